@@ -23,8 +23,10 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/dingodb/dingocli/internal/audit"
 	comm "github.com/dingodb/dingocli/internal/common"
 	configure "github.com/dingodb/dingocli/internal/configure/dingocli"
 	"github.com/dingodb/dingocli/internal/configure/hosts"
@@ -51,6 +53,12 @@ type DingoCli struct {
 	logpath   string
 	config    *configure.DingoCliConfig
 
+	// audit.log of all invocations
+	auditLogPath string
+
+	// crash reports of panicked commands, created on the first crash
+	crashDir string
+
 	// data pipeline
 	in         io.Reader
 	out        io.Writer
@@ -68,6 +76,14 @@ type DingoCli struct {
 	monitor             storage.Monitor
 
 	dingoLogger *logger.DingoLogger
+
+	// dry-run mode (--dry-run)
+	dryRun        bool
+	dryRunActions []Action
+	dryRunMutex   sync.Mutex // actions may be performed by concurrent walkers
+
+	// invocation recorded in audit.log
+	auditEntry *audit.Entry
 }
 
 /*
@@ -77,6 +93,7 @@ type DingoCli struct {
  *   - /data/dingocli.db
  *   - /plugins/{shell,file,polarfs}
  *   - /logs/2006-01-02_15-04-05.log
+ *   - /crash/crash-2006-01-02_15-04-05.log
  *   - /temp/
  */
 func NewDingoCli() (*DingoCli, error) {
@@ -93,6 +110,9 @@ func NewDingoCli() (*DingoCli, error) {
 		pluginDir: path.Join(rootDir, "plugins"),
 		logDir:    path.Join(rootDir, "logs"),
 		tempDir:   path.Join(rootDir, "temp"),
+
+		auditLogPath: path.Join(rootDir, audit.AUDIT_LOG_FILE),
+		crashDir:     path.Join(rootDir, CRASH_DIR),
 	}
 
 	err = dingocli.init()
@@ -267,6 +287,8 @@ func (dingocli *DingoCli) PluginDir() string                 { return dingocli.p
 func (dingocli *DingoCli) LogDir() string                    { return dingocli.logDir }
 func (dingocli *DingoCli) TempDir() string                   { return dingocli.tempDir }
 func (dingocli *DingoCli) LogPath() string                   { return dingocli.logpath }
+func (dingocli *DingoCli) AuditLogPath() string              { return dingocli.auditLogPath }
+func (dingocli *DingoCli) CrashDir() string                  { return dingocli.crashDir }
 func (dingocli *DingoCli) Config() *configure.DingoCliConfig { return dingocli.config }
 func (dingocli *DingoCli) SudoAlias() string                 { return dingocli.config.GetSudoAlias() }
 func (dingocli *DingoCli) SSHTimeout() int                   { return dingocli.config.GetSSHTimeout() }
@@ -510,8 +532,9 @@ func (dingocli *DingoCli) PreAudit(now time.Time, args []string) int64 {
 		return -1
 	}
 
+	dingocli.auditEntry = audit.NewEntry(now, args)
 	cwd, _ := os.Getwd()
-	command := fmt.Sprintf("dingocli %s", strings.Join(args, " "))
+	command := fmt.Sprintf("dingocli %s", strings.Join(logger.RedactArgs(args), " "))
 	id, err := dingocli.Storage().InsertAuditLog(
 		now, cwd, command, comm.AUDIT_STATUS_ABORT)
 	if err != nil {
@@ -523,6 +546,7 @@ func (dingocli *DingoCli) PreAudit(now time.Time, args []string) int64 {
 }
 
 func (dingocli *DingoCli) PostAudit(id int64, ec error) {
+	dingocli.appendAuditLog(ec)
 	if id < 0 {
 		return
 	}
@@ -557,6 +581,30 @@ func (dingocli *DingoCli) PostAudit(id int64, ec error) {
 	}
 }
 
+// audit.log is written even if the audit table of database is broken
+func (dingocli *DingoCli) appendAuditLog(ec error) {
+	entry := dingocli.auditEntry
+	if entry == nil {
+		return
+	}
+
+	status, errorCode := audit.STATUS_SUCCESS, 0
+	if errors.Is(ec, errno.ERR_CANCEL_OPERATION) {
+		status = audit.STATUS_CANCEL
+	} else if ec != nil {
+		status = audit.STATUS_FAIL
+		var code *errno.ErrorCode
+		if errors.As(ec, &code) {
+			errorCode = code.GetCode()
+		}
+	}
+	entry.Finish(time.Now(), status, ExitCode(ec), errorCode, ec)
+	if err := audit.Append(dingocli.AuditLogPath(), entry); err != nil {
+		log.Error("Append audit log failed",
+			log.Field("Error", err))
+	}
+}
+
 func (dingocli *DingoCli) SwitchCluster(cluster storage.Cluster) error {
 
 	dingocli.memStorage = utils.NewSafeMap()
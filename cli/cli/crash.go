@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	log "github.com/dingodb/dingocli/pkg/log/glg"
+	"github.com/dingodb/dingocli/pkg/logger"
+)
+
+// a panic of command is written to $HOME/.dingo/crash/crash-<time>.log instead of the terminal
+const (
+	CRASH_DIR         = "crash"
+	CRASH_FILE_PREFIX = "crash-"
+	CRASH_FILE_SUFFIX = ".log"
+	MAX_CRASH_REPORTS = 20
+	CRASH_ISSUE_URL   = "https://github.com/dingodb/dingocli/issues/new"
+
+	PATH_KERNEL_RELEASE = "/proc/sys/kernel/osrelease"
+	PATH_OS_RELEASE     = "/etc/os-release"
+)
+
+type CrashReport struct {
+	Time      time.Time
+	Version   string
+	Commit    string
+	Branch    string
+	BuildTime string
+	GoVersion string
+	OS        string
+	Arch      string
+	Kernel    string
+	Distro    string
+	Args      string // secrets are redacted
+	Panic     string
+	Stack     string
+}
+
+func NewCrashReport(r any, stack []byte, args []string) *CrashReport {
+	return &CrashReport{
+		Time:      time.Now(),
+		Version:   Version,
+		Commit:    CommitId,
+		Branch:    Branch,
+		BuildTime: BuildTime,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS,
+		Arch:      runtime.GOARCH,
+		Kernel:    kernelRelease(),
+		Distro:    distroName(),
+		Args:      logger.Redact(strings.Join(args, " ")),
+		Panic:     logger.Redact(fmt.Sprintf("%v", r)),
+		Stack:     string(stack),
+	}
+}
+
+func (report *CrashReport) WriteTo(w io.Writer) (int64, error) {
+	n, err := fmt.Fprintf(w, `time:       %s
+version:    %s
+commit:     %s
+branch:     %s
+build time: %s
+go:         %s
+os:         %s/%s
+kernel:     %s
+distro:     %s
+command:    dingo %s
+
+panic: %s
+
+%s`,
+		report.Time.Format(time.RFC3339), report.Version, report.Commit, report.Branch, report.BuildTime,
+		report.GoVersion, report.OS, report.Arch, report.Kernel, report.Distro, report.Args,
+		report.Panic, report.Stack)
+	return int64(n), err
+}
+
+func kernelRelease() string {
+	data, err := os.ReadFile(PATH_KERNEL_RELEASE)
+	if err != nil {
+		return "unknown"
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// PRETTY_NAME of /etc/os-release, e.g. Ubuntu 22.04.4 LTS
+func distroName() string {
+	file, err := os.Open(PATH_OS_RELEASE)
+	if err != nil {
+		return "unknown"
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), "PRETTY_NAME="); ok {
+			return strings.Trim(value, `"'`)
+		}
+	}
+	return "unknown"
+}
+
+// Recover must be deferred directly after PreAudit, it records the panic of command
+// as a crash report and in audit log, then exits with EXIT_FAILURE
+func (dingocli *DingoCli) Recover(auditId int64, args []string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	report := NewCrashReport(r, debug.Stack(), args)
+	output.StopPager()
+	fmt.Fprintf(os.Stderr, "dingo crashed unexpectedly: %s\n", report.Panic)
+	if reportPath, err := dingocli.writeCrashReport(report); err != nil {
+		fmt.Fprintf(os.Stderr, "write crash report failed: %v\n\n%s\n", err, report.Stack)
+	} else {
+		fmt.Fprintf(os.Stderr, "crash report is written to %s\n", reportPath)
+	}
+	fmt.Fprintf(os.Stderr, "please file an issue with the crash report at %s\n", CRASH_ISSUE_URL)
+
+	err := errno.ERR_COMMAND_PANIC.F("%s", report.Panic)
+	dingocli.PostAudit(auditId, err)
+	os.Exit(ExitCode(err))
+}
+
+func (dingocli *DingoCli) writeCrashReport(report *CrashReport) (string, error) {
+	if err := os.MkdirAll(dingocli.crashDir, 0755); err != nil {
+		return "", err
+	}
+	name := CRASH_FILE_PREFIX + report.Time.Format("2006-01-02_15-04-05") + CRASH_FILE_SUFFIX
+	reportPath := path.Join(dingocli.crashDir, name)
+	file, err := os.OpenFile(reportPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if _, err := report.WriteTo(file); err != nil {
+		return "", err
+	}
+	dingocli.pruneCrashReports()
+	return reportPath, nil
+}
+
+// keep the newest MAX_CRASH_REPORTS reports, names are sorted by time
+func (dingocli *DingoCli) pruneCrashReports() {
+	reports, err := filepath.Glob(path.Join(dingocli.crashDir, CRASH_FILE_PREFIX+"*"+CRASH_FILE_SUFFIX))
+	if err != nil || len(reports) <= MAX_CRASH_REPORTS {
+		return
+	}
+	sort.Strings(reports)
+	for _, report := range reports[:len(reports)-MAX_CRASH_REPORTS] {
+		if err := os.Remove(report); err != nil {
+			log.Warn("Remove crash report failed",
+				log.Field("Path", report),
+				log.Field("Error", err))
+		}
+	}
+}
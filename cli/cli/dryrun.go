@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cli
+
+import (
+	"fmt"
+)
+
+const (
+	FLAG_DRY_RUN = "dry-run"
+)
+
+// kind of destructive action
+const (
+	ACTION_SYSCALL = "syscall"
+	ACTION_RPC     = "rpc"
+	ACTION_FILE    = "file"
+	ACTION_COMMAND = "command"
+	ACTION_OBJECT  = "object"
+)
+
+type Action struct {
+	Kind        string
+	Description string
+}
+
+func NewAction(kind, format string, a ...interface{}) Action {
+	return Action{Kind: kind, Description: fmt.Sprintf(format, a...)}
+}
+
+func (action Action) String() string {
+	return fmt.Sprintf("%s: %s", action.Kind, action.Description)
+}
+
+func (dingocli *DingoCli) SetDryRun(dryRun bool) { dingocli.dryRun = dryRun }
+func (dingocli *DingoCli) IsDryRun() bool        { return dingocli.dryRun }
+func (dingocli *DingoCli) DryRunActions() []Action {
+	dingocli.dryRunMutex.Lock()
+	defer dingocli.dryRunMutex.Unlock()
+	return dingocli.dryRunActions
+}
+
+// every destructive action (syscall, rpc, removing files...) goes through Perform,
+// in dry-run mode the action is only reported and fn is not called.
+func (dingocli *DingoCli) Perform(action Action, fn func() error) error {
+	if !dingocli.dryRun {
+		return fn()
+	}
+
+	dingocli.dryRunMutex.Lock()
+	defer dingocli.dryRunMutex.Unlock()
+	dingocli.dryRunActions = append(dingocli.dryRunActions, action)
+	dingocli.WriteOutln("[dry-run] %s", action)
+	return nil
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerform(t *testing.T) {
+	tests := []struct {
+		name    string
+		dryRun  bool
+		fnErr   error
+		called  bool
+		actions int
+		output  string
+	}{
+		{name: "perform", called: true},
+		{name: "perform failed", fnErr: fmt.Errorf("unlink failed"), called: true},
+		{name: "dry-run", dryRun: true, actions: 1, output: "[dry-run] rpc: unlink file1\n"},
+		{name: "dry-run ignores error of fn", dryRun: true, fnErr: fmt.Errorf("unlink failed"),
+			actions: 1, output: "[dry-run] rpc: unlink file1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			out := &bytes.Buffer{}
+			dingocli := &DingoCli{out: out}
+			dingocli.SetDryRun(tt.dryRun)
+
+			called := false
+			err := dingocli.Perform(NewAction(ACTION_RPC, "unlink %s", "file1"), func() error {
+				called = true
+				return tt.fnErr
+			})
+			assert.Equal(tt.called, called)
+			if tt.called {
+				assert.Equal(tt.fnErr, err)
+			} else {
+				assert.NoError(err)
+			}
+			assert.Len(dingocli.DryRunActions(), tt.actions)
+			assert.Equal(tt.output, out.String())
+		})
+	}
+}
+
+func TestPerformConcurrently(t *testing.T) {
+	assert := assert.New(t)
+	dingocli := &DingoCli{out: &bytes.Buffer{}}
+	dingocli.SetDryRun(true)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			dingocli.Perform(NewAction(ACTION_FILE, "remove file%d", i), func() error {
+				t.Errorf("fn is called in dry-run")
+				return nil
+			})
+		}(i)
+	}
+	wg.Wait()
+	assert.Len(dingocli.DryRunActions(), 100)
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cli
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exit codes of dingo, scripts can distinguish failure classes by them
+const (
+	EXIT_OK         = 0
+	EXIT_FAILURE    = 1 // other failures
+	EXIT_USAGE      = 2 // invalid command, flag or argument
+	EXIT_NOT_FOUND  = 3 // fs, file, cluster, host... not found
+	EXIT_RPC        = 4 // rpc failed, timed out or mds unreachable
+	EXIT_PERMISSION = 5 // permission denied
+)
+
+var (
+	// error codes which are not classified by category
+	errnoExitCodes = map[int]int{
+		errno.ERR_HOSTS_FILE_NOT_FOUND.Code:                               EXIT_NOT_FOUND,
+		errno.ERR_TOPOLOGY_FILE_NOT_FOUND.Code:                            EXIT_NOT_FOUND,
+		errno.ERR_HOST_NOT_FOUND.Code:                                     EXIT_NOT_FOUND,
+		errno.ERR_CLUSTER_NOT_FOUND.Code:                                  EXIT_NOT_FOUND,
+		errno.ERR_CLIENT_ID_NOT_FOUND.Code:                                EXIT_NOT_FOUND,
+		errno.ERR_PLAYGROUND_NOT_FOUND.Code:                               EXIT_NOT_FOUND,
+		errno.ERR_USER_NOT_FOUND.Code:                                     EXIT_NOT_FOUND,
+		errno.ERR_CONTAINER_NOT_EXISTED.Code:                              EXIT_NOT_FOUND,
+		errno.ERR_ID_NOT_FOUND.Code:                                       EXIT_NOT_FOUND,
+		errno.ERR_AUDIT_LOG_NOT_FOUND.Code:                                EXIT_NOT_FOUND,
+		errno.ERR_CREATE_DIRECOTRY_PERMISSION_DENIED.Code:                 EXIT_PERMISSION,
+		errno.ERR_EXECUTE_CONTAINER_ENGINE_COMMAND_PERMISSION_DENIED.Code: EXIT_PERMISSION,
+	}
+)
+
+// map error returned by command to exit code
+func ExitCode(err error) int {
+	if err == nil {
+		return EXIT_OK
+	}
+
+	var usageErr *cliutil.UsageError
+	if errors.As(err, &usageErr) || strings.HasPrefix(err.Error(), "unknown command") {
+		return EXIT_USAGE
+	} else if errors.Is(err, fs.ErrNotExist) {
+		return EXIT_NOT_FOUND
+	} else if errors.Is(err, fs.ErrPermission) {
+		return EXIT_PERMISSION
+	}
+
+	var code *errno.ErrorCode
+	if errors.As(err, &code) {
+		return errnoExitCode(code)
+	}
+
+	switch status.Code(err) {
+	case codes.NotFound:
+		return EXIT_NOT_FOUND
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return EXIT_PERMISSION
+	case codes.DeadlineExceeded, codes.Unavailable:
+		return EXIT_RPC
+	}
+	return EXIT_FAILURE
+}
+
+func errnoExitCode(code *errno.ErrorCode) int {
+	if exitCode, ok := errnoExitCodes[code.Code]; ok {
+		return exitCode
+	}
+
+	switch code.GetName() {
+	case errno.NAME_RPC_FAILED, errno.NAME_RPC_TIMEOUT, errno.NAME_MDS_UNREACHABLE, errno.NAME_HOSTNAME_UNRESOLVED:
+		// error returned by mds, e.g. fs not exists
+		if strings.Contains(code.GetClue(), "NOT_FOUND") {
+			return EXIT_NOT_FOUND
+		}
+		return EXIT_RPC
+	case errno.NAME_INVALID_ARGUMENT:
+		return EXIT_USAGE
+	}
+	return EXIT_FAILURE
+}
@@ -18,6 +18,7 @@ package command
 
 import (
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/audit"
 	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/tui"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
@@ -29,6 +30,8 @@ type auditOptions struct {
 	verbose bool
 }
 
+// dingo audit shows audit records of database as before, commands recorded
+// in audit.log are shown by dingo audit list and dingo audit show
 func NewAuditCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options auditOptions
 
@@ -47,6 +50,11 @@ func NewAuditCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.IntVarP(&options.tail, "tail", "n", 20, "Number of lines to show from the end of the logs (0 means all)")
 	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Verbose output for clusters")
 
+	cmd.AddCommand(
+		audit.NewAuditListCommand(dingocli),
+		audit.NewAuditShowCommand(dingocli),
+	)
+
 	return cmd
 }
 
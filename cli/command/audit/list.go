@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/audit"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	AUDIT_LIST_EXAMPLE = `Examples:
+   $ dingo audit list
+
+   # failed commands of alice in the last day
+   $ dingo audit list --since 24h --filter user=alice --filter status=fail`
+
+	AUDIT_TIME_FORMAT = "2006-01-02 15:04:05"
+)
+
+type listOptions struct {
+	tail    int
+	since   time.Duration
+	verbose bool
+	format  string
+}
+
+func NewAuditListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	return newListCommand(dingocli, "list [OPTIONS]", "List commands recorded in audit log")
+}
+
+func newListCommand(dingocli *cli.DingoCli, use, short string) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Args:    utils.NoArgs,
+		Example: AUDIT_LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runList(dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.IntVarP(&options.tail, "tail", "n", 20, "Number of lines to show from the end of the logs (0 means all)")
+	flags.DurationVar(&options.since, "since", 0, "Only show commands executed in the duration, e.g. 24h")
+	flags.BoolVarP(&options.verbose, "verbose", "v", false, "Show hostname, work directory and error code")
+
+	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+	utils.EnablePager(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+// tail is applied after --since, filters of --filter are applied to the rows at last
+func runList(dingocli *cli.DingoCli, options listOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	entries, err := audit.Read(dingocli.AuditLogPath())
+	if err != nil {
+		return errno.ERR_READ_AUDIT_LOG_FAILED.E(err)
+	}
+	if options.since > 0 {
+		since := time.Now().Add(-options.since)
+		recent := []*audit.Entry{}
+		for _, entry := range entries {
+			if !entry.Time.Before(since) {
+				recent = append(recent, entry)
+			}
+		}
+		entries = recent
+	}
+	if options.tail > 0 && options.tail < len(entries) {
+		entries = entries[len(entries)-options.tail:]
+	}
+	outputResult.Result = entries
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_ID, common.ROW_EXECUTE_TIME, common.ROW_USER, common.ROW_DURATION, common.ROW_STATUS, common.ROW_EXIT_CODE}
+	if options.verbose {
+		header = append(header, common.ROW_HOSTNAME, common.ROW_WORK_DIR, common.ROW_ERROR_CODE)
+	}
+	header = append(header, common.ROW_COMMAND)
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, entry := range entries {
+		row := make(map[string]string)
+		row[common.ROW_ID] = entry.Id
+		row[common.ROW_EXECUTE_TIME] = entry.Time.Format(AUDIT_TIME_FORMAT)
+		row[common.ROW_USER] = entry.User
+		row[common.ROW_DURATION] = entry.DurationString()
+		row[common.ROW_STATUS] = entry.Status
+		row[common.ROW_EXIT_CODE] = fmt.Sprintf("%d", entry.ExitCode)
+		row[common.ROW_HOSTNAME] = entry.Hostname
+		row[common.ROW_WORK_DIR] = entry.WorkDir
+		row[common.ROW_ERROR_CODE] = fmt.Sprintf("%d", entry.ErrorCode)
+		row[common.ROW_COMMAND] = entry.Command
+		rows = append(rows, row)
+	}
+	table.AppendBulk(table.ListMap2ListSortByKeys(rows, header, []string{}))
+	table.RenderWithNoData("no command in audit log")
+
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/audit"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	AUDIT_SHOW_EXAMPLE = `Examples:
+   $ dingo audit show 20261016102105-12345
+   $ dingo audit show last --format json`
+
+	AUDIT_LAST = "last"
+)
+
+type showOptions struct {
+	id     string
+	format string
+}
+
+func NewAuditShowCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options showOptions
+
+	cmd := &cobra.Command{
+		Use:     "show ID|last [OPTIONS]",
+		Short:   "Show details of a command recorded in audit log",
+		Args:    utils.ExactArgs(1),
+		Example: AUDIT_SHOW_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.id = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runShow(dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddFormatFlag(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+// last is the latest command except dingo audit itself, which is never recorded
+func findEntry(entries []*audit.Entry, id string) *audit.Entry {
+	if id == AUDIT_LAST {
+		if len(entries) == 0 {
+			return nil
+		}
+		return entries[len(entries)-1]
+	}
+	for _, entry := range entries {
+		if entry.Id == id {
+			return entry
+		}
+	}
+	return nil
+}
+
+func runShow(dingocli *cli.DingoCli, options showOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	entries, err := audit.Read(dingocli.AuditLogPath())
+	if err != nil {
+		return errno.ERR_READ_AUDIT_LOG_FAILED.E(err)
+	}
+	entry := findEntry(entries, options.id)
+	if entry == nil {
+		return errno.ERR_AUDIT_LOG_NOT_FOUND.F("id=%s", options.id)
+	}
+	outputResult.Result = entry
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	fmt.Printf("Id:          %s\n", entry.Id)
+	fmt.Printf("Time:        %s\n", entry.Time.Format(AUDIT_TIME_FORMAT))
+	fmt.Printf("User:        %s\n", entry.User)
+	fmt.Printf("Hostname:    %s\n", entry.Hostname)
+	fmt.Printf("Work Dir:    %s\n", entry.WorkDir)
+	fmt.Printf("Command:     %s\n", entry.Command)
+	fmt.Printf("Args:        %s\n", strings.Join(entry.Args, " "))
+	fmt.Printf("Duration:    %s\n", entry.DurationString())
+	fmt.Printf("Status:      %s\n", entry.Status)
+	fmt.Printf("Exit Code:   %d\n", entry.ExitCode)
+	if entry.ErrorCode != 0 {
+		fmt.Printf("Error Code:  %d\n", entry.ErrorCode)
+	}
+	if entry.Error != "" {
+		fmt.Printf("Error:       %s\n", entry.Error)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/rpc"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+// tokens are issued by administrators of the cluster and stored per context, encrypted by
+// the secret key of "dingo config encrypt", user, role and expiry are read from claims
+// if the token is a jwt, they are not verified by dingo
+const (
+	AUTH_DEFAULT_CONTEXT = "default"
+)
+
+type AuthToken struct {
+	Context   string    `json:"context"`
+	User      string    `json:"user"`
+	Role      string    `json:"role,omitempty"`
+	Token     string    `json:"token"`
+	LoginAt   time.Time `json:"login_at"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+}
+
+func NewAuthCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "auth",
+		Short:   "Manage tokens of administrative requests",
+		GroupID: "ADMIN",
+		Args:    cliutil.NoArgs,
+	}
+
+	cmd.AddCommand(
+		NewLoginCommand(dingocli),
+		NewLogoutCommand(dingocli),
+		NewStatusCommand(dingocli),
+	)
+
+	return cmd
+}
+
+func contextKey(name string) string {
+	if name == "" {
+		return AUTH_DEFAULT_CONTEXT
+	}
+	return name
+}
+
+// the key file is generated if neither it nor DINGO_SECRET exists
+func loadAuthSecretKey() (*cliutil.SecretKey, error) {
+	keyFile := cliutil.GetSecretKeyFile()
+	if os.Getenv(cliutil.ENV_DINGO_SECRET) == "" && !cliutil.IsFileExists(keyFile) {
+		if err := cliutil.GenerateSecretKeyFile(keyFile); err != nil {
+			return nil, err
+		}
+	}
+	return cliutil.LoadSecretKey()
+}
+
+func setAuthToken(dingocli *cli.DingoCli, token *AuthToken) error {
+	key, err := loadAuthSecretKey()
+	if err != nil {
+		return errno.ERR_INSERT_AUTH_TOKEN_FAILED.E(err)
+	}
+	encrypted := *token
+	if encrypted.Token, err = cliutil.EncryptSecret(token.Token, key); err != nil {
+		return errno.ERR_INSERT_AUTH_TOKEN_FAILED.E(err)
+	}
+	data, err := json.Marshal(&encrypted)
+	if err != nil {
+		return err
+	}
+	if err := dingocli.Storage().SetAuthToken(token.Context, string(data)); err != nil {
+		return errno.ERR_INSERT_AUTH_TOKEN_FAILED.E(err)
+	}
+	return nil
+}
+
+func deleteAuthToken(dingocli *cli.DingoCli, context string) error {
+	if err := dingocli.Storage().DeleteAuthToken(context); err != nil {
+		return errno.ERR_DELETE_AUTH_TOKEN_FAILED.E(err)
+	}
+	return nil
+}
+
+// tokens by context
+func getAuthTokens(dingocli *cli.DingoCli) (map[string]*AuthToken, error) {
+	items, err := dingocli.Storage().GetAuthTokens()
+	if err != nil {
+		return nil, errno.ERR_SELECT_AUTH_TOKEN_FAILED.E(err)
+	}
+	tokens := map[string]*AuthToken{}
+	for _, item := range items {
+		token := &AuthToken{}
+		if err := json.Unmarshal([]byte(item.Data), token); err != nil {
+			logger.Warnf("invalid auth token %s: %v", item.Id, err)
+			continue
+		}
+		value, err := cliutil.ResolveSecret(token.Token)
+		if err != nil {
+			logger.Warnf("decrypt auth token %s failed: %v", item.Id, err)
+			continue
+		}
+		token.Token = value
+		tokens[token.Context] = token
+	}
+	return tokens, nil
+}
+
+// AuthLoader loads the token of the context selected for the command,
+// it is called by rpc on the first request
+func AuthLoader(dingocli *cli.DingoCli, cmd *cobra.Command) rpc.AuthLoader {
+	return func() (string, *rpc.AuthIdentity) {
+		context := contextKey(cliutil.GetContextName(cmd))
+		tokens, err := getAuthTokens(dingocli)
+		if err != nil {
+			logger.Warnf("load auth token of context %s failed: %v", context, err)
+			return "", &rpc.AuthIdentity{Context: context}
+		}
+		token, ok := tokens[context]
+		if !ok {
+			return "", &rpc.AuthIdentity{Context: context}
+		}
+		return token.Token, token.identity()
+	}
+}
+
+func (token *AuthToken) identity() *rpc.AuthIdentity {
+	return &rpc.AuthIdentity{Context: token.Context, User: token.User, Role: token.Role, ExpiresAt: token.ExpiresAt}
+}
+
+func (token *AuthToken) expired() bool {
+	return !token.ExpiresAt.IsZero() && token.ExpiresAt.Before(time.Now())
+}
+
+// only the first and last characters are shown
+func (token *AuthToken) masked() string {
+	if len(token.Token) <= 8 {
+		return strings.Repeat("*", len(token.Token))
+	}
+	return token.Token[:4] + "..." + token.Token[len(token.Token)-4:]
+}
+
+// claims of a jwt, other tokens are opaque
+func parseTokenClaims(token *AuthToken) {
+	parts := strings.Split(token.Token, ".")
+	if len(parts) != 3 {
+		return
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return
+	}
+	claims := struct {
+		Subject string `json:"sub"`
+		Name    string `json:"name"`
+		Role    string `json:"role"`
+		Expire  int64  `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return
+	}
+	if token.User == "" {
+		token.User = cliutil.Ternary(claims.Name != "", claims.Name, claims.Subject)
+	}
+	if token.Role == "" {
+		token.Role = claims.Role
+	}
+	if claims.Expire > 0 {
+		token.ExpiresAt = time.Unix(claims.Expire, 0)
+	}
+}
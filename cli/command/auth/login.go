@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	LOGIN_EXAMPLE = `Examples:
+   # token is read from terminal without echo
+   $ dingo auth login
+
+   $ dingo --context prod auth login --token-file /etc/dingo/admin.token
+   $ echo $TOKEN | dingo auth login --user alice --role admin`
+)
+
+type loginOptions struct {
+	token      string
+	tokenFile  string
+	user       string
+	role       string
+	skipVerify bool
+}
+
+func NewLoginCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options loginOptions
+
+	cmd := &cobra.Command{
+		Use:     "login [OPTIONS]",
+		Short:   "Store token for requests of current context",
+		Args:    cliutil.NoArgs,
+		Example: LOGIN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliutil.ReadCommandConfig(cmd)
+			output.SetShow(cliutil.GetBoolFlag(cmd, cliutil.VERBOSE))
+
+			return runLogin(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	cliutil.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().StringVar(&options.token, "token", "", "Token issued by administrator, read from stdin if not set")
+	cmd.Flags().StringVar(&options.tokenFile, "token-file", "", "File of the token")
+	cmd.Flags().StringVar(&options.user, "user", "", "User of the token, default is the user in token claims")
+	cmd.Flags().StringVar(&options.role, "role", "", "Role of the user, default is the role in token claims")
+	cmd.Flags().BoolVar(&options.skipVerify, "skip-verify", false, "Store the token without a request to mds")
+
+	cliutil.AddBoolFlag(cmd, cliutil.VERBOSE, "Show more debug info")
+	cliutil.AddConfigFileFlag(cmd)
+
+	cliutil.AddDurationFlag(cmd, cliutil.RPCTIMEOUT, "RPC timeout")
+	cliutil.AddDurationFlag(cmd, cliutil.RPCRETRYDElAY, "RPC retry delay")
+	cliutil.AddUint32Flag(cmd, cliutil.RPCRETRYTIMES, "RPC retry times")
+
+	cliutil.AddStringFlag(cmd, cliutil.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func readToken(options loginOptions) (string, error) {
+	var data []byte
+	var err error
+	switch {
+	case options.token != "":
+		return options.token, nil
+	case options.tokenFile != "":
+		data, err = os.ReadFile(options.tokenFile)
+	case isatty.IsTerminal(os.Stdin.Fd()):
+		fmt.Fprint(os.Stderr, "Token: ")
+		data, err = term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+	default:
+		data, err = io.ReadAll(os.Stdin)
+	}
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token is empty")
+	}
+	return token, nil
+}
+
+// the token is verified by listing mds, which is allowed for all roles
+func runLogin(cmd *cobra.Command, dingocli *cli.DingoCli, options loginOptions) error {
+	value, err := readToken(options)
+	if err != nil {
+		return err
+	}
+	token := &AuthToken{
+		Context: contextKey(cliutil.GetContextName(cmd)),
+		User:    options.user,
+		Role:    options.role,
+		Token:   value,
+		LoginAt: time.Now(),
+	}
+	parseTokenClaims(token)
+	if token.User == "" {
+		token.User = cliutil.Ternary(os.Getenv("USER") != "", os.Getenv("USER"), "unknown")
+	}
+	if token.expired() {
+		return fmt.Errorf("token of %s expired at %s", token.User, token.ExpiresAt.Format(time.RFC3339))
+	}
+
+	if !options.skipVerify {
+		rpc.SetAuthLoader(func() (string, *rpc.AuthIdentity) {
+			return token.Token, token.identity()
+		})
+		if _, err := rpc.GetMDSList(cmd); err != nil {
+			return err
+		}
+	}
+	if err := setAuthToken(dingocli, token); err != nil {
+		return err
+	}
+
+	fmt.Printf("Logged in as %s", token.User)
+	if token.Role != "" {
+		fmt.Printf(" (role %s)", token.Role)
+	}
+	fmt.Printf(" in context %s\n", token.Context)
+	return nil
+}
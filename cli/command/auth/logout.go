@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	LOGOUT_EXAMPLE = `Examples:
+   $ dingo --context prod auth logout
+   $ dingo auth logout --all`
+)
+
+type logoutOptions struct {
+	all bool
+}
+
+func NewLogoutCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options logoutOptions
+
+	cmd := &cobra.Command{
+		Use:     "logout [OPTIONS]",
+		Short:   "Remove stored token of current context",
+		Args:    cliutil.NoArgs,
+		Example: LOGOUT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliutil.ReadCommandConfig(cmd)
+			return runLogout(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	cliutil.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().BoolVar(&options.all, "all", false, "Remove tokens of all contexts")
+	cliutil.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+func runLogout(cmd *cobra.Command, dingocli *cli.DingoCli, options logoutOptions) error {
+	tokens, err := getAuthTokens(dingocli)
+	if err != nil {
+		return err
+	}
+	contexts := []string{contextKey(cliutil.GetContextName(cmd))}
+	if options.all {
+		contexts = []string{}
+		for context := range tokens {
+			contexts = append(contexts, context)
+		}
+	}
+
+	for _, context := range contexts {
+		token, ok := tokens[context]
+		if !ok {
+			fmt.Printf("Not logged in context %s\n", context)
+			continue
+		}
+		if err := deleteAuthToken(dingocli, context); err != nil {
+			return err
+		}
+		fmt.Printf("Logged out %s in context %s\n", token.User, context)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+type statusOptions struct {
+	format string
+}
+
+// token is masked in result
+type AuthStatus struct {
+	Context   string `json:"context"`
+	Current   bool   `json:"current"`
+	User      string `json:"user"`
+	Role      string `json:"role"`
+	Token     string `json:"token"`
+	LoginAt   string `json:"login_at"`
+	ExpiresAt string `json:"expires_at"`
+	Expired   bool   `json:"expired"`
+}
+
+func NewStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options statusOptions
+
+	cmd := &cobra.Command{
+		Use:   "status [OPTIONS]",
+		Short: "Show stored tokens of contexts",
+		Args:  cliutil.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliutil.ReadCommandConfig(cmd)
+			options.format = cliutil.GetStringFlag(cmd, cliutil.FORMAT)
+			return runStatus(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	cliutil.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cliutil.AddConfigFileFlag(cmd)
+	cliutil.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runStatus(cmd *cobra.Command, dingocli *cli.DingoCli, options statusOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	tokens, err := getAuthTokens(dingocli)
+	if err != nil {
+		return err
+	}
+	current := contextKey(cliutil.GetContextName(cmd))
+	statuses := []*AuthStatus{}
+	for _, token := range tokens {
+		status := &AuthStatus{
+			Context:   token.Context,
+			Current:   token.Context == current,
+			User:      token.User,
+			Role:      token.Role,
+			Token:     token.masked(),
+			LoginAt:   token.LoginAt.Format(time.RFC3339),
+			ExpiresAt: common.ROW_VALUE_NO_VALUE,
+			Expired:   token.expired(),
+		}
+		if !token.ExpiresAt.IsZero() {
+			status.ExpiresAt = token.ExpiresAt.Format(time.RFC3339)
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Context < statuses[j].Context
+	})
+	outputResult.Result = statuses
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_CONTEXT, common.ROW_USER, common.ROW_ROLE, common.ROW_TOKEN, common.ROW_LOGIN_AT, common.ROW_EXPIRES_AT}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, status := range statuses {
+		context := status.Context
+		if status.Current {
+			context = "* " + context
+		}
+		expiresAt := status.ExpiresAt
+		if status.Expired {
+			expiresAt += " (expired)"
+		}
+		rows = append(rows, []string{
+			context,
+			status.User,
+			cliutil.Ternary(status.Role != "", status.Role, common.ROW_VALUE_NO_VALUE),
+			status.Token,
+			status.LoginAt,
+			expiresAt,
+		})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("not logged in any context")
+
+	return nil
+}
@@ -68,6 +68,7 @@ func NewCacheGroupListCommand(dingocli *cli.DingoCli) *cobra.Command {
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddConfigFileFlag(cmd)
 	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
 
 	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
 	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
@@ -140,6 +140,11 @@ var (
 		CREATE_META_TABLES:         ROLE_MDSV2_CLI,
 		CREATE_MDSV2_CLI_CONTAINER: ROLE_MDSV2_CLI,
 		SYNC_JAVA_OPTS:             ROLE_DINGODB_EXECUTOR,
+		SYNC_SYSTEMD_CONFIG:        ROLE_FS_MDS,
+		CREATE_SYSTEMD_META_TABLES: ROLE_MDSV2_CLI,
+		START_SYSTEMD_SERVICE:      ROLE_FS_MDS,
+		GET_SYSTEMD_LEADER:         ROLE_FS_MDS,
+		CHECK_SYSTEMD_HEALTH:       ROLE_FS_MDS,
 	}
 
 	// DEPLOY_LIMIT_SERVICE is used to limit the number of services
@@ -149,6 +154,7 @@ var (
 		CREATE_MDSV2_CLI_CONTAINER: 1,
 		CHECK_STORE_HEALTH:         1,
 		SYNC_JAVA_OPTS:             1,
+		CREATE_SYSTEMD_META_TABLES: 1,
 	}
 
 	CAN_SKIP_ROLES = []string{
@@ -253,6 +259,10 @@ func precheckBeforeDeploy(dingocli *cli.DingoCli,
 func genDeployPlaybook(dingocli *cli.DingoCli,
 	dcs []*topology.DeployConfig,
 	options deployOptions) (*playbook.Playbook, error) {
+	if isSystemdDeploy(dcs) {
+		return genSystemdDeployPlaybook(dingocli, dcs)
+	}
+
 	var steps []int
 	kind := dcs[0].GetKind()
 
@@ -385,6 +395,9 @@ func runDeploy(dingocli *cli.DingoCli, options deployOptions) error {
 
 	// 2) skip service role
 	dcs = skipServiceRole(dcs, options)
+	if err = checkDeployMode(dcs); err != nil {
+		return err
+	}
 
 	// 3) precheck before deploy
 	err = precheckBeforeDeploy(dingocli, dcs, options)
@@ -409,5 +422,8 @@ func runDeploy(dingocli *cli.DingoCli, options deployOptions) error {
 	// 7) print success prompt
 	dingocli.WriteOutln("")
 	dingocli.WriteOutln(color.GreenString("Cluster '%s' successfully deployed ^_^."), dingocli.ClusterName())
+	if isSystemdDeploy(dcs) {
+		dingocli.WriteOutln("Services are managed by systemd units, see them by 'systemctl status dingo-mds-*' on hosts.")
+	}
 	return nil
 }
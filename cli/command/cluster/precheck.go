@@ -164,6 +164,9 @@ func genPrecheckPlaybook(dingocli *cli.DingoCli,
 	roles := dingocli.GetRoles(dcs)
 	skipRoles := topology.FetchSkipRoles(kind, dcs, roles)
 
+	if isSystemdDeploy(dcs) { // no container and image on hosts
+		steps = SYSTEMD_PRECHECK_STEPS
+	}
 	steps = skipPrecheckSteps(steps, options)
 
 	// add playbook step
@@ -196,6 +199,9 @@ func genPrecheckPlaybook(dingocli *cli.DingoCli,
 
 	// add playbook post steps
 	steps = PRECHECK_POST_STEPS
+	if isSystemdDeploy(dcs) {
+		steps = []int{}
+	}
 	for _, step := range steps {
 		pb.AddPostStep(&playbook.PlaybookStep{
 			Type:    step,
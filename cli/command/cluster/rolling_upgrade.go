@@ -0,0 +1,478 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	"github.com/dingodb/dingocli/internal/rpc"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	"github.com/dingodb/dingocli/internal/utils"
+	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	UPGRADE_STATE_DIR     = "upgrade"
+	UPGRADE_TOPOLOGY_TAG  = "topology" // version of state if upgrade to component_version of topology
+	UPGRADE_DRAIN_WEIGHT  = 0
+	UPGRADE_STATE_PENDING = "pending"
+	UPGRADE_STATE_DONE    = "upgraded"
+)
+
+var (
+	// services of a host are upgraded together, health is checked before next host
+	ROLLING_UPGRADE_STEPS = []int{
+		INSTALL_COMPONENT_BINARY,
+		SYNC_SYSTEMD_CONFIG,
+		START_SYSTEMD_SERVICE,
+		CHECK_SYSTEMD_HEALTH,
+	}
+)
+
+// progress of a rolling upgrade, saved after every host so that an interrupted
+// upgrade is resumed by running the same command again
+type upgradeState struct {
+	Cluster   string                           `json:"cluster"`
+	Version   string                           `json:"version"`
+	Upgraded  []string                         `json:"upgraded"`
+	Drained   map[string][]*drainedCacheMember `json:"drained,omitempty"` // host -> members
+	UpdatedAt string                           `json:"updated_at"`
+}
+
+// weight is the one before drain, which is restored after the host is upgraded
+type drainedCacheMember struct {
+	MemberId string `json:"member_id"`
+	Ip       string `json:"ip"`
+	Port     uint32 `json:"port"`
+	Weight   uint32 `json:"weight"`
+}
+
+type upgradeNode struct {
+	host string
+	dcs  []*topology.DeployConfig
+}
+
+func upgradeStatePath(dingocli *cli.DingoCli) string {
+	return filepath.Join(dingocli.DataDir(), UPGRADE_STATE_DIR, dingocli.ClusterName()+".json")
+}
+
+// state of another version is discarded, upgrade starts over
+func loadUpgradeState(dingocli *cli.DingoCli, version string) (*upgradeState, error) {
+	state := &upgradeState{
+		Cluster: dingocli.ClusterName(),
+		Version: version,
+		Drained: map[string][]*drainedCacheMember{},
+	}
+	data, err := os.ReadFile(upgradeStatePath(dingocli))
+	if os.IsNotExist(err) {
+		return state, nil
+	} else if err != nil {
+		return nil, errno.ERR_LOAD_UPGRADE_STATE_FAILED.E(err)
+	}
+
+	saved := &upgradeState{}
+	if err := json.Unmarshal(data, saved); err != nil {
+		return nil, errno.ERR_LOAD_UPGRADE_STATE_FAILED.F("%s: %v", upgradeStatePath(dingocli), err)
+	}
+	if saved.Version != version {
+		if len(saved.Drained) > 0 {
+			return nil, errno.ERR_LOAD_UPGRADE_STATE_FAILED.
+				F("cache members are still drained by upgrade to %s, finish it first", saved.Version)
+		}
+		dingocli.WriteOutln(color.YellowString("Discard unfinished upgrade to %s", saved.Version))
+		return state, nil
+	}
+	if saved.Drained == nil {
+		saved.Drained = map[string][]*drainedCacheMember{}
+	}
+	return saved, nil
+}
+
+func (s *upgradeState) save(dingocli *cli.DingoCli) error {
+	s.UpdatedAt = time.Now().Format(time.RFC3339)
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return errno.ERR_SAVE_UPGRADE_STATE_FAILED.E(err)
+	}
+	path := upgradeStatePath(dingocli)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errno.ERR_SAVE_UPGRADE_STATE_FAILED.E(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return errno.ERR_SAVE_UPGRADE_STATE_FAILED.E(err)
+	}
+	return nil
+}
+
+func (s *upgradeState) isUpgraded(host string) bool {
+	return utils.Contains(s.Upgraded, host)
+}
+
+func countUpgraded(nodes []*upgradeNode, state *upgradeState) int {
+	count := 0
+	for _, node := range nodes {
+		if state.isUpgraded(node.host) {
+			count++
+		}
+	}
+	return count
+}
+
+// services grouped by host in the order of topology
+func groupByHost(dcs []*topology.DeployConfig) []*upgradeNode {
+	nodes := []*upgradeNode{}
+	index := map[string]*upgradeNode{}
+	for _, dc := range dcs {
+		node, ok := index[dc.GetHost()]
+		if !ok {
+			node = &upgradeNode{host: dc.GetHost()}
+			index[dc.GetHost()] = node
+			nodes = append(nodes, node)
+		}
+		node.dcs = append(node.dcs, dc)
+	}
+	return nodes
+}
+
+func mdsEndpoints(dcs []*topology.DeployConfig) []string {
+	endpoints := []string{}
+	for _, dc := range dcs {
+		if dc.GetRole() == topology.ROLE_FS_MDS {
+			endpoints = append(endpoints, fmt.Sprintf("%s:%d", dc.GetListenIp(), dc.GetDingoServerPort()))
+		}
+	}
+	return endpoints
+}
+
+// getLeader returns id of the mds leader, empty if no mds answers as leader
+func getLeader(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) (string, error) {
+	dcs = dingocli.FilterDeployConfigByRole(dcs, topology.ROLE_FS_MDS)
+	if len(dcs) == 0 {
+		return "", nil
+	}
+
+	dingocli.MemStorage().Set(comm.KEY_MDS_LEADER, "")
+	pb := playbook.NewPlaybook(dingocli)
+	pb.AddStep(&playbook.PlaybookStep{
+		Type:    GET_SYSTEMD_LEADER,
+		Configs: dcs,
+		ExecOptions: playbook.ExecOptions{
+			SilentSubBar:  true,
+			SilentMainBar: true,
+		},
+	})
+	if err := pb.Run(); err != nil {
+		return "", err
+	}
+	leader, _ := dingocli.MemStorage().Get(comm.KEY_MDS_LEADER).(string)
+	return leader, nil
+}
+
+// nextNode returns the first pending host which doesn't serve the leader,
+// the host of leader is upgraded at last
+func nextNode(nodes []*upgradeNode, state *upgradeState, leader string) *upgradeNode {
+	var leaderNode *upgradeNode
+	for _, node := range nodes {
+		if state.isUpgraded(node.host) {
+			continue
+		}
+		isLeaderNode := false
+		for _, dc := range node.dcs {
+			if dc.GetId() == leader {
+				isLeaderNode = true
+			}
+		}
+		if !isLeaderNode {
+			return node
+		} else if leaderNode == nil {
+			leaderNode = node
+		}
+	}
+	return leaderNode
+}
+
+func listCacheMembers(cmd *cobra.Command, endpoints []string) ([]*mds.CacheGroupMember, error) {
+	listRpc := &rpc.ListCacheMemberRpc{
+		Info:    rpc.CreateNewMdsRpcWithEndPoint(cmd, endpoints, "ListMembers"),
+		Request: &mds.ListMembersRequest{},
+	}
+	response, rpcError := rpc.GetRpcResponse(listRpc.Info, listRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.ListMembersResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return result.GetMembers(), nil
+}
+
+func reweightCacheMember(cmd *cobra.Command, endpoints []string, member *drainedCacheMember, weight uint32) error {
+	reWeightRpc := &rpc.ReWeightMemberRpc{
+		Info: rpc.CreateNewMdsRpcWithEndPoint(cmd, endpoints, "ReWeightMember"),
+		Request: &mds.ReweightMemberRequest{
+			MemberId: member.MemberId,
+			Ip:       member.Ip,
+			Port:     member.Port,
+			Weight:   weight,
+		},
+	}
+	response, rpcError := rpc.GetRpcResponse(reWeightRpc.Info, reWeightRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+	if mdsErr := response.(*mds.ReweightMemberResponse).GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return nil
+}
+
+// drainCacheMembers set weight of cache members on the host to 0, so no new blocks are
+// cached by them while the host is upgraded. Members drained by an interrupted upgrade
+// are taken from the state, their weight is already 0 in mds.
+func drainCacheMembers(cmd *cobra.Command, dingocli *cli.DingoCli, endpoints []string,
+	node *upgradeNode, state *upgradeState) error {
+	if _, ok := state.Drained[node.host]; ok {
+		return nil
+	}
+
+	ips := map[string]bool{}
+	for _, dc := range node.dcs {
+		ips[dc.GetListenIp()] = true
+	}
+	if hc, err := dingocli.GetHost(node.host); err == nil {
+		ips[hc.GetHostname()] = true
+	}
+	members, err := listCacheMembers(cmd, endpoints)
+	if err != nil {
+		return errno.ERR_DRAIN_CACHE_MEMBER_FAILED.F("list cache members failed: %v", err)
+	}
+	drained := []*drainedCacheMember{}
+	for _, member := range members {
+		if ips[member.GetIp()] && member.GetWeight() > UPGRADE_DRAIN_WEIGHT {
+			drained = append(drained, &drainedCacheMember{
+				MemberId: member.GetMemberId(),
+				Ip:       member.GetIp(),
+				Port:     member.GetPort(),
+				Weight:   member.GetWeight(),
+			})
+		}
+	}
+
+	// weights are saved before drain, so they can be restored after crash
+	state.Drained[node.host] = drained
+	if err := state.save(dingocli); err != nil {
+		return err
+	}
+	for _, member := range drained {
+		dingocli.WriteOutln("  + drain cache member %s (%s:%d)", member.MemberId, member.Ip, member.Port)
+		if err := reweightCacheMember(cmd, endpoints, member, UPGRADE_DRAIN_WEIGHT); err != nil {
+			return errno.ERR_DRAIN_CACHE_MEMBER_FAILED.F("drain %s failed: %v", member.MemberId, err)
+		}
+	}
+	return nil
+}
+
+func restoreCacheMembers(cmd *cobra.Command, dingocli *cli.DingoCli, endpoints []string,
+	node *upgradeNode, state *upgradeState) error {
+	for _, member := range state.Drained[node.host] {
+		dingocli.WriteOutln("  + restore cache member %s (%s:%d) weight to %d",
+			member.MemberId, member.Ip, member.Port, member.Weight)
+		if err := reweightCacheMember(cmd, endpoints, member, member.Weight); err != nil {
+			return errno.ERR_DRAIN_CACHE_MEMBER_FAILED.F("restore %s failed: %v", member.MemberId, err)
+		}
+	}
+	return nil
+}
+
+func genRollingUpgradePlaybook(dingocli *cli.DingoCli, dcs []*topology.DeployConfig,
+	binaries map[string]string, options upgradeOptions) *playbook.Playbook {
+	pb := playbook.NewPlaybook(dingocli)
+	for _, step := range ROLLING_UPGRADE_STEPS {
+		config := dcs
+		if len(DEPLOY_FILTER_ROLE[step]) > 0 {
+			config = dingocli.FilterDeployConfigByRole(config, DEPLOY_FILTER_ROLE[step])
+		}
+		if len(config) == 0 {
+			continue
+		}
+
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: config,
+			Options: map[string]interface{}{
+				comm.KEY_COMPONENT_BINARIES: binaries,
+				comm.KEY_COMPONENT_VERSION:  options.version,
+			},
+		})
+	}
+	return pb
+}
+
+func upgradeHost(cmd *cobra.Command, dingocli *cli.DingoCli, endpoints []string, node *upgradeNode,
+	binaries map[string]string, state *upgradeState, options upgradeOptions) error {
+	if err := drainCacheMembers(cmd, dingocli, endpoints, node, state); err != nil {
+		return err
+	}
+	if err := genRollingUpgradePlaybook(dingocli, node.dcs, binaries, options).Run(); err != nil {
+		return err
+	}
+	if err := restoreCacheMembers(cmd, dingocli, endpoints, node, state); err != nil {
+		return err
+	}
+
+	delete(state.Drained, node.host)
+	state.Upgraded = append(state.Upgraded, node.host)
+	return state.save(dingocli)
+}
+
+// pauseOnError waits the user to fix the failed host, returns true if the host should be retried
+func pauseOnError(dingocli *cli.DingoCli, node *upgradeNode, err error) bool {
+	dingocli.WriteOutln("")
+	dingocli.WriteOutln(color.RedString("Upgrade host %s failed: %v", node.host, err))
+	if utils.AssumeYes() { // nobody to fix it
+		return false
+	}
+	return tui.ConfirmYes("Upgrade is paused, retry host %s after fixing it?", node.host)
+}
+
+func displayRollingTitle(dingocli *cli.DingoCli, nodes []*upgradeNode, state *upgradeState, leader string) {
+	dingocli.WriteOutln(color.YellowString("Rolling upgrade %d hosts to %s, followers before leader", len(nodes), state.Version))
+	for _, node := range nodes {
+		services := []string{}
+		for _, dc := range node.dcs {
+			service := dc.GetRole()
+			if dc.GetId() == leader {
+				service += "(leader)"
+			}
+			services = append(services, service)
+		}
+		status := UPGRADE_STATE_PENDING
+		if state.isUpgraded(node.host) {
+			status = UPGRADE_STATE_DONE
+		}
+		dingocli.WriteOutln("  + host=%s  services=%s  status=%s", node.host, strings.Join(services, ","), status)
+	}
+}
+
+// runRollingUpgrade upgrade services deployed by systemd host by host: cache members of the host
+// are drained, binaries and configs are replaced, units are restarted and must be healthy
+// before the next host. Hosts of mds followers are upgraded before the host of mds leader.
+func runRollingUpgrade(cmd *cobra.Command, dingocli *cli.DingoCli, dcs []*topology.DeployConfig, options upgradeOptions) error {
+	// 1) check deploy mode and filter services
+	if err := checkDeployMode(dcs); err != nil {
+		return err
+	}
+	endpoints := mdsEndpoints(dcs)
+	allHosts := []string{}
+	for _, node := range groupByHost(dcs) {
+		allHosts = append(allHosts, node.host)
+	}
+	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
+		Id:   options.id,
+		Role: options.role,
+		Host: options.host,
+	})
+	if len(dcs) == 0 {
+		return errno.ERR_NO_SERVICES_MATCHED
+	}
+
+	// 2) load progress of the interrupted upgrade
+	version := options.version
+	if len(version) == 0 {
+		version = UPGRADE_TOPOLOGY_TAG
+	}
+	state, err := loadUpgradeState(dingocli, version)
+	if err != nil {
+		return err
+	}
+
+	// 3) display hosts and confirm by user
+	leader, err := getLeader(dingocli, dcs)
+	if err != nil {
+		return err
+	}
+	nodes := groupByHost(dcs)
+	displayRollingTitle(dingocli, nodes, state, leader)
+	if !options.force {
+		if pass := tui.ConfirmYes(tui.DEFAULT_CONFIRM_PROMPT); !pass {
+			dingocli.WriteOut(tui.PromptCancelOpetation("upgrade service"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 4) install binaries of the version into local component repository
+	binaries, err := installComponents(dingocli, dcs, options.version)
+	if err != nil {
+		return err
+	}
+
+	// 5) upgrade host by host
+	for {
+		node := nextNode(nodes, state, leader)
+		if node == nil {
+			break
+		}
+
+		dingocli.WriteOutln("")
+		dingocli.WriteOutln("Upgrade host %s:", color.BlueString("%d/%d", countUpgraded(nodes, state)+1, len(nodes)))
+		dingocli.WriteOutln("  + host=%s  version=%s", node.host, version)
+		for {
+			err = upgradeHost(cmd, dingocli, endpoints, node, binaries, state, options)
+			if err == nil {
+				break
+			} else if !options.pauseOnError || !pauseOnError(dingocli, node, err) {
+				dingocli.WriteOutln(color.YellowString("Upgraded %d/%d hosts, run the same command again to resume",
+					countUpgraded(nodes, state), len(nodes)))
+				return err
+			}
+		}
+		dingocli.WriteOutln(color.GreenString("Upgrade host %s success :)", node.host))
+
+		// leader may move to another host after restart
+		if leader, err = getLeader(dingocli, dcs); err != nil {
+			return err
+		}
+	}
+
+	// 6) remove state if all hosts of the cluster are upgraded, services may be filtered
+	finished := true
+	for _, host := range allHosts {
+		finished = finished && state.isUpgraded(host)
+	}
+	if finished {
+		if err := os.Remove(upgradeStatePath(dingocli)); err != nil && !os.IsNotExist(err) {
+			return errno.ERR_SAVE_UPGRADE_STATE_FAILED.E(err)
+		}
+	}
+	dingocli.WriteOutln("")
+	dingocli.WriteOutln(color.GreenString("Upgrade %d hosts to %s success :)", len(nodes), version))
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package cluster
+
+import (
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	compmgr "github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/playbook"
+	task "github.com/dingodb/dingocli/internal/task/task/common"
+)
+
+const (
+	INSTALL_COMPONENT_BINARY   = playbook.INSTALL_COMPONENT_BINARY
+	SYNC_SYSTEMD_CONFIG        = playbook.SYNC_SYSTEMD_CONFIG
+	CREATE_SYSTEMD_META_TABLES = playbook.CREATE_SYSTEMD_META_TABLES
+	START_SYSTEMD_SERVICE      = playbook.START_SYSTEMD_SERVICE
+	GET_SYSTEMD_LEADER         = playbook.GET_SYSTEMD_LEADER
+	CHECK_SYSTEMD_HEALTH       = playbook.CHECK_SYSTEMD_SERVICE_HEALTH
+)
+
+var (
+	// services run as systemd units with binaries of the local component repository
+	DINGOFS_SYSTEMD_DEPLOY_STEPS = []int{
+		INSTALL_COMPONENT_BINARY,
+		SYNC_SYSTEMD_CONFIG,
+		CREATE_SYSTEMD_META_TABLES,
+		START_SYSTEMD_SERVICE,
+	}
+
+	SYSTEMD_PRECHECK_STEPS = []int{
+		playbook.CHECK_TOPOLOGY,
+		playbook.CHECK_SSH_CONNECT,
+		playbook.GET_HOST_DATE,
+		playbook.CHECK_HOST_DATE,
+	}
+)
+
+func isSystemdDeploy(dcs []*topology.DeployConfig) bool {
+	return len(dcs) > 0 && dcs[0].GetDeployMode() == topology.DEPLOY_MODE_SYSTEMD
+}
+
+// all services should be in the same deploy mode and served by a component
+func checkDeployMode(dcs []*topology.DeployConfig) error {
+	mode := dcs[0].GetDeployMode()
+	if mode != topology.DEPLOY_MODE_CONTAINER && mode != topology.DEPLOY_MODE_SYSTEMD {
+		return errno.ERR_UNSUPPORT_DEPLOY_MODE.F("deploy_mode: %s", mode)
+	}
+	for _, dc := range dcs {
+		if dc.GetDeployMode() != mode {
+			return errno.ERR_UNSUPPORT_DEPLOY_MODE.
+				F("deploy_mode of %s is %s, but others are %s", dc.GetId(), dc.GetDeployMode(), mode)
+		}
+		if _, ok := task.SYSTEMD_ROLE_COMPONENTS[dc.GetRole()]; mode == topology.DEPLOY_MODE_SYSTEMD && !ok {
+			return errno.ERR_ROLE_NOT_SUPPORTED_BY_SYSTEMD.F("role: %s", dc.GetRole())
+		}
+	}
+	return nil
+}
+
+// installComponents returns local binary of every component and version used by the services,
+// installed version is used if exists, otherwise it is downloaded.
+// version overrides component_version of the services if it is not empty
+func installComponents(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, version string) (map[string]string, error) {
+	componentManager, err := compmgr.NewComponentManager()
+	if err != nil {
+		return nil, errno.ERR_INSTALL_COMPONENT_BINARY_FAILED.E(err)
+	}
+
+	binaries := map[string]string{}
+	for _, dc := range dcs {
+		name := task.SYSTEMD_ROLE_COMPONENTS[dc.GetRole()]
+		wanted := dc.GetComponentVersion()
+		if len(version) > 0 {
+			wanted = version
+		}
+		key := task.ComponentBinaryKey(name, wanted)
+		if _, ok := binaries[key]; ok {
+			continue
+		}
+
+		found, _, err := componentManager.FindVersion(name, wanted)
+		if err != nil {
+			return nil, errno.ERR_INSTALL_COMPONENT_BINARY_FAILED.
+				F("find %s:%s failed: %v", name, wanted, err)
+		}
+		component, err := componentManager.FindInstallComponent(name, found)
+		if err != nil {
+			dingocli.WriteOutln("Install %s:%s to local component repository...", name, found)
+			if component, err = componentManager.InstallComponent(name, found); err != nil {
+				return nil, errno.ERR_INSTALL_COMPONENT_BINARY_FAILED.
+					F("install %s:%s failed: %v", name, found, err)
+			}
+		}
+		binaries[key] = filepath.Join(component.Path, component.Name)
+	}
+	return binaries, nil
+}
+
+func genSystemdDeployPlaybook(dingocli *cli.DingoCli, dcs []*topology.DeployConfig) (*playbook.Playbook, error) {
+	binaries, err := installComponents(dingocli, dcs, "")
+	if err != nil {
+		return nil, err
+	}
+
+	pb := playbook.NewPlaybook(dingocli)
+	for _, step := range DINGOFS_SYSTEMD_DEPLOY_STEPS {
+		config := dcs
+		if len(DEPLOY_FILTER_ROLE[step]) > 0 {
+			config = dingocli.FilterDeployConfigByRole(config, DEPLOY_FILTER_ROLE[step])
+		}
+		if DEPLOY_LIMIT_SERVICE[step] > 0 && len(config) > DEPLOY_LIMIT_SERVICE[step] {
+			config = config[:DEPLOY_LIMIT_SERVICE[step]]
+		}
+		if len(config) == 0 {
+			continue
+		}
+
+		pb.AddStep(&playbook.PlaybookStep{
+			Type:    step,
+			Configs: config,
+			Options: map[string]interface{}{
+				comm.KEY_COMPONENT_BINARIES: binaries,
+			},
+		})
+	}
+	return pb, nil
+}
@@ -29,6 +29,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	UPGRADE_EXAMPLE = `Examples:
+  $ dingo cluster upgrade                                    # Upgrade all services one by one
+  $ dingo cluster upgrade --version v1.5.0                   # Rolling upgrade services deployed by systemd to v1.5.0
+  $ dingo cluster upgrade --version v1.5.0 --pause-on-error  # Pause for retry if a host failed to upgrade`
+)
+
 var (
 	UPGRADE_PLAYBOOK_STEPS = []int{
 		// TODO(P0): we can skip it for upgrade one service more than once
@@ -60,20 +67,24 @@ type upgradeOptions struct {
 	host          string
 	force         bool
 	useLocalImage bool
+	version       string
+	pauseOnError  bool
 }
 
 func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options upgradeOptions
 
 	cmd := &cobra.Command{
-		Use:   "upgrade [OPTIONS]",
-		Short: "Upgrade cluster",
-		Args:  cliutil.NoArgs,
+		Use:     "upgrade [OPTIONS]",
+		Short:   "Upgrade cluster",
+		Args:    cliutil.NoArgs,
+		Example: UPGRADE_EXAMPLE,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return checkCommonOptions(dingocli, options.id, options.role, options.host)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runUpgrade(dingocli, options)
+			cliutil.ReadCommandConfig(cmd)
+			return runUpgrade(cmd, dingocli, options)
 		},
 		DisableFlagsInUseLine: true,
 	}
@@ -84,6 +95,14 @@ func NewUpgradeCommand(dingocli *cli.DingoCli) *cobra.Command {
 	flags.StringVar(&options.host, "host", "*", "Specify service host")
 	flags.BoolVarP(&options.force, "force", "f", false, "Never prompt")
 	flags.BoolVar(&options.useLocalImage, "local", false, "Use local image")
+	flags.StringVar(&options.version, "version", "", "Specify component version to upgrade to, only for systemd deploy mode")
+	flags.BoolVar(&options.pauseOnError, "pause-on-error", false, "Pause and wait for retry if a host failed to upgrade")
+
+	// rpc flags for draining cache members
+	cliutil.AddConfigFileFlag(cmd)
+	cliutil.AddDurationFlag(cmd, cliutil.RPCTIMEOUT, "RPC timeout")
+	cliutil.AddDurationFlag(cmd, cliutil.RPCRETRYDElAY, "RPC retry delay")
+	cliutil.AddUint32Flag(cmd, cliutil.RPCRETRYTIMES, "RPC retry times")
 
 	return cmd
 }
@@ -214,13 +233,20 @@ func upgradeOneByOne(dingocli *cli.DingoCli, dcs []*topology.DeployConfig, optio
 	return nil
 }
 
-func runUpgrade(dingocli *cli.DingoCli, options upgradeOptions) error {
+func runUpgrade(cmd *cobra.Command, dingocli *cli.DingoCli, options upgradeOptions) error {
 	// 1) parse cluster topology
 	dcs, err := dingocli.ParseTopology()
 	if err != nil {
 		return err
 	}
 
+	// services deployed by systemd are upgraded host by host
+	if isSystemdDeploy(dcs) {
+		return runRollingUpgrade(cmd, dingocli, dcs, options)
+	} else if len(options.version) > 0 {
+		return errno.ERR_UNSUPPORT_DEPLOY_MODE.F("--version requires deploy_mode: %s", topology.DEPLOY_MODE_SYSTEMD)
+	}
+
 	// 2) filter deploy config
 	dcs = dingocli.FilterDeployConfig(dcs, topology.FilterOption{
 		Id:   options.id,
@@ -20,18 +20,27 @@ import (
 	"fmt"
 
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/auth"
 	"github.com/dingodb/dingocli/cli/command/cache"
 	"github.com/dingodb/dingocli/cli/command/cluster"
 	"github.com/dingodb/dingocli/cli/command/component"
 	"github.com/dingodb/dingocli/cli/command/config"
+	"github.com/dingodb/dingocli/cli/command/debug"
 	"github.com/dingodb/dingocli/cli/command/fs"
 	"github.com/dingodb/dingocli/cli/command/hosts"
 	"github.com/dingodb/dingocli/cli/command/mds"
 	"github.com/dingodb/dingocli/cli/command/monitor"
 	"github.com/dingodb/dingocli/cli/command/nfs"
+	"github.com/dingodb/dingocli/cli/command/playground"
+	rpccommand "github.com/dingodb/dingocli/cli/command/rpc"
 	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/profile"
+	"github.com/dingodb/dingocli/internal/rpc"
 	tools "github.com/dingodb/dingocli/internal/tools/upgrade"
+	"github.com/dingodb/dingocli/internal/tracing"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
 	"github.com/spf13/cobra"
 )
 
@@ -41,6 +50,7 @@ var dingoExample = `Examples:
   $ dingo cache start --id=<UUID>          # Start dingo-cache
   $ dingo mds start --conf ./mds.conf      # Start mds with specified config file
   $ dingo cluster add c1                   # Add a cluster named 'c1'
+  $ dingo --context prod fs list           # List filesystems with context 'prod'
   $ dingo cluster deploy                   # Deploy current cluster
   $ dingo cluster stop                     # Stop current cluster service
   $ dingo cluster clean                    # Clean current cluster
@@ -71,23 +81,28 @@ func addSubCommands(cmd *cobra.Command, dingocli *cli.DingoCli) {
 	)
 
 	cmd.AddCommand(
-		cluster.NewClusterCommand(dingocli),     // dingocli cluster ...
-		config.NewConfigCommand(dingocli),       // dingocli config ...
-		hosts.NewHostsCommand(dingocli),         // dingocli hosts ...
-		monitor.NewMonitorCommand(dingocli),     // dingocli monitor ...
-		cache.NewCacheCommand(dingocli),         // dingocli cache ...
-		nfs.NewNFSCommand(dingocli),             // dingocli export...
-		mds.NewMDSCommand(dingocli),             // dingocli mds ...
-		fs.NewFSCommand(dingocli),               // dingocli fs ...
-		component.NewComponentCommand(dingocli), // dingocli component ...
-
-		NewAuditCommand(dingocli),      // dingocli audit
+		cluster.NewClusterCommand(dingocli),       // dingocli cluster ...
+		config.NewConfigCommand(dingocli),         // dingocli config ...
+		hosts.NewHostsCommand(dingocli),           // dingocli hosts ...
+		monitor.NewMonitorCommand(dingocli),       // dingocli monitor ...
+		cache.NewCacheCommand(dingocli),           // dingocli cache ...
+		nfs.NewNFSCommand(dingocli),               // dingocli export...
+		mds.NewMDSCommand(dingocli),               // dingocli mds ...
+		fs.NewFSCommand(dingocli),                 // dingocli fs ...
+		component.NewComponentCommand(dingocli),   // dingocli component ...
+		auth.NewAuthCommand(dingocli),             // dingocli auth ...
+		rpccommand.NewRpcCommand(dingocli),        // dingocli rpc ...
+		debug.NewDebugCommand(dingocli),           // dingocli debug ...
+		NewAuditCommand(dingocli),                 // dingocli audit ...
+		playground.NewPlaygroundCommand(dingocli), // dingocli playground ...
+
 		NewCompletionCommand(dingocli), // dingocli completion
 		NewEnterCommand(dingocli),      // dingocli enter
 		NewExecCommand(dingocli),       // dingocli exec
 		// commonly used shorthands
 		NewSSHCommand(dingocli),      // dingocli ssh
 		NewPlaybookCommand(dingocli), // dingocli playbook
+		NewServeCommand(dingocli),    // dingocli serve
 	)
 }
 
@@ -103,6 +118,16 @@ http://www.apache.org/licenses/LICENSE-2.0
 	cliutil.SetErr(cmd, dingocli)
 }
 
+// dingo config ...
+func isConfigCommand(cmd *cobra.Command) bool {
+	for ; cmd.HasParent(); cmd = cmd.Parent() {
+		if !cmd.Parent().HasParent() {
+			return cmd.Name() == "config"
+		}
+	}
+	return false
+}
+
 func NewDingoCliCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options rootOptions
 
@@ -124,6 +149,64 @@ func NewDingoCliCommand(dingocli *cli.DingoCli) *cobra.Command {
 			return fmt.Errorf("dingo: '%s' is not a dingo command.\n"+
 				"See 'dingo --help'", args[0])
 		},
+		// per-command defaults in configuration file, e.g. fs.warmup.daemon: true
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			startCommandSpan(cmd)
+			if dir, _ := cmd.Flags().GetString(profile.FLAG_PROFILE_CLI); dir != "" {
+				if err := profile.Enable(cmd.CommandPath(), dir); err != nil {
+					return err
+				}
+			}
+			// configuration file is parsed once for settings used before ReadCommandConfig,
+			// config commands check and fix a broken configuration file, so it is not applied to them
+			endApply := profile.Start(profile.PHASE_CONFIG, "apply command config")
+			err := cliutil.LoadEarlyConfig(cmd)
+			configCommand := isConfigCommand(cmd)
+			if err == nil && !configCommand {
+				err = cliutil.ApplyCommandConfig(cmd)
+			}
+			endApply()
+			if err != nil && !configCommand {
+				return err
+			}
+			if err := cliutil.SetupLogger(cmd); err != nil && !configCommand {
+				return err
+			}
+			dryRun, _ := cmd.Flags().GetBool(cli.FLAG_DRY_RUN)
+			dingocli.SetDryRun(dryRun)
+			yes, _ := cmd.Flags().GetBool(cliutil.ASSUME_YES)
+			cliutil.SetAssumeYes(yes)
+			colorMode, _ := cmd.Flags().GetString(output.FLAG_COLOR)
+			if err := output.SetColorMode(colorMode); err != nil {
+				return err
+			}
+			if err := setOutputOptions(cmd); err != nil {
+				return err
+			}
+			debugRpc, _ := cmd.Flags().GetString(rpc.FLAG_DEBUG_RPC)
+			if err := rpc.StartDebugRpc(debugRpc); err != nil {
+				return err
+			}
+			rpc.SetVersionCheck(cmd)
+			// token of the context is attached to rpc requests
+			rpc.SetAuthLoader(auth.AuthLoader(dingocli, cmd))
+			// errors are printed as json envelope by Execute
+			cmd.Root().SilenceErrors = output.IsJsonError()
+			// stdout is redirected to file, so pager is not started
+			if filename, _ := cmd.Flags().GetString(output.FLAG_OUTPUT_FILE); filename != "" {
+				if err := output.StartOutputFile(filename); err != nil {
+					return err
+				}
+			}
+			if pager := cliutil.GetPagerCommand(cmd); pager != "" {
+				if err := output.StartPager(pager); err != nil {
+					logger.Warnf("start pager '%s' failed: %v", pager, err)
+				}
+				// commands failed by CheckErr exit without returning to Execute
+				cliutil.AddExitHook(output.StopPager)
+			}
+			return nil
+		},
 		SilenceUsage:          true, // silence usage when an error occurs
 		DisableFlagsInUseLine: true,
 	}
@@ -133,9 +216,66 @@ func NewDingoCliCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.Flags().BoolVarP(&options.debug, "debug", "d", false, "Print debug information")
 	cmd.Flags().BoolVarP(&options.upgrade, "upgrade", "u", false, "Upgrade dingo itself to the latest version")
 	cmd.Flags().StringVar(&options.branch, "branch", "", "Branch to upgrade from (default: main)")
+	cmd.PersistentFlags().String(cliutil.CONTEXT, "", "Use the named context in configuration file")
+	cmd.PersistentFlags().Bool(cli.FLAG_DRY_RUN, false, "Report destructive actions without executing them")
+	cmd.PersistentFlags().BoolP(cliutil.ASSUME_YES, "y", false, "Assume yes to all confirmation prompts")
+	cmd.PersistentFlags().String(output.FLAG_COLOR, output.COLOR_AUTO, "When to colorize output (auto|always|never), NO_COLOR disables auto color")
+	cmd.PersistentFlags().String(output.FLAG_OUTPUT_FILE, "", "Write output to file atomically, progress is still shown on terminal")
+	cmd.PersistentFlags().Bool(cliutil.NO_PAGER, false, "Do not pipe long output into a pager")
+	cmd.PersistentFlags().String(rpc.FLAG_DEBUG_RPC, "", "Dump rpc requests and responses to stderr, or to the file of --debug-rpc=FILE")
+	cmd.PersistentFlags().Lookup(rpc.FLAG_DEBUG_RPC).NoOptDefVal = rpc.DEBUG_RPC_STDERR
+	cmd.PersistentFlags().Bool(rpc.FLAG_SKIP_VERSION_CHECK, false, "Do not check version of mds before requests")
+	// for diagnosing slow commands, not shown in help
+	cmd.PersistentFlags().String(profile.FLAG_PROFILE_CLI, "", "Print time spent in config, rpc and io at exit, and write pprof files into --profile-cli=DIR")
+	cmd.PersistentFlags().Lookup(profile.FLAG_PROFILE_CLI).NoOptDefVal = profile.PROFILE_NO_PPROF
+	cmd.PersistentFlags().MarkHidden(profile.FLAG_PROFILE_CLI)
+	cliutil.AddLogFlags(cmd)
 
 	addSubCommands(cmd, dingocli)
+	registerFlagCompletions(cmd)
 	setupRootCommand(cmd, dingocli)
 
 	return cmd
 }
+
+// root span of the command, exported by tracing.Finish if global.otel.endpoint is set
+func startCommandSpan(cmd *cobra.Command) {
+	span := tracing.StartCommand(cmd.CommandPath(), cli.Version)
+	for _, name := range []string{"fsname", "fsid", cliutil.DINGOFS_MDSADDR, cliutil.CONTEXT} {
+		if flag := cmd.Flags().Lookup(name); flag != nil && flag.Changed {
+			span.SetAttribute("dingo."+name, flag.Value.String())
+		}
+	}
+}
+
+// --format, --columns and --no-headers of list-style commands
+func setOutputOptions(cmd *cobra.Command) error {
+	format, _ := cmd.Flags().GetString(cliutil.FORMAT)
+	columns, _ := cmd.Flags().GetStringSlice(cliutil.COLUMNS)
+	noHeaders, _ := cmd.Flags().GetBool(cliutil.NO_HEADERS)
+	filters, _ := cmd.Flags().GetStringArray(cliutil.FILTER)
+	sortBy, _ := cmd.Flags().GetString(cliutil.SORT_BY)
+	limit := 0
+	// commands like component list have --limit of their own
+	if cmd.Annotations[cliutil.ANNOTATION_LIST_LIMIT] == "true" {
+		limit, _ = cmd.Flags().GetInt(cliutil.LIMIT)
+	}
+	template := ""
+	// command outputs json result, which is rendered by the template in internal/output
+	if output.IsTemplateFormat(format) {
+		template, format = format, cliutil.FORMAT_JSON
+		if err := cmd.Flags().Set(cliutil.FORMAT, format); err != nil {
+			return err
+		}
+	}
+	output.SetOptions(output.Options{
+		Format:    format,
+		Columns:   columns,
+		NoHeaders: noHeaders,
+		Template:  template,
+		Filters:   filters,
+		SortBy:    sortBy,
+		Limit:     limit,
+	})
+	return nil
+}
@@ -21,6 +21,8 @@ import (
 	"os"
 
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/rpc"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -87,3 +89,13 @@ PowerShell:
 
 	return completionCmd
 }
+
+// register dynamic completion of flags shared by many commands, e.g. --fsname
+func registerFlagCompletions(cmd *cobra.Command) {
+	if flag := cmd.Flags().Lookup(cliutil.DINGOFS_FSNAME); flag != nil {
+		cmd.RegisterFlagCompletionFunc(cliutil.DINGOFS_FSNAME, rpc.CompleteFsName)
+	}
+	for _, c := range cmd.Commands() {
+		registerFlagCompletions(c)
+	}
+}
@@ -18,6 +18,7 @@ package component
 
 import (
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/component/mirror"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -36,6 +37,7 @@ func NewComponentCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewUninstallCommand(dingocli),
 		NewUseCommand(dingocli),
 		NewUpdateCommand(dingocli),
+		mirror.NewMirrorCommand(dingocli),
 	)
 
 	return cmd
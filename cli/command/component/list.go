@@ -19,10 +19,15 @@ package component
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
 	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
 	"github.com/dingodb/dingocli/internal/utils"
 
 	"github.com/spf13/cobra"
@@ -35,12 +40,24 @@ const (
 
    # list all installed components
    $ dingo component list --installed
+
+   # list the latest 10 commit builds within 30 days
+   $ dingo component list --since 720h --limit 10
+
+   # list name and version of installed components without header
+   $ dingo component list --installed --columns name,version --no-headers
+
+   # list installed components sorted by release time, newest first
+   $ dingo component list --filter installed=Yes* --sort-by -release -v
    `
 )
 
 type listOptions struct {
 	verbose   bool
 	installed bool
+	since     string
+	limit     int
+	format    string
 }
 
 func NewListCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -52,6 +69,7 @@ func NewListCommand(dingocli *cli.DingoCli) *cobra.Command {
 		Args:    utils.ExactArgs(0),
 		Example: COMPONENT_LIST_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
 
 			return runList(cmd, dingocli, options)
 		},
@@ -63,6 +81,11 @@ func NewListCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 	cmd.Flags().BoolVarP(&options.verbose, "verbose", "v", false, "Show more component info")
 	cmd.Flags().BoolVar(&options.installed, "installed", false, "List all installed components")
+	cmd.Flags().StringVar(&options.since, "since", "", "List commit builds since date or duration, e.g. 2025-01-01 or 720h")
+	cmd.Flags().IntVar(&options.limit, "limit", 0, "List at most N latest commit builds per component")
+	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+	utils.EnablePager(cmd)
 
 	return cmd
 }
@@ -73,6 +96,18 @@ func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) er
 		return err
 	}
 
+	filter := component.CommitFilter{Limit: options.limit}
+	if len(options.since) > 0 {
+		if filter.Since, err = component.ParseSince(options.since); err != nil {
+			return err
+		}
+	}
+	if !filter.IsEmpty() {
+		if err := componentManager.SetCommitFilter(filter); err != nil {
+			return err
+		}
+	}
+
 	components, err := componentManager.ListComponents()
 	if err != nil {
 		return err
@@ -87,29 +122,73 @@ func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) er
 }
 
 func FormatOutput(components []*component.Component, options listOptions) error {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	if options.verbose {
-		fmt.Fprintln(w, "Name\tVersion\tInstalled\tRelease\tCommit\tActive\tPath")
-		fmt.Fprintln(w, "----\t-------\t---------\t-------\t------\t------\t----")
-	} else {
-		fmt.Fprintln(w, "Name\tVersion\tInstalled\tCommit\tActive")
-		fmt.Fprintln(w, "----\t-------\t---------\t------\t------")
-	}
-
+	filtered := []*component.Component{}
 	for _, comp := range components {
 		if options.installed && !comp.IsInstalled {
 			continue
 		}
+		filtered = append(filtered, comp)
+	}
 
-		installText := utils.Ternary(comp.IsInstalled, fmt.Sprintf("Yes%s", utils.Ternary(comp.Updatable, "(U)", "")), "")
-		activeText := utils.Ternary(comp.IsInstalled && comp.IsActive, "Yes", "")
+	if options.format == utils.FORMAT_JSON {
+		return output.OutputJson(&common.OutputResult{Error: errno.ERR_OK, Result: filtered})
+	}
 
-		if options.verbose {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", comp.Name, comp.Version, installText, comp.Release, comp.Commit, activeText, comp.Path)
-		} else {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", comp.Name, comp.Version, installText, comp.Commit, activeText)
+	// columns can be filtered and sorted by all fields, only shown fields are printed
+	allHeader := []string{"Name", "Version", "Installed", "Release", "Commit", "Active", "Path"}
+	header := allHeader
+	if !options.verbose && len(output.GetOptions().Columns) == 0 {
+		header = []string{"Name", "Version", "Installed", "Commit", "Active"}
+	}
+	rows := [][]string{}
+	for _, comp := range filtered {
+		row := map[string]string{
+			"Name":      comp.Name,
+			"Version":   comp.Version,
+			"Installed": installedText(comp),
+			"Release":   comp.Release,
+			"Commit":    comp.Commit,
+			"Active":    activeText(comp),
+			"Path":      comp.Path,
 		}
+		rows = append(rows, table.Map2List(row, allHeader))
+	}
+	rows, err := output.ProcessRows(allHeader, rows)
+	if err != nil {
+		return err
+	}
+	_, rows, _ = output.SelectColumns(allHeader, rows, header)
+
+	switch options.format {
+	case utils.FORMAT_TABLE, utils.FORMAT_CSV, utils.FORMAT_TSV:
+		return output.RenderRows(os.Stdout, header, rows)
+	}
+
+	header, rows, err = output.SelectColumns(header, rows, output.GetOptions().Columns)
+	if err != nil {
+		return err
+	}
+	// plain output has the same --columns and --no-headers as other formats
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if !output.GetOptions().NoHeaders {
+		separators := []string{}
+		for _, column := range header {
+			separators = append(separators, strings.Repeat("-", len(column)))
+		}
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		fmt.Fprintln(w, strings.Join(separators, "\t"))
+	}
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
 	}
 
 	return w.Flush()
 }
+
+func installedText(comp *component.Component) string {
+	return utils.Ternary(comp.IsInstalled, fmt.Sprintf("Yes%s", utils.Ternary(comp.Updatable, "(U)", "")), "")
+}
+
+func activeText(comp *component.Component) string {
+	return utils.Ternary(comp.IsInstalled && comp.IsActive, "Yes", "")
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mirror
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	MIRROR_CLONE_EXAMPLE = `Examples:
+   # clone all stable versions of all components
+   $ dingo component mirror clone --target /data/mirror
+
+   # clone specified components and versions, include main branch build
+   $ dingo component mirror clone --target /data/mirror --component dingo-client,dingo-mds --version v3.0.5 --main
+
+   # refresh mirror periodically (unchanged builds are skipped)
+   $ crontab -e
+   0 2 * * * dingo component mirror clone --target /data/mirror --main
+
+   # use local mirror
+   $ DINGOFS_MIRROR=http://mirror-host:8080 dingo component install dingo-client`
+)
+
+type cloneOptions struct {
+	target     string
+	source     string
+	components []string
+	versions   []string
+	main       bool
+	commits    bool
+}
+
+func NewCloneCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options cloneOptions
+
+	cmd := &cobra.Command{
+		Use:     "clone [OPTIONS]",
+		Short:   "clone components from upstream mirror into local directory",
+		Args:    utils.NoArgs,
+		Example: MIRROR_CLONE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runClone(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	cmd.Flags().StringVar(&options.target, "target", "", "Local directory to store mirror")
+	cmd.Flags().StringVar(&options.source, "source", component.Mirror_URL, "Upstream mirror url")
+	cmd.Flags().StringSliceVar(&options.components, "component", nil, "Components to clone (default all)")
+	cmd.Flags().StringSliceVar(&options.versions, "version", nil, "Versions to clone (default all stable versions)")
+	cmd.Flags().BoolVar(&options.main, "main", false, "Clone main branch build")
+	cmd.Flags().BoolVar(&options.commits, "commits", false, "Clone all commit builds")
+	cmd.MarkFlagRequired("target")
+
+	return cmd
+}
+
+func runClone(cmd *cobra.Command, dingocli *cli.DingoCli, options cloneOptions) error {
+	for _, name := range options.components {
+		if !utils.Contains(component.ALL_COMPONENTS, name) {
+			return fmt.Errorf("unknown component: %s", name)
+		}
+	}
+
+	results, err := component.CloneMirror(options.source, utils.AbsPath(options.target), component.MirrorOptions{
+		Components:     options.components,
+		Versions:       options.versions,
+		IncludeMain:    options.main,
+		IncludeCommits: options.commits,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Name\tVersion\tStatus\tPath")
+	fmt.Fprintln(w, "----\t-------\t------\t----")
+	for _, result := range results {
+		status := utils.Ternary(result.Downloaded, "downloaded", "unchanged")
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.Name, result.Version, status, result.Path)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Mirror cloned to %s ^_^!\n", options.target)
+	return nil
+}
@@ -18,7 +18,6 @@ package component
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
 
 	"github.com/dingodb/dingocli/cli/cli"
@@ -47,10 +46,11 @@ func NewUninstallCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options uninstallOptions
 
 	cmd := &cobra.Command{
-		Use:     "uninstall <component1><:version> [OPTIONS]",
-		Short:   "uninstall components",
-		Args:    utils.ExactArgs(1),
-		Example: COMPONENT_UN_EXAMPLE,
+		Use:               "uninstall <component1><:version> [OPTIONS]",
+		Short:             "uninstall components",
+		Args:              utils.ExactArgs(1),
+		Example:           COMPONENT_UN_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(completeInstalledComponents),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			options.component = args[0]
 
@@ -76,34 +76,79 @@ func runUninstall(cmd *cobra.Command, dingocli *cli.DingoCli, options *uninstall
 	}
 	name, version := component.ParseComponentVersion(options.component)
 
-	if options.all {
-		if version != "" {
-			return fmt.Errorf("cannot specify version when --all is set")
-		}
+	targets, err := findUninstallComponents(componentManager, name, version, options)
+	if err != nil {
+		return err
+	}
+
+	if options.all && !dingocli.IsDryRun() && !utils.Confirm("Are you sure to uninstall all versions of %s?", name) {
+		return fmt.Errorf("abort uninstall component %s", name)
+	}
 
-		removedComponents, err := componentManager.RemoveComponents(name, true)
+	for _, comp := range targets {
+		filename := filepath.Join(comp.Path, comp.Name)
+		action := cli.NewAction(cli.ACTION_FILE, "remove %s (%s:%s)", filename, comp.Name, comp.Version)
+		err := dingocli.Perform(action, func() error {
+			return componentManager.RemoveComponent(comp.Name, comp.Version, true, false)
+		})
 		if err != nil {
 			return err
 		}
+	}
+	action := cli.NewAction(cli.ACTION_FILE, "update installed components list")
+	if err := dingocli.Perform(action, componentManager.SaveInstalledComponents); err != nil {
+		return err
+	}
+	if dingocli.IsDryRun() {
+		return nil
+	}
 
+	if options.all {
 		fmt.Printf("Successfully removed components: \n")
-		for _, comp := range removedComponents {
-			os.Remove(filepath.Join(comp.Path, comp.Name))
+		for _, comp := range targets {
 			fmt.Printf("  %s:%s \n", comp.Name, comp.Version)
 		}
-
 		return nil
 	}
 
+	fmt.Printf("Successfully removed component: %s:%s\n", name, version)
+
+	return nil
+}
+
+// find installed components to uninstall, the active component is only removed with --all or --force
+func findUninstallComponents(componentManager *component.ComponentManager, name, version string, options *uninstallOptions) ([]*component.Component, error) {
+	if options.all {
+		if version != "" {
+			return nil, fmt.Errorf("cannot specify version when --all is set")
+		}
+
+		components, err := componentManager.ListComponents()
+		if err != nil {
+			return nil, err
+		}
+		targets := []*component.Component{}
+		for _, comp := range components {
+			if comp.Name == name && componentManager.IsInstalled(comp.Name, comp.Version) {
+				targets = append(targets, comp)
+			}
+		}
+		if len(targets) == 0 {
+			return nil, fmt.Errorf("component %s not installed", name)
+		}
+		return targets, nil
+	}
+
 	if version == "" {
-		return fmt.Errorf("Must be specify version to uninstall")
+		return nil, fmt.Errorf("Must be specify version to uninstall")
 	}
-	// remove one component
-	if err := componentManager.RemoveComponent(name, version, options.force, true); err != nil {
-		return err
+	comp, err := componentManager.FindInstallComponent(name, version)
+	if err != nil {
+		return nil, fmt.Errorf("component %s:%s not installed", name, version)
+	}
+	if comp.IsActive && !options.force {
+		return nil, fmt.Errorf("cannot remove active component %s, please set another version as default or use --force to remove", name)
 	}
 
-	fmt.Printf("Successfully removed component: %s:%s\n", name, version)
-
-	return nil
+	return []*component.Component{comp}, nil
 }
@@ -18,6 +18,7 @@ package component
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/component"
@@ -41,10 +42,11 @@ func NewUseCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options useOptions
 
 	cmd := &cobra.Command{
-		Use:     "use <component1>:[version] [OPTIONS]",
-		Short:   "set default version",
-		Args:    utils.ExactArgs(1),
-		Example: COMPONENT_USE_EXAMPLE,
+		Use:               "use <component1>:[version] [OPTIONS]",
+		Short:             "set default version",
+		Args:              utils.ExactArgs(1),
+		Example:           COMPONENT_USE_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(completeInstalledComponents),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			options.component = args[0]
 
@@ -79,3 +81,27 @@ func runUse(cmd *cobra.Command, dingocli *cli.DingoCli, options *useOptions) err
 
 	return nil
 }
+
+// complete installed components as name:version
+func completeInstalledComponents(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	componentManager, err := component.NewComponentManager()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	components, err := componentManager.ListComponents()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	completions := []string{}
+	for _, comp := range components {
+		if !componentManager.IsInstalled(comp.Name, comp.Version) {
+			continue
+		}
+		name := fmt.Sprintf("%s:%s", comp.Name, comp.Version)
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
@@ -35,6 +35,13 @@ func NewConfigCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewShowCommand(dingocli),
 		NewDiffCommand(dingocli),
 		NewCommitCommand(dingocli),
+		NewUseContextCommand(dingocli),
+		NewGetContextsCommand(dingocli),
+		NewCurrentContextCommand(dingocli),
+		NewEncryptCommand(dingocli),
+		NewDecryptCommand(dingocli),
+		NewValidateCommand(dingocli),
+		NewMigrateCommand(dingocli),
 	)
 	return cmd
 }
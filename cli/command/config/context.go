@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/tui"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	USE_CONTEXT_EXAMPLE = `Examples:
+  $ dingo config use-context staging   # Switch default context to 'staging'
+  $ dingo config get-contexts          # List all contexts
+  $ dingo --context prod fs list       # Use context 'prod' for one command`
+)
+
+func NewUseContextCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "use-context CONTEXT [OPTIONS]",
+		Short:   "Switch current context in configuration file",
+		Args:    cliutil.ExactArgs(1),
+		Example: USE_CONTEXT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUseContext(dingocli, cliutil.GetConfigFile(cmd), args[0])
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	cliutil.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+func runUseContext(dingocli *cli.DingoCli, confFile, name string) error {
+	if err := cliutil.SetCurrentContext(confFile, name); err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln("Switched to context '%s'", name)
+	return nil
+}
+
+func NewGetContextsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contexts [OPTIONS]",
+		Short: "List contexts in configuration file",
+		Args:  cliutil.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliutil.ReadCommandConfig(cmd)
+			return runGetContexts(cmd, dingocli)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	cliutil.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+func runGetContexts(cmd *cobra.Command, dingocli *cli.DingoCli) error {
+	contexts := cliutil.ListContexts()
+	if len(contexts) == 0 {
+		dingocli.WriteOutln("<no contexts>")
+		return nil
+	}
+
+	dingocli.WriteOut(tui.FormatContexts(contexts, cliutil.GetContextName(cmd)))
+	return nil
+}
+
+func NewCurrentContextCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-context [OPTIONS]",
+		Short: "Show current context",
+		Args:  cliutil.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliutil.ReadCommandConfig(cmd)
+			return runCurrentContext(cmd, dingocli)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	cliutil.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+func runCurrentContext(cmd *cobra.Command, dingocli *cli.DingoCli) error {
+	name := cliutil.GetContextName(cmd)
+	if name == "" {
+		return fmt.Errorf("current context is not set")
+	}
+
+	dingocli.WriteOutln("%s", name)
+	return nil
+}
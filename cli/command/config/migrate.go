@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package config
+
+import (
+	"os"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	tui "github.com/dingodb/dingocli/internal/tui/common"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	MIGRATE_EXAMPLE = `Examples:
+  $ dingo config migrate                      # Upgrade default configuration file to current schema
+  $ dingo config migrate --conf ./dingo.yaml  # Upgrade specified configuration file
+  $ dingo config migrate -f                   # Upgrade without confirmation`
+)
+
+type migrateOptions struct {
+	force bool
+}
+
+func NewMigrateCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options migrateOptions
+
+	cmd := &cobra.Command{
+		Use:     "migrate [OPTIONS]",
+		Short:   "Upgrade configuration file to current schema",
+		Args:    cliutil.NoArgs,
+		Example: MIGRATE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate(dingocli, cliutil.GetConfigFile(cmd), options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&options.force, "force", "f", false, "Write configuration file without confirmation")
+	cliutil.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+func runMigrate(dingocli *cli.DingoCli, confFile string, options migrateOptions) error {
+	result, err := cliutil.MigrateConfigFile(confFile)
+	if err != nil {
+		return errno.ERR_PARSE_DINGOADM_CONFIGURE_FAILED.E(err)
+	}
+
+	if len(result.Changes) == 0 {
+		dingocli.WriteOutln("Configuration file %s is up to date (version %d)", confFile, result.ToVersion)
+		return nil
+	}
+
+	// 1) print changes and difference
+	dingocli.WriteOutln("Migrate %s from version %d to %d:", confFile, result.FromVersion, result.ToVersion)
+	for _, change := range result.Changes {
+		dingocli.WriteOutln("  - %s", change)
+	}
+	dingocli.WriteOutln("")
+	dingocli.Out().Write([]byte(cliutil.Diff(result.Original, result.Migrated)))
+	dingocli.WriteOutln("")
+
+	// 2) confirm by user
+	if !options.force {
+		if pass := tui.ConfirmYes("Do you want to continue?"); !pass {
+			dingocli.WriteOutln(tui.PromptCancelOpetation("migrate configuration file"))
+			return errno.ERR_CANCEL_OPERATION
+		}
+	}
+
+	// 3) backup and write configuration file
+	backup := confFile + ".bak"
+	if err := os.WriteFile(backup, []byte(result.Original), 0644); err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+	if err := os.WriteFile(confFile, []byte(result.Migrated), 0644); err != nil {
+		return errno.ERR_WRITE_FILE_FAILED.E(err)
+	}
+
+	dingocli.WriteOutln("Configuration file migrated, the original one is saved as %s", backup)
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ENCRYPT_EXAMPLE = `Examples:
+  $ dingo config encrypt --gen-key             # Generate secret key file and encrypt value read from stdin
+  $ dingo config encrypt my-secret-key         # Encrypt value, put output into dingo.yaml, e.g. sk: enc:xxxx
+  $ DINGO_SECRET=passphrase dingo config encrypt my-secret-key`
+
+	DECRYPT_EXAMPLE = `Examples:
+  $ dingo config decrypt enc:xxxx`
+)
+
+type encryptOptions struct {
+	genKey bool
+}
+
+func NewEncryptCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options encryptOptions
+
+	cmd := &cobra.Command{
+		Use:     "encrypt [VALUE] [OPTIONS]",
+		Short:   "Encrypt sensitive value for configuration file",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: ENCRYPT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEncrypt(dingocli, args, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.genKey, "gen-key", false, "Generate secret key file if not exist")
+
+	return cmd
+}
+
+func runEncrypt(dingocli *cli.DingoCli, args []string, options encryptOptions) error {
+	if options.genKey {
+		keyFile := cliutil.GetSecretKeyFile()
+		if !cliutil.IsFileExists(keyFile) {
+			if err := cliutil.GenerateSecretKeyFile(keyFile); err != nil {
+				return err
+			}
+			dingocli.WriteOutln("Generated secret key file %s", keyFile)
+		}
+	}
+
+	key, err := cliutil.LoadSecretKey()
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := readSecretValue(dingocli, args)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := cliutil.EncryptSecret(plaintext, key)
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln("%s", encrypted)
+	return nil
+}
+
+func NewDecryptCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "decrypt [VALUE]",
+		Short:   "Decrypt encrypted value in configuration file",
+		Args:    cliutil.RequiresMaxArgs(1),
+		Example: DECRYPT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDecrypt(dingocli, args)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	return cmd
+}
+
+func runDecrypt(dingocli *cli.DingoCli, args []string) error {
+	value, err := readSecretValue(dingocli, args)
+	if err != nil {
+		return err
+	}
+	if !cliutil.IsEncryptedSecret(value) {
+		return fmt.Errorf("value is not encrypted, expect prefix '%s'", cliutil.SECRET_PREFIX)
+	}
+
+	plaintext, err := cliutil.ResolveSecret(value)
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln("%s", plaintext)
+	return nil
+}
+
+// read value from argument, or from stdin to keep it out of shell history
+func readSecretValue(dingocli *cli.DingoCli, args []string) (string, error) {
+	if len(args) == 1 {
+		return args[0], nil
+	}
+
+	reader := bufio.NewReader(dingocli.In())
+	line, err := reader.ReadString('\n')
+	line = strings.TrimRight(line, "\r\n")
+	if len(line) == 0 {
+		return "", fmt.Errorf("no value specified: %v", err)
+	}
+	return line, nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	VALIDATE_EXAMPLE = `Examples:
+  $ dingo config validate                      # Validate default configuration file
+  $ dingo config validate --conf ./dingo.yaml  # Validate specified configuration file`
+)
+
+func NewValidateCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validate [OPTIONS]",
+		Short:   "Validate configuration file",
+		Args:    cliutil.NoArgs,
+		Example: VALIDATE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runValidate(cmd, dingocli, cliutil.GetConfigFile(cmd))
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	cliutil.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+func runValidate(cmd *cobra.Command, dingocli *cli.DingoCli, confFile string) error {
+	problems, err := cliutil.ValidateConfigFile(confFile, cmd.Root())
+	if err != nil {
+		return errno.ERR_PARSE_DINGOADM_CONFIGURE_FAILED.E(err)
+	}
+
+	errors := 0
+	for _, problem := range problems {
+		if problem.Level == cliutil.CONFIG_PROBLEM_ERROR {
+			errors++
+		}
+		location := confFile
+		if problem.Line > 0 { // line number is unavailable for toml
+			location = fmt.Sprintf("%s:%d", confFile, problem.Line)
+		}
+		if problem.Key == "" {
+			dingocli.WriteOutln("%s: %s: %s", location, problem.Level, problem.Message)
+		} else {
+			dingocli.WriteOutln("%s: %s: %s: %s", location, problem.Level, problem.Key, problem.Message)
+		}
+	}
+
+	if errors > 0 {
+		return errno.ERR_INVALID_DINGO_CONFIGURE_FILE.F("%d error(s), %d warning(s)", errors, len(problems)-errors)
+	}
+
+	dingocli.WriteOutln("Configuration file %s is valid (%d warning(s))", confFile, len(problems))
+	return nil
+}
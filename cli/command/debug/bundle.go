@@ -0,0 +1,383 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package debug
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/audit"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	BUNDLE_EXAMPLE = `Examples:
+   $ dingo debug bundle
+
+   # logs of the last 2 hours, and logs of a client started with default log dir
+   $ dingo debug bundle /tmp/bundle.tar.gz --since 2h --log-dir /var/log/dingofs`
+
+	BUNDLE_MANIFEST_FILE = "manifest.json"
+
+	BUNDLE_DEFAULT_SINCE      = 24 * time.Hour
+	BUNDLE_DEFAULT_LOG_SIZE   = "10MiB"
+	BUNDLE_DEFAULT_HISTORY    = 200
+	BUNDLE_FILE_MODE          = 0644
+	BUNDLE_MDS_STATUS_TIMEOUT = 10 * time.Second
+)
+
+type bundleOptions struct {
+	file       string
+	since      time.Duration
+	maxLogSize int64
+	logDirs    []string
+	history    int
+	noMDS      bool
+}
+
+// files and errors of collecting them, failed items never fail the bundle,
+// so a bundle can always be created from a broken environment
+type BundleManifest struct {
+	CreatedAt string            `json:"created_at"`
+	Hostname  string            `json:"hostname"`
+	Command   string            `json:"command"`
+	Files     []string          `json:"files"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+type BundleVersion struct {
+	Version   string `json:"version"`
+	CommitId  string `json:"commit_id"`
+	Branch    string `json:"branch"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+	Platform  string `json:"platform"`
+	Kernel    string `json:"kernel,omitempty"`
+}
+
+type BundleMDS struct {
+	Id       uint64 `json:"id"`
+	Addr     string `json:"addr"`
+	Version  string `json:"version"`
+	State    string `json:"state"`
+	Online   bool   `json:"online"`
+	LastSeen string `json:"last_online_time"`
+}
+
+type bundleWriter struct {
+	prefix   string
+	tw       *tar.Writer
+	manifest *BundleManifest
+}
+
+func NewBundleCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options bundleOptions
+
+	cmd := &cobra.Command{
+		Use:     "bundle [FILE] [OPTIONS]",
+		Short:   "Create a tar.gz of configuration, logs and status to attach to bug reports",
+		Args:    utils.RequiresMaxArgs(1),
+		Example: BUNDLE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.file = fmt.Sprintf("dingo-bundle-%s-%s.tar.gz", hostname(), time.Now().Format("20060102150405"))
+			if len(args) > 0 {
+				options.file = args[0]
+			}
+			options.since, _ = cmd.Flags().GetDuration("since")
+			maxLogSize, _ := cmd.Flags().GetString("max-log-size")
+			size, err := humanize.ParseBytes(maxLogSize)
+			if err != nil {
+				return fmt.Errorf("invalid --max-log-size %s: %v", maxLogSize, err)
+			}
+			options.maxLogSize = int64(size)
+			options.logDirs, _ = cmd.Flags().GetStringSlice("log-dir")
+			options.history, _ = cmd.Flags().GetInt("history")
+			options.noMDS, _ = cmd.Flags().GetBool("no-mds")
+
+			return runBundle(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Duration("since", BUNDLE_DEFAULT_SINCE, "Only collect logs modified in the duration")
+	cmd.Flags().String("max-log-size", BUNDLE_DEFAULT_LOG_SIZE, "Only collect the tail of larger log files")
+	cmd.Flags().StringSlice("log-dir", nil, "More directories of client logs to collect")
+	cmd.Flags().Int("history", BUNDLE_DEFAULT_HISTORY, "Number of recent commands to collect (0 means all)")
+	cmd.Flags().Bool("no-mds", false, "Do not collect status of mds, e.g. the cluster is not reachable")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return "unknown"
+	}
+	return name
+}
+
+// all contents are redacted like logs, secrets in files are never put into the bundle
+func runBundle(cmd *cobra.Command, dingocli *cli.DingoCli, options bundleOptions) error {
+	file, err := os.OpenFile(options.file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, BUNDLE_FILE_MODE)
+	if err != nil {
+		return errno.ERR_CREATE_BUNDLE_FAILED.E(err)
+	}
+	defer file.Close()
+	gw := gzip.NewWriter(file)
+	writer := &bundleWriter{
+		prefix: strings.TrimSuffix(filepath.Base(options.file), ".tar.gz"),
+		tw:     tar.NewWriter(gw),
+		manifest: &BundleManifest{
+			CreatedAt: time.Now().Format(time.RFC3339),
+			Hostname:  hostname(),
+			Command:   logger.Redact(strings.Join(os.Args, " ")),
+			Files:     []string{},
+			Errors:    map[string]string{},
+		},
+	}
+
+	spinner := output.NewSpinner("Collecting versions and configuration")
+	writer.addJSON("version.json", bundleVersion())
+	configFile := utils.GetConfigFile(cmd)
+	writer.addFile("config/"+filepath.Base(configFile), configFile, 0)
+	writer.addFile("config/"+component.INSTALLED_FILE, filepath.Join(component.RepostoryDir, component.INSTALLED_FILE), 0)
+	writer.addHistory(dingocli, options.history)
+
+	spinner.Describe("Collecting mountpoints and client logs")
+	logDirs := options.logDirs
+	if mountpoints, err := utils.GetDingoFSMountPoints(); err != nil {
+		writer.fail("mounts.json", err)
+	} else {
+		infos := []*utils.MountPointInfo{}
+		for _, mountpoint := range mountpoints {
+			info := utils.GetMountPointInfo(mountpoint)
+			if dir := info.LogDir(); dir != "" {
+				logDirs = append(logDirs, dir)
+			}
+			infos = append(infos, info)
+		}
+		writer.addJSON("mounts.json", infos)
+	}
+	writer.addFile("mountinfo", "/proc/self/mountinfo", 0)
+	for i, dir := range utils.RemoveDuplicates(logDirs) {
+		writer.addLogs(fmt.Sprintf("logs/client-%d", i+1), dir, options)
+	}
+	writer.addLogs("logs/dingo", dingocli.LogDir(), options)
+	if utils.PathExist(dingocli.CrashDir()) { // created on the first crash
+		writer.addLogs("crash", dingocli.CrashDir(), options)
+	}
+
+	if !options.noMDS {
+		spinner.Describe("Collecting status of mds")
+		mdses, err := bundleMDSStatus(cmd)
+		if err != nil {
+			writer.fail("mds.json", err)
+		} else {
+			writer.addJSON("mds.json", mdses)
+		}
+	}
+
+	spinner.Finish()
+
+	// manifest is the last one, so it records errors of all files
+	writer.addJSON(BUNDLE_MANIFEST_FILE, writer.manifest)
+	if err := writer.tw.Close(); err != nil {
+		return errno.ERR_CREATE_BUNDLE_FAILED.E(err)
+	}
+	if err := gw.Close(); err != nil {
+		return errno.ERR_CREATE_BUNDLE_FAILED.E(err)
+	}
+	if err := file.Close(); err != nil {
+		return errno.ERR_CREATE_BUNDLE_FAILED.E(err)
+	}
+
+	fmt.Printf("Created support bundle %s with %d files", options.file, len(writer.manifest.Files))
+	if len(writer.manifest.Errors) > 0 {
+		fmt.Printf(", %d items failed to collect, see %s", len(writer.manifest.Errors), BUNDLE_MANIFEST_FILE)
+	}
+	fmt.Println()
+	return nil
+}
+
+func bundleVersion() *BundleVersion {
+	version := &BundleVersion{
+		Version:   cli.Version,
+		CommitId:  cli.CommitId,
+		Branch:    cli.Branch,
+		BuildTime: cli.BuildTime,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+	if data, err := os.ReadFile("/proc/version"); err == nil {
+		version.Kernel = strings.TrimSpace(string(data))
+	}
+	return version
+}
+
+func bundleMDSStatus(cmd *cobra.Command) ([]*BundleMDS, error) {
+	mdsInfos, err := rpc.GetMDSList(cmd)
+	if err != nil {
+		return nil, err
+	}
+	versions, versionErrs := rpc.Batch(cmd, mdsInfos, func(mdsInfo *mds.MDS) (string, error) {
+		return rpc.GetMDSVersion(fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort()))
+	})
+	mdses := []*BundleMDS{}
+	for i, mdsInfo := range mdsInfos {
+		lastOnline := int64(mdsInfo.GetLastOnlineTimeMs())
+		mdses = append(mdses, &BundleMDS{
+			Id:       mdsInfo.GetId(),
+			Addr:     fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort()),
+			Version:  utils.Ternary(versionErrs[i] == nil, versions[i], ""),
+			State:    mdsInfo.GetState().String(),
+			Online:   mdsInfo.GetIsOnline(),
+			LastSeen: time.UnixMilli(lastOnline).Format(time.RFC3339),
+		})
+	}
+	return mdses, nil
+}
+
+func (w *bundleWriter) fail(name string, err error) {
+	w.manifest.Errors[name] = logger.Redact(err.Error())
+}
+
+func (w *bundleWriter) write(name string, data []byte, modTime time.Time) {
+	data = []byte(logger.Redact(string(data)))
+	header := &tar.Header{
+		Name:    filepath.Join(w.prefix, name),
+		Mode:    BUNDLE_FILE_MODE,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := w.tw.WriteHeader(header); err != nil {
+		w.fail(name, err)
+		return
+	}
+	if _, err := w.tw.Write(data); err != nil {
+		w.fail(name, err)
+		return
+	}
+	w.manifest.Files = append(w.manifest.Files, name)
+}
+
+func (w *bundleWriter) addJSON(name string, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		w.fail(name, err)
+		return
+	}
+	w.write(name, data, time.Now())
+}
+
+// only the last maxSize bytes of file if maxSize > 0
+func (w *bundleWriter) addFile(name, path string, maxSize int64) {
+	file, err := os.Open(path)
+	if err != nil {
+		w.fail(name, err)
+		return
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		w.fail(name, err)
+		return
+	}
+	if maxSize > 0 && info.Size() > maxSize {
+		if _, err := file.Seek(-maxSize, io.SeekEnd); err != nil {
+			w.fail(name, err)
+			return
+		}
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		w.fail(name, err)
+		return
+	}
+	w.write(name, data, info.ModTime())
+}
+
+// regular files under dir modified in --since, newest first
+func (w *bundleWriter) addLogs(name, dir string, options bundleOptions) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		w.fail(name, err)
+		return
+	}
+	since := time.Now().Add(-options.since)
+	files := []os.FileInfo{}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || info.ModTime().Before(since) {
+			continue
+		}
+		files = append(files, info)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().After(files[j].ModTime())
+	})
+	for _, info := range files {
+		w.addFile(filepath.Join(name, info.Name()), filepath.Join(dir, info.Name()), options.maxLogSize)
+	}
+}
+
+// commands are already redacted in audit log
+func (w *bundleWriter) addHistory(dingocli *cli.DingoCli, history int) {
+	entries, err := audit.Read(dingocli.AuditLogPath())
+	if err != nil {
+		w.fail("history.json", errno.ERR_READ_AUDIT_LOG_FAILED.E(err))
+		return
+	}
+	if history > 0 && history < len(entries) {
+		entries = entries[len(entries)-history:]
+	}
+	w.addJSON("history.json", entries)
+}
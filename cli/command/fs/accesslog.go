@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_ACCESSLOG_EXAMPLE = `Examples:
+   $ dingo fs accesslog /mnt/dingofs
+
+   # slow read and write only
+   $ dingo fs accesslog /mnt/dingofs --op read,write --min-latency 100ms
+
+   # failed operations only
+   $ dingo fs accesslog /mnt/dingofs --errors`
+
+	// virtual file in root of mountpoint served by client, like .stats,
+	// every line is an operation, e.g.
+	// 2026.01.15 08:26:11.003330 [uid:0,gid:0,pid:4403] write (17669,8666,4993160): OK <0.000010>
+	ACCESSLOG_FILE          = ".accesslog"
+	ACCESSLOG_RESULT_OK     = "OK"
+	ACCESSLOG_POLL_INTERVAL = 100 * time.Millisecond
+)
+
+// op, arguments, result and latency in seconds
+var accessLogRegex = regexp.MustCompile(`([a-z_]+)\s*\((.*)\)\s*:\s*(\S+).*<([0-9.]+)>\s*$`)
+
+type accesslogOptions struct {
+	mountpoint string
+	ops        []string
+	minLatency time.Duration
+	errors     bool
+}
+
+type accessLogEntry struct {
+	op      string
+	args    string
+	result  string
+	latency time.Duration
+}
+
+func NewFsAccesslogCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options accesslogOptions
+
+	cmd := &cobra.Command{
+		Use:               "accesslog MOUNTPOINT [OPTIONS]",
+		Short:             "Show operations of client in real time",
+		Args:              utils.ExactArgs(1),
+		Example:           FS_ACCESSLOG_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.mountpoint = args[0]
+
+			return runAccesslog(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().StringSliceVar(&options.ops, "op", nil, "Only show these operations, e.g. lookup,read,write")
+	cmd.Flags().DurationVar(&options.minLatency, "min-latency", 0, "Only show operations slower than it")
+	cmd.Flags().BoolVar(&options.errors, "errors", false, "Only show failed operations")
+
+	return cmd
+}
+
+func runAccesslog(cmd *cobra.Command, dingocli *cli.DingoCli, options accesslogOptions) error {
+	mountpoint, err := utils.FindDingoFSMountPoint(options.mountpoint)
+	if err != nil {
+		return errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	}
+
+	ops := map[string]bool{}
+	for _, op := range options.ops {
+		ops[strings.ToLower(strings.TrimSpace(op))] = true
+	}
+
+	admin, err := utils.DialAdmin(mountpoint.MountPoint)
+	if err == nil {
+		defer admin.Close()
+		return streamAccessLog(admin, ops, options)
+	} else if !errors.Is(err, utils.ErrAdminSocketNotFound) {
+		return errno.ERR_CLIENT_ADMIN_FAILED.E(err)
+	}
+
+	filename := filepath.Join(mountpoint.MountPoint, ACCESSLOG_FILE)
+	f, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("access log is not supported by client of %s", mountpoint.MountPoint)
+		}
+		return fmt.Errorf("open access log of %s failed: %v", mountpoint.MountPoint, err)
+	}
+	defer f.Close()
+
+	// the client writes operations to the reader as they happen, EOF means no operation for now
+	reader := bufio.NewReader(f)
+	partial := ""
+	for {
+		line, err := reader.ReadString('\n')
+		if err == io.EOF {
+			partial += line
+			time.Sleep(ACCESSLOG_POLL_INTERVAL)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("read access log of %s failed: %v", mountpoint.MountPoint, err)
+		}
+		line = strings.TrimRight(partial+line, "\n")
+		partial = ""
+		if matchAccessLog(line, ops, options) {
+			fmt.Println(line)
+		}
+	}
+}
+
+// every answer of the admin socket is a line of access log, the stream never ends
+func streamAccessLog(admin *utils.AdminClient, ops map[string]bool, options accesslogOptions) error {
+	err := admin.Stream(utils.ADMIN_COMMAND_ACCESSLOG, nil, -1, func(data json.RawMessage) error {
+		var line string
+		if err := json.Unmarshal(data, &line); err != nil {
+			return err
+		}
+		if matchAccessLog(line, ops, options) {
+			fmt.Println(line)
+		}
+		return nil
+	})
+	if err != nil {
+		return errno.ERR_CLIENT_ADMIN_FAILED.E(err)
+	}
+	return nil
+}
+
+// lines can not be parsed are shown only if no filter is specified
+func matchAccessLog(line string, ops map[string]bool, options accesslogOptions) bool {
+	filtered := len(ops) > 0 || options.minLatency > 0 || options.errors
+	entry, ok := parseAccessLog(line)
+	if !ok {
+		return !filtered
+	}
+	if len(ops) > 0 && !ops[entry.op] {
+		return false
+	}
+	if entry.latency < options.minLatency {
+		return false
+	}
+	if options.errors && entry.result == ACCESSLOG_RESULT_OK {
+		return false
+	}
+	return true
+}
+
+func parseAccessLog(line string) (*accessLogEntry, bool) {
+	matches := accessLogRegex.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, false
+	}
+	seconds, err := strconv.ParseFloat(matches[4], 64)
+	if err != nil {
+		return nil, false
+	}
+	return &accessLogEntry{
+		op:      matches[1],
+		args:    matches[2],
+		result:  matches[3],
+		latency: time.Duration(seconds * float64(time.Second)),
+	}, true
+}
@@ -0,0 +1,324 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_ANALYZE_EXAMPLE = `Examples:
+   $ dingo fs analyze --fsname dingofs1 --top 50
+
+   # files accessed in the last hour, as csv
+   $ dingo fs analyze --fsname dingofs1 --path /dir1 --window 1h --format csv`
+
+	ANALYZE_LARGEST_FILE = "largest-file"
+	ANALYZE_LARGEST_DIR  = "largest-dir"
+	ANALYZE_HOT_FILE     = "hot-file"
+)
+
+type analyzeOptions struct {
+	fsid    uint32
+	path    string
+	top     uint32
+	window  time.Duration
+	threads uint32
+	format  string
+}
+
+type AnalyzeEntry struct {
+	Path   string    `json:"path"`
+	Ino    uint64    `json:"ino"`
+	Length uint64    `json:"length"`
+	Atime  time.Time `json:"atime,omitempty"`
+}
+
+// mds keeps no access counter, hot files are the most recently accessed ones in window by atime
+type AnalyzeReport struct {
+	Path         string          `json:"path"`
+	Window       string          `json:"window"`
+	LargestFiles []*AnalyzeEntry `json:"largest_files"`
+	LargestDirs  []*AnalyzeEntry `json:"largest_dirs"`
+	HotFiles     []*AnalyzeEntry `json:"hot_files"`
+	ScannedFiles uint64          `json:"scanned_files"`
+	ScannedDirs  uint64          `json:"scanned_dirs"`
+}
+
+type fsAnalyzer struct {
+	cmd          *cobra.Command
+	fsid         uint32
+	epoch        uint64
+	since        time.Time
+	concurrent   chan struct{}
+	hardlinks    sync.Map
+	spinner      *output.Progress
+	largestFiles *topEntries
+	largestDirs  *topEntries
+	hotFiles     *topEntries
+	scannedFiles atomic.Uint64
+	scannedDirs  atomic.Uint64
+}
+
+// n greatest entries by less, kept in descending order
+type topEntries struct {
+	mutex   sync.Mutex
+	n       int
+	less    func(a, b *AnalyzeEntry) bool
+	entries []*AnalyzeEntry
+}
+
+func NewFsAnalyzeCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options analyzeOptions
+
+	cmd := &cobra.Command{
+		Use:     "analyze [OPTIONS]",
+		Short:   "Report largest files, largest directories and hot files of a directory tree",
+		Args:    utils.NoArgs,
+		Example: FS_ANALYZE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.path = path.Clean("/" + utils.GetStringFlag(cmd, utils.DINGOFS_PATH))
+			options.top, _ = cmd.Flags().GetUint32("top")
+			if options.top == 0 {
+				return fmt.Errorf("--top must be greater than 0")
+			}
+			options.window, _ = cmd.Flags().GetDuration("window")
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runAnalyze(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_PATH, "Full path of the directory within the volume")
+	cmd.Flags().Uint32("top", 50, "Number of entries of every report")
+	cmd.Flags().Duration("window", 24*time.Hour, "Files accessed in this recent window are hot files")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runAnalyze(cmd *cobra.Command, dingocli *cli.DingoCli, options analyzeOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	// epoch + router
+	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if epochErr != nil {
+		return epochErr
+	}
+	if routerErr := rpc.InitFsMDSRouter(cmd, options.fsid); routerErr != nil {
+		return routerErr
+	}
+	dirInodeId, inodeErr := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if inodeErr != nil {
+		return inodeErr
+	}
+
+	bySize := func(a, b *AnalyzeEntry) bool { return a.Length < b.Length }
+	analyzer := &fsAnalyzer{
+		cmd:          cmd,
+		fsid:         options.fsid,
+		epoch:        epoch,
+		since:        time.Now().Add(-options.window),
+		concurrent:   make(chan struct{}, options.threads),
+		largestFiles: &topEntries{n: int(options.top), less: bySize},
+		largestDirs:  &topEntries{n: int(options.top), less: bySize},
+		hotFiles:     &topEntries{n: int(options.top), less: func(a, b *AnalyzeEntry) bool { return a.Atime.Before(b.Atime) }},
+	}
+	if options.format != utils.FORMAT_JSON {
+		analyzer.spinner = output.NewSpinner(fmt.Sprintf("Scanning %s", options.path))
+	}
+	length, err := analyzer.walk(dirInodeId, options.path)
+	if analyzer.spinner != nil {
+		analyzer.spinner.Finish()
+	}
+	report := &AnalyzeReport{Path: options.path, Window: options.window.String()}
+	if err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	} else {
+		analyzer.largestDirs.add(&AnalyzeEntry{Path: options.path, Ino: dirInodeId, Length: length})
+		report.LargestFiles = analyzer.largestFiles.entries
+		report.LargestDirs = analyzer.largestDirs.entries
+		report.HotFiles = analyzer.hotFiles.entries
+		report.ScannedFiles = analyzer.scannedFiles.Load()
+		report.ScannedDirs = analyzer.scannedDirs.Load() + 1
+		outputResult.Result = report
+	}
+
+	// print result
+	if options.format == utils.FORMAT_JSON {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_TYPE, common.ROW_PATH, common.ROW_SIZE, common.ROW_ATIME}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	appendRows := func(kind string, entries []*AnalyzeEntry) {
+		for _, entry := range entries {
+			atime := common.ROW_VALUE_NO_VALUE
+			if !entry.Atime.IsZero() {
+				atime = entry.Atime.Format(STATS_TIME_FORMAT)
+			}
+			rows = append(rows, []string{kind, entry.Path, humanize.IBytes(entry.Length), atime})
+		}
+	}
+	appendRows(ANALYZE_LARGEST_FILE, report.LargestFiles)
+	appendRows(ANALYZE_LARGEST_DIR, report.LargestDirs)
+	appendRows(ANALYZE_HOT_FILE, report.HotFiles)
+	// entries of every report are in rank order
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no file found")
+	if options.format == utils.FORMAT_PLAIN || options.format == utils.FORMAT_TABLE {
+		fmt.Printf("Scanned %s files and %s directories of %s, hot files are accessed in %s\n",
+			humanize.Comma(int64(report.ScannedFiles)), humanize.Comma(int64(report.ScannedDirs)), report.Path, report.Window)
+	}
+
+	return nil
+}
+
+// returns recursive size of the directory, subdirectories are walked concurrently if threads are idle
+func (a *fsAnalyzer) walk(ino uint64, dirPath string) (uint64, error) {
+	entries, err := rpc.ListDentry(a.cmd, a.fsid, ino, a.epoch)
+	if err != nil {
+		return 0, err
+	}
+
+	var total atomic.Uint64
+	var wg sync.WaitGroup
+	var errMutex sync.Mutex
+	var walkErr error
+	walkSubdir := func(ino uint64, subdirPath string) error {
+		length, err := a.walk(ino, subdirPath)
+		if err != nil {
+			return err
+		}
+		total.Add(length)
+		a.largestDirs.add(&AnalyzeEntry{Path: subdirPath, Ino: ino, Length: length})
+		return nil
+	}
+	for _, entry := range entries {
+		if a.spinner != nil {
+			a.spinner.Add64(1)
+		}
+		entryPath := path.Join(dirPath, entry.GetName())
+		switch entry.GetType() {
+		case mds.FileType_DIRECTORY:
+			a.scannedDirs.Add(1)
+			select {
+			case a.concurrent <- struct{}{}:
+				wg.Add(1)
+				go func(ino uint64, subdirPath string) {
+					defer wg.Done()
+					defer func() { <-a.concurrent }()
+					if err := walkSubdir(ino, subdirPath); err != nil {
+						errMutex.Lock()
+						walkErr = err
+						errMutex.Unlock()
+					}
+				}(entry.GetIno(), entryPath)
+			default:
+				if err := walkSubdir(entry.GetIno(), entryPath); err != nil {
+					wg.Wait()
+					return 0, err
+				}
+			}
+		case mds.FileType_FILE:
+			inode, err := rpc.GetInode(a.cmd, a.fsid, entry.GetIno(), entry.GetParent(), a.epoch)
+			if err != nil {
+				wg.Wait()
+				return 0, err
+			}
+			if inode.GetNlink() >= 2 {
+				if _, loaded := a.hardlinks.LoadOrStore(inode.GetIno(), struct{}{}); loaded {
+					continue
+				}
+			}
+			a.scannedFiles.Add(1)
+			total.Add(inode.GetLength())
+			// atime is in nanoseconds
+			file := &AnalyzeEntry{Path: entryPath, Ino: inode.GetIno(), Length: inode.GetLength(),
+				Atime: time.Unix(0, int64(inode.GetAtime()))}
+			a.largestFiles.add(file)
+			if file.Atime.After(a.since) {
+				a.hotFiles.add(file)
+			}
+		}
+	}
+	wg.Wait()
+	return total.Load(), walkErr
+}
+
+func (t *topEntries) add(entry *AnalyzeEntry) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if len(t.entries) == t.n && !t.less(t.entries[t.n-1], entry) {
+		return
+	}
+	index := sort.Search(len(t.entries), func(i int) bool { return t.less(t.entries[i], entry) })
+	t.entries = append(t.entries, nil)
+	copy(t.entries[index+1:], t.entries[index:])
+	t.entries[index] = entry
+	if len(t.entries) > t.n {
+		t.entries = t.entries[:t.n]
+	}
+}
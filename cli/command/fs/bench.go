@@ -0,0 +1,414 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_BENCH_EXAMPLE = `Examples:
+   $ dingo fs bench /mnt/dingofs
+
+   # 4 threads, every thread writes a 4GiB file and 1000 small files
+   $ dingo fs bench /mnt/dingofs --threads 4 --big-file-size 4GiB --small-file-count 1000
+
+   # only metadata and small files
+   $ dingo fs bench /mnt/dingofs --big-file-size 0`
+
+	// random io is done on the big file
+	BENCH_RANDOM_IO_SIZE = 4 * utils.KiB
+	BENCH_DIR_PREFIX     = ".dingo-bench-"
+)
+
+type benchOptions struct {
+	mountpoint     string
+	blockSize      uint64
+	bigFileSize    uint64
+	smallFileSize  uint64
+	smallFileCount uint32
+	randomCount    uint32
+	threads        uint32
+	format         string
+}
+
+// value is throughput, iops or ops per second, cost is average latency of an operation of a thread
+type BenchResult struct {
+	Item  string  `json:"item"`
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+	Cost  float64 `json:"cost_ms"`
+	Ops   int64   `json:"ops"`
+}
+
+type benchStage struct {
+	item string
+	unit string
+	// bytes of every op for throughput, 0 for ops per second
+	opSize uint64
+	// returns number of ops done by the thread
+	fn func(thread int) (int64, error)
+}
+
+type benchRunner struct {
+	dir     string
+	options benchOptions
+	buffer  []byte
+}
+
+func NewFsBenchCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options benchOptions
+
+	cmd := &cobra.Command{
+		Use:               "bench MOUNTPOINT [OPTIONS]",
+		Short:             "Run io and metadata benchmark against a mountpoint",
+		Args:              utils.ExactArgs(1),
+		Example:           FS_BENCH_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.mountpoint, _ = filepath.Abs(args[0])
+			sizes := map[string]*uint64{
+				"block-size":      &options.blockSize,
+				"big-file-size":   &options.bigFileSize,
+				"small-file-size": &options.smallFileSize,
+			}
+			for name, size := range sizes {
+				value, _ := cmd.Flags().GetString(name)
+				if *size, err = utils.ParseSize(value); err != nil {
+					return fmt.Errorf("invalid --%s: %v", name, err)
+				}
+			}
+			if options.blockSize == 0 {
+				return fmt.Errorf("--block-size must be greater than 0")
+			}
+			options.smallFileCount, _ = cmd.Flags().GetUint32("small-file-count")
+			options.randomCount, _ = cmd.Flags().GetUint32("random-count")
+			options.threads, _ = cmd.Flags().GetUint32("threads")
+			if options.threads == 0 {
+				return fmt.Errorf("--threads must be greater than 0")
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runBench(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().String("block-size", "1MiB", "Size of every read and write of big files")
+	cmd.Flags().String("big-file-size", "1GiB", "Size of the big file of every thread, 0 to skip big file tests")
+	cmd.Flags().String("small-file-size", "128KiB", "Size of every small file")
+	cmd.Flags().Uint32("small-file-count", 100, "Number of small files of every thread, 0 to skip small file tests")
+	cmd.Flags().Uint32("random-count", 1000, "Number of 4KiB random reads and writes of every thread")
+	cmd.Flags().Uint32P("threads", "p", 1, "Number of concurrent threads")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runBench(cmd *cobra.Command, dingocli *cli.DingoCli, options benchOptions) error {
+	if err := utils.CheckMountPoint(options.mountpoint); err != nil {
+		return err
+	}
+	if inode, err := utils.GetFileInode(options.mountpoint); err != nil {
+		return err
+	} else if inode != 1 {
+		return fmt.Errorf("%s is not a dingofs mountpoint", options.mountpoint)
+	}
+
+	// files of the benchmark are removed after finished
+	dir, err := os.MkdirTemp(options.mountpoint, BENCH_DIR_PREFIX)
+	if err != nil {
+		return fmt.Errorf("create benchmark directory failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	runner := &benchRunner{dir: dir, options: options, buffer: make([]byte, options.blockSize)}
+	rand.Read(runner.buffer)
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	results := []*BenchResult{}
+	for _, stage := range runner.stages() {
+		if options.format != "json" {
+			fmt.Fprintf(os.Stderr, "%s...\r", stage.item)
+		}
+		result, err := runner.run(stage)
+		if err != nil {
+			outputResult.Error = errno.ERR_FS_BENCH_FAILED.E(fmt.Errorf("%s: %v", stage.item, err))
+			break
+		}
+		results = append(results, result)
+	}
+	outputResult.Result = results
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_ITEM, common.ROW_VALUE, common.ROW_COST}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, result := range results {
+		row := make(map[string]string)
+		row[common.ROW_ITEM] = result.Item
+		row[common.ROW_VALUE] = fmt.Sprintf("%.2f %s", result.Value, result.Unit)
+		row[common.ROW_COST] = fmt.Sprintf("%.2f ms", result.Cost)
+		rows = append(rows, row)
+	}
+	// rows are in order of stages
+	list := table.ListMap2ListSortByKeys(rows, header, []string{})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no benchmark is run")
+	fmt.Printf("Benchmark of %s: block size %s, big file %s, small file %s * %d, %d threads\n",
+		options.mountpoint, humanize.IBytes(options.blockSize), humanize.IBytes(options.bigFileSize),
+		humanize.IBytes(options.smallFileSize), options.smallFileCount, options.threads)
+
+	return nil
+}
+
+func (r *benchRunner) stages() []*benchStage {
+	stages := []*benchStage{}
+	if r.options.bigFileSize > 0 {
+		stages = append(stages,
+			&benchStage{"Write big file", "MiB/s", r.options.blockSize, r.writeBigFile},
+			&benchStage{"Read big file", "MiB/s", r.options.blockSize, r.readBigFile},
+		)
+		if r.options.randomCount > 0 && r.options.bigFileSize >= BENCH_RANDOM_IO_SIZE {
+			stages = append(stages,
+				&benchStage{"Random write 4KiB", "IOPS", 0, r.randomWrite},
+				&benchStage{"Random read 4KiB", "IOPS", 0, r.randomRead},
+			)
+		}
+	}
+	if r.options.smallFileCount > 0 {
+		stages = append(stages,
+			&benchStage{"Write small file", "files/s", 0, r.writeSmallFiles},
+			&benchStage{"Read small file", "files/s", 0, r.readSmallFiles},
+			&benchStage{"Stat file", "files/s", 0, r.statSmallFiles},
+			&benchStage{"Delete file", "files/s", 0, r.deleteSmallFiles},
+		)
+	}
+	return stages
+}
+
+// run the stage in all threads, the first error is returned
+func (r *benchRunner) run(stage *benchStage) (*BenchResult, error) {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var firstErr error
+	ops := int64(0)
+
+	start := time.Now()
+	for i := 0; i < int(r.options.threads); i++ {
+		wg.Add(1)
+		go func(thread int) {
+			defer wg.Done()
+			n, err := stage.fn(thread)
+			mutex.Lock()
+			defer mutex.Unlock()
+			ops += n
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}(i)
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	result := &BenchResult{Item: stage.item, Unit: stage.unit, Ops: ops}
+	if ops == 0 {
+		return result, nil
+	}
+	seconds := elapsed.Seconds()
+	result.Value = float64(ops) / seconds
+	if stage.opSize > 0 {
+		result.Value = float64(ops) * float64(stage.opSize) / float64(utils.MiB) / seconds
+	}
+	result.Cost = seconds * 1000 * float64(r.options.threads) / float64(ops)
+	return result, nil
+}
+
+func (r *benchRunner) bigFile(thread int) string {
+	return filepath.Join(r.dir, fmt.Sprintf("big.%d", thread))
+}
+
+func (r *benchRunner) smallFile(thread int, index uint32) string {
+	return filepath.Join(r.dir, fmt.Sprintf("small.%d.%d", thread, index))
+}
+
+// data is flushed by fsync before close, which is counted in the cost
+func (r *benchRunner) writeFile(name string, size uint64, flag int) (int64, error) {
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|flag, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	ops := int64(0)
+	for written := uint64(0); written < size; ops++ {
+		n := min(size-written, r.options.blockSize)
+		if _, err := file.Write(r.buffer[:n]); err != nil {
+			return ops, err
+		}
+		written += n
+	}
+	if err := file.Sync(); err != nil {
+		return ops, err
+	}
+	return ops, file.Close()
+}
+
+func (r *benchRunner) readFile(name string) (int64, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	buffer := make([]byte, r.options.blockSize)
+	ops := int64(0)
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 {
+			ops++
+		}
+		if err != nil {
+			if err == io.EOF {
+				return ops, nil
+			}
+			return ops, err
+		}
+	}
+}
+
+func (r *benchRunner) writeBigFile(thread int) (int64, error) {
+	return r.writeFile(r.bigFile(thread), r.options.bigFileSize, os.O_TRUNC)
+}
+
+func (r *benchRunner) readBigFile(thread int) (int64, error) {
+	return r.readFile(r.bigFile(thread))
+}
+
+func (r *benchRunner) randomIO(thread int, write bool) (int64, error) {
+	flag := os.O_RDONLY
+	if write {
+		flag = os.O_WRONLY
+	}
+	file, err := os.OpenFile(r.bigFile(thread), flag, 0644)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano() + int64(thread)))
+	blocks := int64(r.options.bigFileSize / BENCH_RANDOM_IO_SIZE)
+	buffer := make([]byte, BENCH_RANDOM_IO_SIZE)
+	copy(buffer, r.buffer)
+	ops := int64(0)
+	for ; ops < int64(r.options.randomCount); ops++ {
+		offset := random.Int63n(blocks) * int64(BENCH_RANDOM_IO_SIZE)
+		if write {
+			_, err = file.WriteAt(buffer, offset)
+		} else {
+			_, err = file.ReadAt(buffer, offset)
+		}
+		if err != nil {
+			return ops, err
+		}
+	}
+	if write {
+		if err := file.Sync(); err != nil {
+			return ops, err
+		}
+	}
+	return ops, file.Close()
+}
+
+func (r *benchRunner) randomWrite(thread int) (int64, error) {
+	return r.randomIO(thread, true)
+}
+
+func (r *benchRunner) randomRead(thread int) (int64, error) {
+	return r.randomIO(thread, false)
+}
+
+func (r *benchRunner) writeSmallFiles(thread int) (int64, error) {
+	for i := uint32(0); i < r.options.smallFileCount; i++ {
+		if _, err := r.writeFile(r.smallFile(thread, i), r.options.smallFileSize, os.O_EXCL); err != nil {
+			return int64(i), err
+		}
+	}
+	return int64(r.options.smallFileCount), nil
+}
+
+func (r *benchRunner) readSmallFiles(thread int) (int64, error) {
+	for i := uint32(0); i < r.options.smallFileCount; i++ {
+		if _, err := r.readFile(r.smallFile(thread, i)); err != nil {
+			return int64(i), err
+		}
+	}
+	return int64(r.options.smallFileCount), nil
+}
+
+func (r *benchRunner) statSmallFiles(thread int) (int64, error) {
+	for i := uint32(0); i < r.options.smallFileCount; i++ {
+		if _, err := os.Stat(r.smallFile(thread, i)); err != nil {
+			return int64(i), err
+		}
+	}
+	return int64(r.options.smallFileCount), nil
+}
+
+func (r *benchRunner) deleteSmallFiles(thread int) (int64, error) {
+	for i := uint32(0); i < r.options.smallFileCount; i++ {
+		if err := os.Remove(r.smallFile(thread, i)); err != nil {
+			return int64(i), err
+		}
+	}
+	return int64(r.options.smallFileCount), nil
+}
@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"golang.org/x/sys/unix"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	CACHE_DROP_EXAMPLE = `Examples:
+   # evict cached blocks of files under /mnt/dingofs/dir1
+   $ dingo fs cache drop /mnt/dingofs/dir1
+
+   # also evict blocks cached by members of the remote cache group
+   $ dingo fs cache drop /mnt/dingofs/dir1 --remote
+
+   # evict all cached blocks of local mountpoints
+   $ dingo fs cache drop --all`
+
+	CACHE_DROP_SCOPE_LOCAL  = "local"
+	CACHE_DROP_SCOPE_REMOTE = "remote"
+)
+
+type dropOptions struct {
+	paths  []string
+	all    bool
+	remote bool
+}
+
+func NewCacheDropCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options dropOptions
+
+	cmd := &cobra.Command{
+		Use:               "drop PATH|--all [OPTIONS]",
+		Short:             "Evict cached blocks of a path or all local mountpoints",
+		Args:              utils.RequiresMaxArgs(1),
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		Example:           CACHE_DROP_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.all, _ = cmd.Flags().GetBool("all")
+			options.remote, _ = cmd.Flags().GetBool("remote")
+			if options.all == (len(args) == 1) {
+				return fmt.Errorf("either PATH or --all is required")
+			}
+
+			if options.all {
+				mountpoints, err := utils.GetDingoFSMountPoints()
+				if err != nil {
+					return err
+				}
+				for _, mountpoint := range mountpoints {
+					options.paths = append(options.paths, mountpoint.MountPoint)
+				}
+				if len(options.paths) == 0 {
+					return fmt.Errorf("no dingofs mountpoint found")
+				}
+			} else {
+				path, _ := filepath.Abs(args[0])
+				options.paths = []string{path}
+			}
+
+			return runDrop(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Bool("all", false, "Evict all cached blocks of local mountpoints")
+	cmd.Flags().Bool("remote", false, "Also evict blocks cached by remote cache group members")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+
+	return cmd
+}
+
+// client evicts blocks of files under the path, and forwards the request to
+// members of its cache group if scope is remote
+func runDrop(cmd *cobra.Command, dingocli *cli.DingoCli, options dropOptions) error {
+	scope := CACHE_DROP_SCOPE_LOCAL
+	if options.remote {
+		scope = CACHE_DROP_SCOPE_REMOTE
+	}
+
+	failed := 0
+	for _, path := range options.paths {
+		action := cli.NewAction(cli.ACTION_SYSCALL, "%s(%s, scope=%s)", utils.ADMIN_COMMAND_CACHE_DROP, path, scope)
+		err := dingocli.Perform(action, func() error {
+			return dropCache(path, scope)
+		})
+		if err != nil {
+			failed++
+			fmt.Printf("Drop cache of [%s] failed: %v\n", path, err)
+			continue
+		}
+		if !dingocli.IsDryRun() {
+			fmt.Printf("Successfully dropped %s cache of [%s]\n", scope, path)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("drop cache of %d paths failed", failed)
+	}
+	return nil
+}
+
+// by admin socket of the client, or xattr for clients without it
+func dropCache(path, scope string) error {
+	admin, err := utils.DialAdmin(path)
+	if err == nil {
+		defer admin.Close()
+		mountpoint, err := utils.FindDingoFSMountPoint(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(mountpoint.MountPoint, path)
+		return admin.Call(utils.ADMIN_COMMAND_CACHE_DROP, map[string]string{
+			"path":  filepath.Join("/", rel),
+			"scope": scope,
+		}, nil)
+	} else if !errors.Is(err, utils.ErrAdminSocketNotFound) {
+		return err
+	}
+
+	err = unix.Setxattr(path, DINGOFS_CACHE_DROP_XATTR, []byte("scope="+scope), 0)
+	if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
+		return fmt.Errorf("client does not support dropping cache, please upgrade dingofs client")
+	} else if err != nil {
+		return fmt.Errorf("%s: %v", DINGOFS_CACHE_DROP_XATTR, err)
+	}
+	return nil
+}
@@ -0,0 +1,683 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/config"
+	"github.com/dingodb/dingocli/cli/command/fs/quota"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_CHECK_EXAMPLE = `Examples:
+   $ dingo fs check --fsname dingofs1
+
+   # check a subtree and verify every block exists in s3 or rados
+   $ dingo fs check --fsname dingofs1 --path /dir1 --check-objects
+
+   # fix dangling dentries, orphan directory quotas and quota usage
+   $ dingo fs check --fsname dingofs1 --repair`
+
+	CHECK_FLAG_OBJECTS = "check-objects"
+	CHECK_FLAG_REPAIR  = "repair"
+)
+
+// kind of inconsistencies, only dangling dentries and quota records are repaired,
+// others need to be fixed by hand as data may be lost
+const (
+	FSCK_DANGLING_DENTRY  = "dangling-dentry" // dentry points to inode not exists, repair: remove dentry
+	FSCK_PARENT_MISMATCH  = "parent-mismatch" // parents of inode do not contain directory of dentry
+	FSCK_ORPHAN_INODE     = "orphan-inode"    // nlink is larger than dentries found, inode is never freed
+	FSCK_NLINK_MISMATCH   = "nlink-mismatch"  // nlink is less than dentries found
+	FSCK_MISSING_OBJECT   = "missing-object"  // block of slice not exists in storage
+	FSCK_OBJECT_SIZE      = "object-size"     // block in storage is smaller than expected
+	FSCK_ORPHAN_QUOTA     = "orphan-quota"    // quota of directory not exists, repair: delete quota
+	FSCK_DIR_QUOTA_DRIFT  = "dir-quota-drift" // used of directory quota is not real usage, repair: set used
+	FSCK_FS_QUOTA_DRIFT   = "fs-quota-drift"  // used of fs quota is not real usage, repair: set used
+	FSCK_STATUS_FOUND     = "found"
+	FSCK_STATUS_REPAIRED  = "repaired"
+	FSCK_STATUS_FAILED    = "repair failed"
+	FSCK_MAX_OBJECTS_SHOW = 3
+)
+
+type checkOptions struct {
+	fsid         uint32
+	fsname       string
+	path         string
+	threads      uint32
+	checkObjects bool
+	repair       bool
+	format       string
+}
+
+type FsckIssue struct {
+	Type   string `json:"type"`
+	Ino    uint64 `json:"ino"`
+	Path   string `json:"path"`
+	Detail string `json:"detail"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+
+	// how to repair, nil if it can not be repaired
+	repair func() error
+}
+
+type FsckReport struct {
+	FsId     uint32       `json:"fs_id"`
+	FsName   string       `json:"fs_name"`
+	Path     string       `json:"path"`
+	Dirs     int64        `json:"dirs"`
+	Files    int64        `json:"files"`
+	Objects  int64        `json:"objects"`
+	Issues   []*FsckIssue `json:"issues"`
+	Repaired int          `json:"repaired"`
+}
+
+type fileLinks struct {
+	path  string
+	nlink uint32
+	refs  uint32
+}
+
+type fsChecker struct {
+	cmd      *cobra.Command
+	dingocli *cli.DingoCli
+	options  checkOptions
+	epoch    uint64
+
+	chunkSize uint64
+	blockSize uint64
+	// key -> size of all blocks in storage, nil if objects are not checked
+	objects map[string]int64
+	// blocks referenced by slices, only collected by fs gc
+	refs map[string]bool
+	// files whose slices can not be read, their blocks are unknown
+	sliceErrors int64
+	// directory inode -> quota
+	quotas map[uint64]*mds.Quota
+
+	sem    chan struct{}
+	mu     sync.Mutex
+	report *FsckReport
+	links  map[uint64]*fileLinks
+	dirs   map[uint64]bool
+	// usage counted as fs quota, hard links are counted once
+	fsLength int64
+	fsInodes int64
+}
+
+func NewFsCheckCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options checkOptions
+
+	cmd := &cobra.Command{
+		Use:     "check [OPTIONS]",
+		Short:   "Check metadata consistency of filesystem",
+		Args:    utils.NoArgs,
+		Example: FS_CHECK_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			fsname, err := rpc.GetFsName(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsname = fsname
+
+			options.path = path.Clean("/" + utils.GetStringFlag(cmd, utils.DINGOFS_PATH))
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			options.checkObjects = utils.GetBoolFlag(cmd, CHECK_FLAG_OBJECTS)
+			options.repair = utils.GetBoolFlag(cmd, CHECK_FLAG_REPAIR)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runCheck(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_PATH, "Directory to check, the whole filesystem by default")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+	cmd.Flags().Bool(CHECK_FLAG_OBJECTS, false, "Also check blocks of files exist in s3 or rados")
+	cmd.Flags().Bool(CHECK_FLAG_REPAIR, false, "Repair dangling dentries and quota records")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runCheck(cmd *cobra.Command, dingocli *cli.DingoCli, options checkOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	// epoch + router
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+	dirIno, err := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if err != nil {
+		return err
+	}
+
+	checker := &fsChecker{
+		cmd:      cmd,
+		dingocli: dingocli,
+		options:  options,
+		epoch:    epoch,
+		sem:      make(chan struct{}, options.threads),
+		report:   &FsckReport{FsId: options.fsid, FsName: options.fsname, Path: options.path, Issues: []*FsckIssue{}},
+		links:    map[uint64]*fileLinks{},
+		dirs:     map[uint64]bool{dirIno: true},
+	}
+	if err := checker.prepare(); err != nil {
+		return err
+	}
+
+	// scan
+	spinner := output.NewSpinner(fmt.Sprintf("Checking %s of fs %s", options.path, options.fsname))
+	length, inodes, err := checker.walkDir(dirIno, options.path)
+	spinner.Finish()
+	if err != nil {
+		return err
+	}
+	checker.checkDirQuota(dirIno, options.path, length, inodes+1)
+	if options.path == "/" {
+		// only a full scan sees every link and directory
+		checker.checkLinks()
+		checker.checkOrphanQuotas()
+		checker.checkFsQuota()
+	}
+
+	report := checker.report
+	if options.repair {
+		for _, issue := range report.Issues {
+			if issue.repair == nil {
+				continue
+			}
+			if err := issue.repair(); err != nil {
+				issue.Status, issue.Error = FSCK_STATUS_FAILED, err.Error()
+			} else if !dingocli.IsDryRun() {
+				issue.Status = FSCK_STATUS_REPAIRED
+				report.Repaired++
+			}
+		}
+	}
+	outputResult.Result = report
+	if remain := len(report.Issues) - report.Repaired; remain > 0 {
+		outputResult.Error = errno.ERR_FILESYSTEM_CHECK_FAILED.F("%d of %d issues are not repaired", remain, len(report.Issues))
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_TYPE, common.ROW_INODE_ID, common.ROW_PATH, common.ROW_DETAIL, common.ROW_STATUS}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, issue := range report.Issues {
+		status := issue.Status
+		if issue.Error != "" {
+			status = fmt.Sprintf("%s: %s", issue.Status, issue.Error)
+		}
+		rows = append(rows, map[string]string{
+			common.ROW_TYPE:     issue.Type,
+			common.ROW_INODE_ID: fmt.Sprintf("%d", issue.Ino),
+			common.ROW_PATH:     issue.Path,
+			common.ROW_DETAIL:   issue.Detail,
+			common.ROW_STATUS:   status,
+		})
+	}
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_TYPE, common.ROW_PATH})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no inconsistency found")
+
+	fmt.Printf("Checked %d directories, %d files", report.Dirs, report.Files)
+	if options.checkObjects {
+		fmt.Printf(", %d objects", report.Objects)
+	}
+	fmt.Printf(", %d issues found, %d repaired\n", len(report.Issues), report.Repaired)
+
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+// load fs info, directory quotas and objects in storage before scan
+func (c *fsChecker) prepare() error {
+	fsInfo, err := rpc.GetFsInfo(c.cmd, c.options.fsid, "")
+	if err != nil {
+		return err
+	}
+	c.chunkSize = fsInfo.GetChunkSize()
+	c.blockSize = fsInfo.GetBlockSize()
+
+	quotas, err := quota.LoadDirQuotas(c.cmd, c.options.fsid, c.epoch)
+	if err != nil {
+		return err
+	}
+	c.quotas = quotas
+
+	if !c.options.checkObjects {
+		return nil
+	}
+	if c.chunkSize == 0 {
+		return fmt.Errorf("invalid chunk size of fs %s", c.options.fsname)
+	}
+	store, err := utils.NewObjectStore(fsInfo.GetExtra())
+	if err != nil {
+		return err
+	}
+	c.objects = map[string]int64{}
+	spinner := output.NewSpinner(fmt.Sprintf("Listing objects in %s", store))
+	err = store.List(utils.BLOCK_STORE_PREFIX, func(key string, size int64, mtime time.Time) error {
+		c.objects[key] = size
+		spinner.Add64(1)
+		return nil
+	})
+	spinner.Finish()
+	return err
+}
+
+func (c *fsChecker) addIssue(issue *FsckIssue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	issue.Status = FSCK_STATUS_FOUND
+	c.report.Issues = append(c.report.Issues, issue)
+}
+
+// walk directory and returns length and inodes of its subtree, counted as directory quota
+func (c *fsChecker) walkDir(ino uint64, dirPath string) (int64, int64, error) {
+	entries, err := rpc.ListDentry(c.cmd, c.options.fsid, ino, c.epoch)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var length, inodes int64
+	var walkErr error
+	add := func(l, n int64, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		length, inodes = length+l, inodes+n
+		if err != nil && walkErr == nil {
+			walkErr = err
+		}
+	}
+
+	c.mu.Lock()
+	c.report.Dirs++
+	c.mu.Unlock()
+	for _, entry := range entries {
+		entryPath := path.Join(dirPath, entry.GetName())
+		add(0, 1, nil)
+
+		inode, err := rpc.GetInode(c.cmd, c.options.fsid, entry.GetIno(), entry.GetParent(), c.epoch)
+		if err != nil {
+			if !isNotFound(err) {
+				add(0, 0, err)
+				break
+			}
+			c.addDanglingDentry(entry, entryPath)
+			continue
+		}
+		if parents := inode.GetParents(); len(parents) > 0 && !slices.Contains(parents, ino) {
+			c.addIssue(&FsckIssue{Type: FSCK_PARENT_MISMATCH, Ino: entry.GetIno(), Path: entryPath,
+				Detail: fmt.Sprintf("dentry in %d, parents of inode %v", ino, parents)})
+		}
+
+		if entry.GetType() != mds.FileType_DIRECTORY {
+			if entry.GetType() == mds.FileType_FILE {
+				add(int64(inode.GetLength()), 0, nil)
+			}
+			c.checkFile(inode, entry.GetParent(), entryPath)
+			continue
+		}
+
+		c.mu.Lock()
+		c.dirs[entry.GetIno()] = true
+		c.fsInodes++
+		c.mu.Unlock()
+		walk := func(e *mds.Dentry, p string) {
+			l, n, err := c.walkDir(e.GetIno(), p)
+			if err == nil {
+				c.checkDirQuota(e.GetIno(), p, l, n+1)
+			}
+			add(l, n, err)
+		}
+		select {
+		case c.sem <- struct{}{}:
+			wg.Add(1)
+			go func(e *mds.Dentry, p string) {
+				defer wg.Done()
+				defer func() { <-c.sem }()
+				walk(e, p)
+			}(entry, entryPath)
+		default:
+			walk(entry, entryPath)
+		}
+	}
+	wg.Wait()
+
+	return length, inodes, walkErr
+}
+
+// error of mds or rpc which means the inode or dentry does not exist
+func isNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "NOT_FOUND")
+}
+
+// err is the result of looking up the inode again before repair, the dentry is
+// only deleted if the inode is still not found
+func checkDangling(ino uint64, err error) error {
+	if err == nil {
+		return fmt.Errorf("inode %d exists now, dentry is not dangling", ino)
+	}
+	if !isNotFound(err) {
+		return fmt.Errorf("lookup inode %d failed: %v", ino, err)
+	}
+	return nil
+}
+
+func (c *fsChecker) addDanglingDentry(entry *mds.Dentry, entryPath string) {
+	fsid, ino, parent, name, epoch := c.options.fsid, entry.GetIno(), entry.GetParent(), entry.GetName(), c.epoch
+	isDir := entry.GetType() == mds.FileType_DIRECTORY
+	c.addIssue(&FsckIssue{
+		Type:   FSCK_DANGLING_DENTRY,
+		Ino:    ino,
+		Path:   entryPath,
+		Detail: fmt.Sprintf("inode %d of %s not exists", ino, strings.ToLower(entry.GetType().String())),
+		repair: func() error {
+			// the inode may be created after the walk, e.g. by a client which is writing the dentry
+			_, err := rpc.GetInode(c.cmd, fsid, ino, parent, epoch)
+			if err := checkDangling(ino, err); err != nil {
+				return err
+			}
+			action := cli.NewAction(cli.ACTION_RPC, "remove dangling dentry %s(parent=%d, name=%s)", entryPath, parent, name)
+			return c.dingocli.Perform(action, func() error {
+				if isDir {
+					return rpc.DeleteDirectory(c.cmd, fsid, parent, name, epoch)
+				}
+				return rpc.DeleteFile(c.cmd, fsid, parent, name, epoch)
+			})
+		},
+	})
+}
+
+// count links and check blocks of file
+func (c *fsChecker) checkFile(inode *mds.Inode, parent uint64, filePath string) {
+	c.mu.Lock()
+	c.report.Files++
+	links, seen := c.links[inode.GetIno()]
+	if !seen {
+		links = &fileLinks{path: filePath, nlink: inode.GetNlink()}
+		c.links[inode.GetIno()] = links
+		c.fsInodes++
+		if inode.GetType() == mds.FileType_FILE {
+			c.fsLength += int64(inode.GetLength())
+		}
+	}
+	links.refs++
+	c.mu.Unlock()
+
+	// blocks of hard link are checked once
+	if seen || c.objects == nil || inode.GetType() != mds.FileType_FILE || inode.GetLength() == 0 {
+		return
+	}
+	chunkNum := uint32((inode.GetLength() + c.chunkSize - 1) / c.chunkSize)
+	chunks, err := rpc.ReadSliceAll(c.cmd, c.options.fsid, inode.GetIno(), parent, chunkNum, c.epoch)
+	if err != nil {
+		c.mu.Lock()
+		c.sliceErrors++
+		c.mu.Unlock()
+		c.addIssue(&FsckIssue{Type: FSCK_MISSING_OBJECT, Ino: inode.GetIno(), Path: filePath,
+			Detail: fmt.Sprintf("read slices failed: %v", err)})
+		return
+	}
+
+	total, missing, short := 0, []string{}, []string{}
+	for _, chunk := range chunks {
+		for _, slice := range chunk.GetSlices() {
+			objects := utils.EnumerateBlockKeys(slice.GetId(), slice.GetPos(), slice.GetSize(), chunk.GetIndex(), c.chunkSize, c.blockSize)
+			for _, object := range objects {
+				total++
+				if c.refs != nil {
+					c.mu.Lock()
+					c.refs[object.Name] = true
+					c.mu.Unlock()
+				}
+				size, ok := c.objects[object.Name]
+				if !ok {
+					missing = append(missing, object.Name)
+				} else if size >= 0 && size < int64(object.Size) {
+					short = append(short, fmt.Sprintf("%s(%d<%d)", object.Name, size, object.Size))
+				}
+			}
+		}
+	}
+	c.mu.Lock()
+	c.report.Objects += int64(total)
+	c.mu.Unlock()
+	if len(missing) > 0 {
+		c.addIssue(&FsckIssue{Type: FSCK_MISSING_OBJECT, Ino: inode.GetIno(), Path: filePath,
+			Detail: fmt.Sprintf("%d of %d blocks missing: %s", len(missing), total, joinLimited(missing))})
+	}
+	if len(short) > 0 {
+		c.addIssue(&FsckIssue{Type: FSCK_OBJECT_SIZE, Ino: inode.GetIno(), Path: filePath,
+			Detail: fmt.Sprintf("%d of %d blocks too small: %s", len(short), total, joinLimited(short))})
+	}
+}
+
+func joinLimited(items []string) string {
+	if len(items) > FSCK_MAX_OBJECTS_SHOW {
+		return strings.Join(items[:FSCK_MAX_OBJECTS_SHOW], ", ") + ", ..."
+	}
+	return strings.Join(items, ", ")
+}
+
+func (c *fsChecker) checkLinks() {
+	for ino, links := range c.links {
+		if links.refs == links.nlink {
+			continue
+		}
+		issueType := FSCK_NLINK_MISMATCH
+		if links.nlink > links.refs {
+			issueType = FSCK_ORPHAN_INODE
+		}
+		c.addIssue(&FsckIssue{Type: issueType, Ino: ino, Path: links.path,
+			Detail: fmt.Sprintf("nlink is %d, but %d dentries found", links.nlink, links.refs)})
+	}
+}
+
+func (c *fsChecker) checkDirQuota(ino uint64, dirPath string, length, inodes int64) {
+	quota, ok := c.quotas[ino]
+	if !ok || (quota.GetUsedBytes() == length && quota.GetUsedInodes() == inodes) {
+		return
+	}
+	fsid, epoch := c.options.fsid, c.epoch
+	c.addIssue(&FsckIssue{
+		Type: FSCK_DIR_QUOTA_DRIFT,
+		Ino:  ino,
+		Path: dirPath,
+		Detail: fmt.Sprintf("used %d bytes %d inodes, real %d bytes %d inodes",
+			quota.GetUsedBytes(), quota.GetUsedInodes(), length, inodes),
+		repair: func() error {
+			action := cli.NewAction(cli.ACTION_RPC, "SetDirQuota(fsid=%d, inode=%d, usedBytes=%d, usedInodes=%d)", fsid, ino, length, inodes)
+			return c.dingocli.Perform(action, func() error {
+				return setDirQuotaUsage(c.cmd, fsid, ino, length, inodes, epoch)
+			})
+		},
+	})
+}
+
+// quota of directory which is not found in full scan
+func (c *fsChecker) checkOrphanQuotas() {
+	fsid, epoch := c.options.fsid, c.epoch
+	for ino := range c.quotas {
+		if c.dirs[ino] {
+			continue
+		}
+		dirIno := ino
+		c.addIssue(&FsckIssue{
+			Type:   FSCK_ORPHAN_QUOTA,
+			Ino:    dirIno,
+			Detail: "quota of directory not exists",
+			repair: func() error {
+				action := cli.NewAction(cli.ACTION_RPC, "DeleteDirQuota(fsid=%d, inode=%d)", fsid, dirIno)
+				return c.dingocli.Perform(action, func() error {
+					return deleteDirQuota(c.cmd, fsid, dirIno, epoch)
+				})
+			},
+		})
+	}
+}
+
+func (c *fsChecker) checkFsQuota() {
+	_, result, err := config.GetFsQuotaData(c.cmd, c.options.fsid)
+	if err != nil {
+		c.addIssue(&FsckIssue{Type: FSCK_FS_QUOTA_DRIFT, Ino: common.ROOTINODEID, Path: "/",
+			Detail: fmt.Sprintf("get fs quota failed: %v", err)})
+		return
+	}
+	// root inode is counted
+	quota, length, inodes := result.GetQuota(), c.fsLength, c.fsInodes+1
+	if quota.GetUsedBytes() == length && quota.GetUsedInodes() == inodes {
+		return
+	}
+	fsid, epoch := c.options.fsid, c.epoch
+	c.addIssue(&FsckIssue{
+		Type: FSCK_FS_QUOTA_DRIFT,
+		Ino:  common.ROOTINODEID,
+		Path: "/",
+		Detail: fmt.Sprintf("used %d bytes %d inodes, real %d bytes %d inodes",
+			quota.GetUsedBytes(), quota.GetUsedInodes(), length, inodes),
+		repair: func() error {
+			action := cli.NewAction(cli.ACTION_RPC, "SetFsQuota(fsid=%d, usedBytes=%d, usedInodes=%d)", fsid, length, inodes)
+			return c.dingocli.Perform(action, func() error {
+				return setFsQuotaUsage(c.cmd, fsid, length, inodes, epoch)
+			})
+		},
+	})
+}
+
+func setDirQuotaUsage(cmd *cobra.Command, fsId uint32, ino uint64, usedBytes, usedInodes int64, epoch uint64) error {
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "SetDirQuota")
+	if err != nil {
+		return err
+	}
+	setDirQuotaRpc := &rpc.SetDirQuotaRpc{
+		Info: mdsRpc,
+		Request: &mds.SetDirQuotaRequest{
+			Context: &mds.Context{Epoch: epoch, IsBypassCache: true},
+			FsId:    fsId,
+			Ino:     ino,
+			Quota:   &mds.Quota{UsedBytes: usedBytes, UsedInodes: usedInodes},
+		},
+	}
+	response, rpcError := rpc.GetRpcResponse(setDirQuotaRpc.Info, setDirQuotaRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+	if mdsErr := response.(*mds.SetDirQuotaResponse).GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return nil
+}
+
+func setFsQuotaUsage(cmd *cobra.Command, fsId uint32, usedBytes, usedInodes int64, epoch uint64) error {
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "setFsQuota")
+	if err != nil {
+		return err
+	}
+	setFsQuotaRpc := &rpc.SetFsQuotaRpc{
+		Info: mdsRpc,
+		Request: &mds.SetFsQuotaRequest{
+			Context: &mds.Context{Epoch: epoch, IsBypassCache: true},
+			FsId:    fsId,
+			Quota:   &mds.Quota{UsedBytes: usedBytes, UsedInodes: usedInodes},
+		},
+	}
+	response, rpcError := rpc.GetRpcResponse(setFsQuotaRpc.Info, setFsQuotaRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+	if mdsErr := response.(*mds.SetFsQuotaResponse).GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return nil
+}
+
+func deleteDirQuota(cmd *cobra.Command, fsId uint32, ino uint64, epoch uint64) error {
+	mdsRpc := rpc.CreateNewMdsRpcWithEndPoint(cmd, rpc.GetEndPoint(ino), "DeleteDirQuota")
+	deleteRpc := &rpc.DeleteDirQuotaRpc{
+		Info: mdsRpc,
+		Request: &mds.DeleteDirQuotaRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsId:    fsId,
+			Ino:     ino,
+		},
+	}
+	response, rpcError := rpc.GetRpcResponse(deleteRpc.Info, deleteRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+	if mdsErr := response.(*mds.DeleteDirQuotaResponse).GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package fs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotFound(t *testing.T) {
+	assert := assert.New(t)
+	assert.False(isNotFound(nil))
+	assert.False(isNotFound(fmt.Errorf("rpc timeout")))
+	assert.True(isNotFound(fmt.Errorf("errcode: ENOT_FOUND errmsg: \"inode not found\"")))
+}
+
+func TestCheckDangling(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		repair bool
+	}{
+		{name: "still not found", err: fmt.Errorf("errcode: ENOT_FOUND"), repair: true},
+		{name: "inode created after walk", err: nil, repair: false},
+		{name: "lookup failed", err: fmt.Errorf("rpc timeout"), repair: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDangling(100, tt.err)
+			if tt.repair {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
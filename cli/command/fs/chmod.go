@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_CHMOD_EXAMPLE = `Examples:
+   $ dingo fs chmod --fsname dingofs1 755 /dir1 --recursive
+
+   $ dingo fs chmod --fsname dingofs1 0644 /dir1/file1 --dry-run`
+)
+
+func NewFsChmodCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "chmod MODE PATH [OPTIONS]",
+		Short:   "Change mode of a file or directory tree by mds",
+		Args:    utils.ExactArgs(2),
+		Example: FS_CHMOD_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// only octal mode is supported, e.g. 755 or 2775
+			mode, err := strconv.ParseUint(args[0], 8, 32)
+			if err != nil || mode > 07777 {
+				return fmt.Errorf("invalid mode: %s, octal mode is required, e.g. 755", args[0])
+			}
+			options, err := getSetattrOptions(cmd, args[1])
+			if err != nil {
+				return err
+			}
+
+			action := cli.NewAction(cli.ACTION_RPC, "SetAttr(%s, mode=%04o, recursive=%t)", options.path, mode, options.recursive)
+			// mode of symlink is not used
+			skipTypes := []mds.FileType{mds.FileType_SYM_LINK}
+			return runSetattr(cmd, dingocli, options, action, skipTypes, func(ino uint64, parent uint64, fileType mds.FileType, epoch uint64) error {
+				_, err := rpc.SetAttr(cmd, options.fsid, ino, parent, rpc.SET_ATTR_MODE, fileTypeMode(fileType)|uint32(mode), 0, 0, epoch)
+				return err
+			})
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	addSetattrFlags(cmd)
+
+	return cmd
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"os/user"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_CHOWN_EXAMPLE = `Examples:
+   $ dingo fs chown --fsname dingofs1 1000:1000 /dir1 --recursive
+
+   $ dingo fs chown --fsname dingofs1 alice /dir1 -R --dry-run
+
+   $ dingo fs chown --fsname dingofs1 :data /dir1/file1`
+)
+
+func NewFsChownCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "chown OWNER[:GROUP] PATH [OPTIONS]",
+		Short:   "Change owner and group of a file or directory tree by mds",
+		Args:    utils.ExactArgs(2),
+		Example: FS_CHOWN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			toSet, uid, gid, err := parseOwner(args[0])
+			if err != nil {
+				return err
+			}
+			options, err := getSetattrOptions(cmd, args[1])
+			if err != nil {
+				return err
+			}
+
+			action := cli.NewAction(cli.ACTION_RPC, "SetAttr(%s, owner=%s, recursive=%t)", options.path, args[0], options.recursive)
+			return runSetattr(cmd, dingocli, options, action, nil, func(ino uint64, parent uint64, fileType mds.FileType, epoch uint64) error {
+				_, err := rpc.SetAttr(cmd, options.fsid, ino, parent, toSet, 0, uid, gid, epoch)
+				return err
+			})
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	addSetattrFlags(cmd)
+
+	return cmd
+}
+
+// OWNER[:GROUP], OWNER or GROUP can be empty, names are resolved on local host
+func parseOwner(owner string) (toSet uint32, uid uint32, gid uint32, err error) {
+	userName, groupName, _ := strings.Cut(owner, ":")
+	if userName != "" {
+		if uid, err = lookupId(userName, func(name string) (string, error) {
+			u, err := user.Lookup(name)
+			if err != nil {
+				return "", err
+			}
+			return u.Uid, nil
+		}); err != nil {
+			return 0, 0, 0, err
+		}
+		toSet |= rpc.SET_ATTR_UID
+	}
+	if groupName != "" {
+		if gid, err = lookupId(groupName, func(name string) (string, error) {
+			g, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return g.Gid, nil
+		}); err != nil {
+			return 0, 0, 0, err
+		}
+		toSet |= rpc.SET_ATTR_GID
+	}
+	if toSet == 0 {
+		return 0, 0, 0, fmt.Errorf("invalid owner: %s", owner)
+	}
+	return toSet, uid, gid, nil
+}
+
+func lookupId(name string, lookup func(string) (string, error)) (uint32, error) {
+	if id, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return uint32(id), nil
+	}
+	idStr, err := lookup(name)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	return uint32(id), err
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunk
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+// a block object of a slice, blocks of later slices overwrite earlier ones in the same range
+type ChunkBlock struct {
+	ChunkIndex uint32 `json:"chunk_index"`
+	Version    uint64 `json:"version"`
+	SliceId    uint64 `json:"slice_id"`
+	Key        string `json:"key"`
+	Size       uint32 `json:"size"`
+	Pos        uint64 `json:"pos"`
+	Cache      string `json:"cache"`
+}
+
+// file resolved from a path in local mountpoint or a path within filesystem
+type chunkFile struct {
+	fsInfo *mds.FsInfo
+	epoch  uint64
+	path   string
+	ino    uint64
+	parent uint64
+	length uint64
+}
+
+func NewChunkCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chunk",
+		Short: "Inspect chunks and backend objects of a file",
+		Args:  utils.NoArgs,
+	}
+
+	cmd.AddCommand(
+		NewChunkInfoCommand(dingocli),
+		NewChunkFetchCommand(dingocli),
+	)
+
+	return cmd
+}
+
+func addChunkFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id, PATH is within the filesystem if set")
+	cmd.Flags().String("fsname", "", "Filesystem name, PATH is within the filesystem if set")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+}
+
+func resolveChunkFile(cmd *cobra.Command, filePath string) (*chunkFile, error) {
+	file := &chunkFile{}
+	if cmd.Flag(utils.DINGOFS_FSID).Changed || cmd.Flag(utils.DINGOFS_FSNAME).Changed {
+		// path within filesystem, resolved by mds
+		fsid, err := rpc.GetFsId(cmd)
+		if err != nil {
+			return nil, err
+		}
+		if file.fsInfo, err = rpc.GetFsInfo(cmd, fsid, ""); err != nil {
+			return nil, err
+		}
+		if file.epoch, err = initRouter(cmd, fsid); err != nil {
+			return nil, err
+		}
+		file.path = path.Clean("/" + filePath)
+		var fileType mds.FileType
+		file.ino, file.parent, fileType, err = rpc.ResolvePathInode(cmd, fsid, file.path, file.epoch)
+		if err != nil {
+			return nil, err
+		}
+		if fileType != mds.FileType_FILE {
+			return nil, fmt.Errorf("%s is not a file", file.path)
+		}
+	} else {
+		// path in local mountpoint, inode is got by stat
+		absPath, err := filepath.Abs(filePath)
+		if err != nil {
+			return nil, err
+		}
+		mountpoint, err := utils.FindDingoFSMountPoint(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("%v, specify --fsname to use path within filesystem", err)
+		}
+		if file.fsInfo, err = rpc.GetFsInfo(cmd, 0, utils.MountPointFsName(mountpoint)); err != nil {
+			return nil, err
+		}
+		if file.epoch, err = initRouter(cmd, file.fsInfo.GetFsId()); err != nil {
+			return nil, err
+		}
+		file.path = utils.Path2DingofsPath(absPath, mountpoint)
+		if file.ino, err = utils.GetFileInode(absPath); err != nil {
+			return nil, err
+		}
+		if file.parent, err = utils.GetFileInode(filepath.Dir(absPath)); err != nil {
+			return nil, err
+		}
+	}
+
+	inode, err := rpc.GetInode(cmd, file.fsInfo.GetFsId(), file.ino, file.parent, file.epoch)
+	if err != nil {
+		return nil, err
+	}
+	if inode.GetType() != mds.FileType_FILE {
+		return nil, fmt.Errorf("%s is not a file", file.path)
+	}
+	file.length = inode.GetLength()
+	return file, nil
+}
+
+func initRouter(cmd *cobra.Command, fsId uint32) (uint64, error) {
+	epoch, err := rpc.GetFsEpochByFsId(cmd, fsId)
+	if err != nil {
+		return 0, err
+	}
+	return epoch, rpc.InitFsMDSRouter(cmd, fsId)
+}
+
+// slices of all chunks, in order of chunk index
+func readChunks(cmd *cobra.Command, file *chunkFile) ([]*mds.Chunk, error) {
+	chunkSize := file.fsInfo.GetChunkSize()
+	if chunkSize == 0 {
+		return nil, fmt.Errorf("invalid chunk size")
+	}
+	if file.length == 0 {
+		return []*mds.Chunk{}, nil
+	}
+	chunkNum := uint32((file.length + chunkSize - 1) / chunkSize)
+	return rpc.ReadSliceAll(cmd, file.fsInfo.GetFsId(), file.ino, file.parent, chunkNum, file.epoch)
+}
+
+// cache dirs of all local mountpoints of the filesystem
+func localCacheDirs(fsName string) []string {
+	cacheDirs := []string{}
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return cacheDirs
+	}
+	for _, mountpoint := range mountpoints {
+		if utils.MountPointFsName(mountpoint) == fsName {
+			cacheDirs = append(cacheDirs, utils.GetMountPointCacheDirs(mountpoint)...)
+		}
+	}
+	return cacheDirs
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunk
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CHUNK_FETCH_EXAMPLE = `Examples:
+   # first 4MiB of file
+   $ dingo fs chunk fetch /mnt/dingofs/dir1/file.bin --length 4MiB --output blob.bin
+
+   # write to stdout
+   $ dingo fs chunk fetch /dir1/file.bin --fsname dingofs1 --offset 64MiB --length 1MiB --output - | xxd | head`
+
+	CHUNK_FETCH_STDOUT = "-"
+)
+
+type fetchOptions struct {
+	path   string
+	offset uint64
+	length uint64
+	output string
+}
+
+// range of file read from a block object
+type fetchedRange struct {
+	key    string
+	offset uint64
+	length uint64
+}
+
+func NewChunkFetchCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options fetchOptions
+
+	cmd := &cobra.Command{
+		Use:     "fetch PATH --output FILE [OPTIONS]",
+		Short:   "Read a range of file from backend objects directly, bypassing client",
+		Args:    utils.ExactArgs(1),
+		Example: CHUNK_FETCH_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.path = args[0]
+			var err error
+			if options.offset, err = humanize.ParseBytes(utils.GetStringFlag(cmd, "offset")); err != nil {
+				return fmt.Errorf("invalid offset: %v", err)
+			}
+			if length := utils.GetStringFlag(cmd, "length"); length != "" {
+				if options.length, err = humanize.ParseBytes(length); err != nil {
+					return fmt.Errorf("invalid length: %v", err)
+				}
+			}
+			options.output = utils.GetStringFlag(cmd, "output")
+
+			return runFetch(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().String("offset", "0", "Offset in file, e.g. 64MiB")
+	cmd.Flags().String("length", "", "Length to read, e.g. 4MiB (default to end of file)")
+	cmd.Flags().String("output", "", "File to write data, - for stdout")
+	cmd.MarkFlagRequired("output")
+	addChunkFlags(cmd)
+
+	return cmd
+}
+
+func runFetch(cmd *cobra.Command, dingocli *cli.DingoCli, options fetchOptions) error {
+	file, err := resolveChunkFile(cmd, options.path)
+	if err != nil {
+		return err
+	}
+	if options.offset >= file.length {
+		return fmt.Errorf("offset %d is beyond length %d of %s", options.offset, file.length, file.path)
+	}
+	if options.length == 0 || options.offset+options.length > file.length {
+		options.length = file.length - options.offset
+	}
+	store, err := utils.NewObjectStore(file.fsInfo.GetExtra())
+	if err != nil {
+		return err
+	}
+	chunks, err := readChunks(cmd, file)
+	if err != nil {
+		return err
+	}
+
+	data, ranges, err := fetchRange(store, chunks, file.fsInfo.GetChunkSize(), file.fsInfo.GetBlockSize(), options.offset, options.length)
+	if err != nil {
+		return err
+	}
+
+	if options.output == CHUNK_FETCH_STDOUT {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(options.output, data, 0644); err != nil {
+		return err
+	}
+	for _, r := range ranges {
+		log.Printf("fetched [%d, %d) from %s", r.offset, r.offset+r.length, r.key)
+	}
+	fmt.Printf("Fetched %s of %s [%d, %d) from %d objects in %s to %s\n", humanize.IBytes(uint64(len(data))), file.path,
+		options.offset, options.offset+options.length, len(ranges), store, options.output)
+	return nil
+}
+
+// data of [offset, offset+length) in file, slices of a chunk are applied in order so
+// later writes overwrite earlier ones, holes are zero
+func fetchRange(store utils.ObjectStore, chunks []*mds.Chunk, chunkSize uint64, blockSize uint64,
+	offset uint64, length uint64) ([]byte, []*fetchedRange, error) {
+	if blockSize == 0 {
+		return nil, nil, fmt.Errorf("invalid block size")
+	}
+	data := make([]byte, length)
+	ranges := []*fetchedRange{}
+	fetched := map[string][]byte{}
+	end := offset + length
+	for _, chunk := range chunks {
+		chunkStart := uint64(chunk.GetIndex()) * chunkSize
+		for _, slice := range chunk.GetSlices() {
+			// file range [sliceStart, sliceEnd) is stored from byte 0 of the slice
+			sliceStart := chunkStart + uint64(slice.GetPos())
+			sliceEnd := sliceStart + uint64(slice.GetLen())
+			if sliceEnd <= offset || sliceStart >= end {
+				continue
+			}
+			blocks := utils.EnumerateBlockKeys(slice.GetId(), slice.GetPos(), slice.GetSize(), chunk.GetIndex(), chunkSize, blockSize)
+			for pos := max(sliceStart, offset); pos < min(sliceEnd, end); {
+				index := (pos - sliceStart) / blockSize
+				if index >= uint64(len(blocks)) {
+					return nil, nil, fmt.Errorf("block %d of slice %d is beyond slice size %d", index, slice.GetId(), slice.GetSize())
+				}
+				key := blocks[index].Name
+				block, ok := fetched[key]
+				if !ok {
+					var err error
+					if block, err = store.Get(key); err != nil {
+						return nil, nil, fmt.Errorf("get object %s: %v", key, err)
+					}
+					fetched[key] = block
+				}
+
+				blockStart := sliceStart + index*blockSize
+				stop := min(blockStart+blockSize, sliceEnd, end)
+				from := pos - blockStart
+				if from+(stop-pos) > uint64(len(block)) {
+					return nil, nil, fmt.Errorf("object %s has %d bytes, less than %d", key, len(block), from+(stop-pos))
+				}
+				copy(data[pos-offset:stop-offset], block[from:from+(stop-pos)])
+				ranges = append(ranges, &fetchedRange{key: key, offset: pos, length: stop - pos})
+				pos = stop
+			}
+		}
+	}
+	return data, ranges, nil
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunk
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CHUNK_INFO_EXAMPLE = `Examples:
+   # path in a local dingofs mountpoint
+   $ dingo fs chunk info /mnt/dingofs/dir1/file.bin
+
+   # path within the filesystem
+   $ dingo fs chunk info /dir1/file.bin --fsname dingofs1 --format json`
+)
+
+type infoOptions struct {
+	path   string
+	format string
+}
+
+type ChunkInfo struct {
+	FsName    string        `json:"fs_name"`
+	Path      string        `json:"path"`
+	Ino       uint64        `json:"ino"`
+	Length    uint64        `json:"length"`
+	ChunkSize uint64        `json:"chunk_size"`
+	BlockSize uint64        `json:"block_size"`
+	Storage   string        `json:"storage"`
+	Blocks    []*ChunkBlock `json:"blocks"`
+}
+
+func NewChunkInfoCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options infoOptions
+
+	cmd := &cobra.Command{
+		Use:     "info PATH [OPTIONS]",
+		Short:   "Show chunks of a file with object keys and cache state of blocks",
+		Args:    utils.ExactArgs(1),
+		Example: CHUNK_INFO_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.path = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runInfo(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	addChunkFlags(cmd)
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runInfo(cmd *cobra.Command, dingocli *cli.DingoCli, options infoOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	file, err := resolveChunkFile(cmd, options.path)
+	if err != nil {
+		return err
+	}
+	info := &ChunkInfo{
+		FsName:    file.fsInfo.GetFsName(),
+		Path:      file.path,
+		Ino:       file.ino,
+		Length:    file.length,
+		ChunkSize: file.fsInfo.GetChunkSize(),
+		BlockSize: file.fsInfo.GetBlockSize(),
+		Blocks:    []*ChunkBlock{},
+	}
+	if store, err := utils.NewObjectStore(file.fsInfo.GetExtra()); err == nil {
+		info.Storage = store.String()
+	}
+
+	chunks, err := readChunks(cmd, file)
+	if err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	} else {
+		// residency is only known for blocks cached by clients on this host
+		cacheDirs := localCacheDirs(info.FsName)
+		for _, chunk := range chunks {
+			for _, slice := range chunk.GetSlices() {
+				objects := utils.EnumerateBlockKeys(slice.GetId(), slice.GetPos(), slice.GetSize(), chunk.GetIndex(), info.ChunkSize, info.BlockSize)
+				for _, object := range objects {
+					info.Blocks = append(info.Blocks, &ChunkBlock{
+						ChunkIndex: chunk.GetIndex(),
+						Version:    chunk.GetVersion(),
+						SliceId:    slice.GetId(),
+						Key:        object.Name,
+						Size:       object.Size,
+						Pos:        object.Pos,
+						Cache:      utils.BlockCacheState(cacheDirs, object.Name),
+					})
+				}
+			}
+		}
+	}
+	outputResult.Result = info
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	fmt.Printf("path: %s\ninode: %d\nlength: %s\nchunk size: %s\nblock size: %s\nstorage: %s\n", info.Path, info.Ino,
+		humanize.IBytes(info.Length), humanize.IBytes(info.ChunkSize), humanize.IBytes(info.BlockSize),
+		utils.Ternary(info.Storage != "", info.Storage, common.ROW_VALUE_UNKNOWN))
+	header := []string{common.ROW_CHUNK_INDEX, common.ROW_VERSION, common.ROW_SLICE_ID, common.ROW_OBJECT_NAME,
+		common.ROW_SIZE, common.ROW_POS, common.ROW_CACHE}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, block := range info.Blocks {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", block.ChunkIndex),
+			fmt.Sprintf("%d", block.Version),
+			fmt.Sprintf("%d", block.SliceId),
+			block.Key,
+			fmt.Sprintf("%d", block.Size),
+			fmt.Sprintf("%d", block.Pos),
+			block.Cache,
+		})
+	}
+	// blocks are in order of chunk and slice
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no blocks")
+
+	return nil
+}
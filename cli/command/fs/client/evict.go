@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CLIENT_EVICT_EXAMPLE = `Examples:
+   $ dingo fs client evict 5a1b0c3e-7d2f-4a8e-9c61-2f0e4b7d8a90
+
+   $ dingo fs client evict 5a1b0c3e-7d2f-4a8e-9c61-2f0e4b7d8a90 --fsname dingofs1`
+)
+
+type evictOptions struct {
+	clientId string
+	fsname   string
+	format   string
+}
+
+func NewClientEvictCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options evictOptions
+
+	cmd := &cobra.Command{
+		Use:     "evict ID [OPTIONS]",
+		Short:   "Revoke the session of a dead or misbehaving client",
+		Args:    utils.ExactArgs(1),
+		Example: CLIENT_EVICT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.clientId = args[0]
+			options.fsname = utils.GetStringFlag(cmd, utils.DINGOFS_FSNAME)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runEvict(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddStringFlag(cmd, utils.DINGOFS_FSNAME, "Filesystem name, found by client id if not set")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// the mountpoint of client is removed from fs by umount, so mds rejects the session afterwards
+func runEvict(cmd *cobra.Command, dingocli *cli.DingoCli, options evictOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	clients, err := listFsClients(cmd, options.fsname)
+	if err != nil {
+		return err
+	}
+	var client *FsClient
+	for _, c := range clients {
+		if c.ClientId == options.clientId {
+			client = c
+			break
+		}
+	}
+	if client == nil {
+		return errno.ERR_CLIENT_ID_NOT_FOUND.F("client %s not found in mountpoints of filesystems", options.clientId)
+	}
+
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "UmountFs")
+	if err != nil {
+		return err
+	}
+	umountRpc := &rpc.UmountFsRpc{
+		Info: mdsRpc,
+		Request: &mds.UmountFsRequest{
+			FsName:   client.FsName,
+			ClientId: client.ClientId,
+		},
+	}
+
+	if !dingocli.IsDryRun() && !utils.Confirm("Are you sure to evict client %s mounting %s on %s?",
+		client.ClientId, client.FsName, client.MountPoint) {
+		return fmt.Errorf("abort evict client")
+	}
+
+	action := cli.NewAction(cli.ACTION_RPC, "UmountFs(fsname=%s, clientid=%s)", client.FsName, client.ClientId)
+	dingocli.Perform(action, func() error {
+		response, rpcError := rpc.GetRpcResponse(umountRpc.Info, umountRpc)
+		if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+			outputResult.Error = rpcError
+		} else {
+			result := response.(*mds.UmountFsResponse)
+			if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+				outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
+			}
+			outputResult.Result = result
+		}
+		return nil
+	})
+	if dingocli.IsDryRun() {
+		return nil
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	fmt.Printf("Successfully evict client %s of filesystem %s\n", client.ClientId, client.FsName)
+
+	return nil
+}
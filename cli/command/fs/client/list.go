@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CLIENT_LIST_EXAMPLE = `Examples:
+   $ dingo fs client list
+
+   $ dingo fs client list --fsname dingofs1`
+)
+
+type listOptions struct {
+	fsname string
+	format string
+}
+
+// mountpoint of fs joined with heartbeat of the client,
+// heartbeat is zero if the client is not known by mds any more, e.g. crashed
+type FsClient struct {
+	ClientId      string    `json:"client_id"`
+	FsId          uint32    `json:"fs_id"`
+	FsName        string    `json:"fs_name"`
+	Hostname      string    `json:"hostname"`
+	MountPoint    string    `json:"mountpoint"`
+	Version       string    `json:"version"`
+	LastHeartbeat time.Time `json:"last_heartbeat"`
+}
+
+func NewClientListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [OPTIONS]",
+		Short:   "List clients mounting filesystems",
+		Args:    utils.NoArgs,
+		Example: CLIENT_LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.fsname = utils.GetStringFlag(cmd, utils.DINGOFS_FSNAME)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runList(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddStringFlag(cmd, utils.DINGOFS_FSNAME, "Filesystem name, all filesystems if not set")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+	utils.EnablePager(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	clients, err := listFsClients(cmd, options.fsname)
+	if err != nil {
+		outputResult.Error = errno.FromError(err)
+	}
+	outputResult.Result = clients
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	// set table header
+	header := []string{common.ROW_FS_CLIENTID, common.ROW_FS_NAME, common.ROW_HOSTNAME, common.ROW_MOUNTPOINT,
+		common.ROW_VERSION, common.ROW_LASTONLINETIME}
+	table.SetHeader(header)
+	// fill table
+	rows := make([]map[string]string, 0)
+	for _, client := range clients {
+		row := make(map[string]string)
+		row[common.ROW_FS_CLIENTID] = client.ClientId
+		row[common.ROW_FS_NAME] = client.FsName
+		row[common.ROW_HOSTNAME] = client.Hostname
+		row[common.ROW_MOUNTPOINT] = client.MountPoint
+		row[common.ROW_VERSION] = client.Version
+		row[common.ROW_LASTONLINETIME] = common.ROW_VALUE_NO_VALUE
+		if !client.LastHeartbeat.IsZero() {
+			row[common.ROW_LASTONLINETIME] = client.LastHeartbeat.Format("2006-01-02 15:04:05.000")
+		}
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_FS_NAME, common.ROW_HOSTNAME})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no client mounting filesystem")
+
+	return nil
+}
+
+// clients of all filesystems if fsname is empty
+func listFsClients(cmd *cobra.Command, fsname string) ([]*FsClient, error) {
+	fsInfos := []*mds.FsInfo{}
+	if len(fsname) == 0 {
+		infos, err := rpc.ListFsInfo(cmd)
+		if err != nil {
+			return nil, err
+		}
+		fsInfos = infos
+	} else {
+		fsInfo, err := rpc.GetFsInfo(cmd, 0, fsname)
+		if err != nil {
+			return nil, err
+		}
+		fsInfos = append(fsInfos, fsInfo)
+	}
+	return ListFsClients(cmd, fsInfos)
+}
+
+// clients of the filesystems, found by mountpoints of fs and joined with heartbeats by client id
+func ListFsClients(cmd *cobra.Command, fsInfos []*mds.FsInfo) ([]*FsClient, error) {
+	heartbeats, err := rpc.ListClients(cmd)
+	if err != nil {
+		return nil, err
+	}
+	clientMap := make(map[string]*mds.Client)
+	for _, heartbeat := range heartbeats {
+		clientMap[heartbeat.GetId()] = heartbeat
+	}
+
+	clients := []*FsClient{}
+	for _, fsInfo := range fsInfos {
+		for _, mountPoint := range fsInfo.GetMountPoints() {
+			client := &FsClient{
+				ClientId:   mountPoint.GetClientId(),
+				FsId:       fsInfo.GetFsId(),
+				FsName:     fsInfo.GetFsName(),
+				Hostname:   mountPoint.GetIp(),
+				MountPoint: fmt.Sprintf("%s:%d:%s", mountPoint.GetIp(), mountPoint.GetPort(), mountPoint.GetPath()),
+				Version:    common.ROW_VALUE_UNKNOWN,
+			}
+			if heartbeat, ok := clientMap[client.ClientId]; ok {
+				client.Hostname = heartbeat.GetHostname()
+				client.Version = heartbeat.GetVersion()
+				ms := int64(heartbeat.GetLastOnlineTimeMs())
+				client.LastHeartbeat = time.Unix(ms/1000, (ms%1000)*1000000)
+			}
+			clients = append(clients, client)
+		}
+	}
+	return clients, nil
+}
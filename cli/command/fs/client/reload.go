@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	CLIENT_RELOAD_EXAMPLE = `Examples:
+   # apply changes of client config file without remount
+   $ dingo fs client reload /mnt/dingofs`
+)
+
+type reloadOptions struct {
+	mountpoint string
+	format     string
+}
+
+// options of client config file which are applied, options needing remount are not in it
+type ClientReloadResult struct {
+	MountPoint string   `json:"mountpoint"`
+	Socket     string   `json:"socket"`
+	Changed    []string `json:"changed"`
+}
+
+func NewClientReloadCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options reloadOptions
+
+	cmd := &cobra.Command{
+		Use:               "reload MOUNTPOINT [OPTIONS]",
+		Short:             "Reload config file of the client serving a local mountpoint",
+		Args:              utils.ExactArgs(1),
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		Example:           CLIENT_RELOAD_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.mountpoint = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runReload(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+// only clients with admin socket can reload config
+func runReload(cmd *cobra.Command, dingocli *cli.DingoCli, options reloadOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	admin, err := utils.DialAdmin(options.mountpoint)
+	if errors.Is(err, utils.ErrAdminSocketNotFound) {
+		return errno.ERR_CLIENT_ADMIN_NOT_FOUND.F("client of %s does not support reloading config, please upgrade dingofs client", options.mountpoint)
+	} else if err != nil {
+		return errno.ERR_CLIENT_ADMIN_FAILED.E(err)
+	}
+	defer admin.Close()
+
+	result := &ClientReloadResult{MountPoint: options.mountpoint, Socket: admin.Socket(), Changed: []string{}}
+	action := cli.NewAction(cli.ACTION_SYSCALL, "%s(%s)", utils.ADMIN_COMMAND_CONFIG_RELOAD, admin.Socket())
+	err = dingocli.Perform(action, func() error {
+		return admin.Call(utils.ADMIN_COMMAND_CONFIG_RELOAD, nil, result)
+	})
+	if err != nil {
+		outputResult.Error = errno.ERR_CLIENT_ADMIN_FAILED.E(err)
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	if dingocli.IsDryRun() {
+		return nil
+	}
+
+	if len(result.Changed) == 0 {
+		fmt.Printf("Config of client serving [%s] is up to date\n", options.mountpoint)
+	} else {
+		fmt.Printf("Successfully reloaded config of client serving [%s], changed: %s\n", options.mountpoint, strings.Join(result.Changed, ", "))
+	}
+	return nil
+}
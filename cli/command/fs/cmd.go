@@ -18,12 +18,19 @@ package fs
 
 import (
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/cache"
+	"github.com/dingodb/dingocli/cli/command/fs/chunk"
+	"github.com/dingodb/dingocli/cli/command/fs/client"
 	"github.com/dingodb/dingocli/cli/command/fs/config"
 	"github.com/dingodb/dingocli/cli/command/fs/dirstats"
+	"github.com/dingodb/dingocli/cli/command/fs/inode"
+	"github.com/dingodb/dingocli/cli/command/fs/meta"
 	"github.com/dingodb/dingocli/cli/command/fs/quota"
+	"github.com/dingodb/dingocli/cli/command/fs/storage"
 	"github.com/dingodb/dingocli/cli/command/fs/subpath"
 	"github.com/dingodb/dingocli/cli/command/fs/trash"
 	"github.com/dingodb/dingocli/cli/command/fs/warmup"
+	"github.com/dingodb/dingocli/cli/command/fs/xattr"
 	cliutil "github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -39,19 +46,48 @@ func NewFSCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.AddCommand(
 		NewFsCreateCommand(dingocli),
 		NewFsDeleteCommand(dingocli),
+		NewFsRmrCommand(dingocli),
+		NewFsChownCommand(dingocli),
+		NewFsChmodCommand(dingocli),
 		NewFsListCommand(dingocli),
 		NewFsQueryCommand(dingocli),
 		NewFsMountpointCommand(dingocli),
+		NewFsInfoCommand(dingocli),
+		NewFsLsofCommand(dingocli),
+		client.NewClientCommand(dingocli),
+		NewFsTopologyCommand(dingocli),
+		NewFsMetricsCommand(dingocli),
+		NewFsHealthCommand(dingocli),
+		NewFsVersionsCommand(dingocli),
+		NewFsSlowlogCommand(dingocli),
 		NewFsUsageCommand(dingocli),
+		NewFsDfCommand(dingocli),
+		NewFsDuCommand(dingocli),
+		NewFsSummaryCommand(dingocli),
+		NewFsFindCommand(dingocli),
+		NewFsTreeCommand(dingocli),
+		NewFsAnalyzeCommand(dingocli),
+		NewFsCheckCommand(dingocli),
+		NewFsGcCommand(dingocli),
 		NewFsUmountCommand(dingocli),
 		NewFsMountCommand(dingocli),
 		config.NewFsCommand(dingocli),
 		quota.NewQuotaCommand(dingocli),
 		warmup.NewWarmupCommand(dingocli),
+		cache.NewCacheCommand(dingocli),
+		xattr.NewXattrCommand(dingocli),
 		subpath.NewSubpathCommand(dingocli),
+		storage.NewStorageCommand(dingocli),
+		NewFsSyncCommand(dingocli),
 		NewStatsCommand(dingocli),
+		NewFsAccesslogCommand(dingocli),
+		NewFsProfileCommand(dingocli),
+		NewFsBenchCommand(dingocli),
 		dirstats.NewDirstatsCommand(dingocli),
+		inode.NewInodeCommand(dingocli),
+		chunk.NewChunkCommand(dingocli),
 		trash.NewTrashCommand(dingocli),
+		meta.NewMetaCommand(dingocli),
 	)
 
 	return cmd
@@ -25,7 +25,7 @@ import (
 func NewFsCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
-		Short: "Manage fs quota",
+		Short: "Manage fs quota and config",
 		Args:  cliutil.NoArgs,
 	}
 
@@ -33,6 +33,7 @@ func NewFsCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewFsQuotaSetCommand(dingocli),
 		NewFsQuotaGetCommand(dingocli),
 		NewFsQuotaCheckCommand(dingocli),
+		NewFsConfigDiffCommand(dingocli),
 	)
 
 	return cmd
@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_CONFIG_DIFF_EXAMPLE = `Examples:
+   $ dingo fs config diff --fsname fs1
+
+   # compare clients with the configure file they should run with
+   $ dingo fs config diff --fsname fs1 --reference /etc/dingofs/client.conf`
+
+	// flags differing from client to client by nature
+	CONFIG_DIFF_DEFAULT_IGNORE = `(_ip|_port|_addr|_path|_dir|_file|hostname|mountpoint|pid)$`
+
+	CONFIG_EXPECTED_BY_MAJORITY  = "majority"
+	CONFIG_EXPECTED_BY_REFERENCE = "reference"
+)
+
+type diffOptions struct {
+	fsid      uint32
+	reference map[string]string
+	ignore    *regexp.Regexp
+	format    string
+}
+
+// values of a flag differing from the expected one, by client
+type ConfigDrift struct {
+	Name       string            `json:"name"`
+	Expected   string            `json:"expected"`
+	ExpectedBy string            `json:"expected_by"`
+	Clients    map[string]string `json:"clients"`
+}
+
+type ConfigDiffResult struct {
+	Clients int               `json:"clients"`
+	Drifts  []*ConfigDrift    `json:"drifts"`
+	Errors  map[string]string `json:"errors,omitempty"`
+}
+
+func NewFsConfigDiffCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options diffOptions
+
+	cmd := &cobra.Command{
+		Use:     "diff [OPTIONS]",
+		Short:   "Show configuration of mounted clients differing from the majority or a reference file",
+		Args:    utils.NoArgs,
+		Example: FS_CONFIG_DIFF_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+
+			if reference, _ := cmd.Flags().GetString("reference"); reference != "" {
+				if options.reference, err = utils.ReadFlagFile(reference); err != nil {
+					return err
+				}
+			}
+			ignore, _ := cmd.Flags().GetString("ignore")
+			if options.ignore, err = regexp.Compile(ignore); err != nil {
+				return fmt.Errorf("invalid --ignore %s: %v", ignore, err)
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runDiff(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().String("reference", "", "Flagfile of --name=value lines which clients are compared with, the majority of clients if not set")
+	cmd.Flags().String("ignore", CONFIG_DIFF_DEFAULT_IGNORE, "Regex of flag names not compared, '' compares all")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// effective configuration is read from /flags of the brpc server of every client,
+// exits with error if any flag differs, so drift can be caught by scripts
+func runDiff(cmd *cobra.Command, dingocli *cli.DingoCli, options diffOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+	if err != nil {
+		return err
+	}
+	timeout := utils.GetDurationFlag(cmd, utils.RPCTIMEOUT)
+	mountPoints := fsInfo.GetMountPoints()
+	type clientFlags struct {
+		name  string
+		flags map[string]string
+		err   error
+	}
+	results, _ := rpc.Batch(cmd, mountPoints, func(mountPoint *mds.MountPoint) (*clientFlags, error) {
+		client := &clientFlags{name: fmt.Sprintf("%s:%d:%s", mountPoint.GetIp(), mountPoint.GetPort(), mountPoint.GetPath())}
+		flags, err := utils.ScrapeFlags(fmt.Sprintf("%s:%d", mountPoint.GetIp(), mountPoint.GetPort()), timeout)
+		if err != nil {
+			client.err = err
+			return client, nil
+		}
+		client.flags = map[string]string{}
+		for _, flag := range flags {
+			if options.ignore.String() == "" || !options.ignore.MatchString(flag.Name) {
+				client.flags[flag.Name] = flag.Value
+			}
+		}
+		return client, nil
+	})
+
+	result := &ConfigDiffResult{Drifts: []*ConfigDrift{}, Errors: map[string]string{}}
+	values := map[string]map[string]string{} // flag name -> client -> value
+	for _, client := range results {
+		if client.err != nil {
+			result.Errors[client.name] = client.err.Error()
+			continue
+		}
+		result.Clients++
+		for name, value := range client.flags {
+			if values[name] == nil {
+				values[name] = map[string]string{}
+			}
+			values[name][client.name] = value
+		}
+	}
+
+	for name, clients := range values {
+		drift := &ConfigDrift{Name: name, Clients: map[string]string{}}
+		if expected, ok := options.reference[name]; ok {
+			drift.Expected, drift.ExpectedBy = expected, CONFIG_EXPECTED_BY_REFERENCE
+		} else if options.reference != nil {
+			continue
+		} else {
+			drift.Expected, drift.ExpectedBy = majorityValue(clients), CONFIG_EXPECTED_BY_MAJORITY
+		}
+		for client, value := range clients {
+			if value != drift.Expected {
+				drift.Clients[client] = value
+			}
+		}
+		if len(drift.Clients) > 0 {
+			result.Drifts = append(result.Drifts, drift)
+		}
+	}
+	sort.Slice(result.Drifts, func(i, j int) bool { return result.Drifts[i].Name < result.Drifts[j].Name })
+	if len(result.Drifts) > 0 {
+		outputResult.Error = errno.ERR_CONFIG_DRIFT
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	for _, drift := range result.Drifts {
+		fmt.Println(color.New(color.Bold).Sprint(drift.Name))
+		fmt.Println(color.GreenString("  - %s (%s)", drift.Expected, drift.ExpectedBy))
+		clients := make([]string, 0, len(drift.Clients))
+		for client := range drift.Clients {
+			clients = append(clients, client)
+		}
+		sort.Strings(clients)
+		for _, client := range clients {
+			fmt.Println(color.RedString("  + %s (%s)", drift.Clients[client], client))
+		}
+	}
+	for client, err := range result.Errors {
+		fmt.Fprintf(os.Stderr, "%s: get flags of %s failed: %s\n", color.YellowString("[WARNING]"), client, err)
+	}
+	if len(result.Drifts) == 0 {
+		fmt.Printf("no configuration differs in %d clients of %s\n", result.Clients, fsInfo.GetFsName())
+		return nil
+	}
+	fmt.Printf("%d flags differ in %d clients of %s\n", len(result.Drifts), result.Clients, fsInfo.GetFsName())
+	return outputResult.Error
+}
+
+// the value most clients have, the smaller one if tied so the result is stable
+func majorityValue(clients map[string]string) string {
+	counts := map[string]int{}
+	for _, value := range clients {
+		counts[value]++
+	}
+	majority, count := "", 0
+	for value, n := range counts {
+		if n > count || (n == count && value < majority) {
+			majority, count = value, n
+		}
+	}
+	return majority
+}
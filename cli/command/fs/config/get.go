@@ -33,22 +33,24 @@ import (
 
 const (
 	FS_QUOTA_GET_EXAMPLE = `Examples:
-   $ dingo fs quota get --fsname fs1`
+   $ dingo fs quota get --fsname fs1
+   $ dingo fs config get --fsname fs1 --all
+   $ dingo fs config get --fsname fs1 trashdays capacity`
 )
 
 type getOptions struct {
 	fsid   uint32
 	fsname string
 	format string
+	all    bool
 }
 
 func NewFsQuotaGetCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options getOptions
 
 	cmd := &cobra.Command{
-		Use:     "get [OPTIONS]",
-		Short:   "Get fs quota",
-		Args:    utils.NoArgs,
+		Use:     "get [OPTIONS] [KEY...]",
+		Short:   "Get fs quota or config",
 		Example: FS_QUOTA_GET_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			utils.ReadCommandConfig(cmd)
@@ -67,6 +69,11 @@ func NewFsQuotaGetCommand(dingocli *cli.DingoCli) *cobra.Command {
 			options.fsname = fsname
 
 			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+			options.all, _ = cmd.Flags().GetBool("all")
+
+			if options.all || len(args) > 0 {
+				return runGetTunables(cmd, options, args)
+			}
 
 			return runGet(cmd, dingocli, options)
 		},
@@ -79,6 +86,7 @@ func NewFsQuotaGetCommand(dingocli *cli.DingoCli) *cobra.Command {
 	// add flags
 	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
 	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().Bool("all", false, "Show all configs of filesystem")
 
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddConfigFileFlag(cmd)
@@ -33,7 +33,8 @@ import (
 
 const (
 	FS_QUOTA_SET_EXAMPLE = `Examples:
-   $ dingo fs quota set --fsname dingofs --capacity 10 --inodes 1000000`
+   $ dingo fs quota set --fsname dingofs --capacity 10 --inodes 1000000
+   $ dingo fs config set --fsname dingofs trashdays 7 owner alice`
 )
 
 type setOptions struct {
@@ -47,9 +48,8 @@ func NewFsQuotaSetCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options setOptions
 
 	cmd := &cobra.Command{
-		Use:     "set [OPTIONS]",
-		Short:   "Set fs quota",
-		Args:    utils.NoArgs,
+		Use:     "set [OPTIONS] [KEY VALUE...]",
+		Short:   "Set fs quota or config",
 		Example: FS_QUOTA_SET_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			utils.ReadCommandConfig(cmd)
@@ -60,14 +60,17 @@ func NewFsQuotaSetCommand(dingocli *cli.DingoCli) *cobra.Command {
 				return err
 			}
 			options.fsid = fsid
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			if len(args) > 0 {
+				return runSetTunables(cmd, dingocli, options, args)
+			}
 
 			options.capacity, options.inodes, err = utils.GetQuotaValue(cmd)
 			if err != nil {
 				return err
 			}
 
-			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
-
 			return runSet(cmd, dingocli, options)
 		},
 		SilenceUsage:          false,
@@ -99,40 +102,11 @@ func runSet(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions) erro
 	outputResult := &common.OutputResult{
 		Error: errno.ERR_OK,
 	}
-	// new rpc
-	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "setFsQuota")
+	result, err := SetFsQuota(cmd, options.fsid, options.capacity, options.inodes)
 	if err != nil {
-		return err
-	}
-
-	// get epoch id
-	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
-	if epochErr != nil {
-		return epochErr
-	}
-
-	// set request info
-	request := &mds.SetFsQuotaRequest{
-		Context: &mds.Context{Epoch: epoch, IsBypassCache: true},
-		FsId:    options.fsid,
-		Quota:   &mds.Quota{MaxBytes: options.capacity, MaxInodes: options.inodes},
-	}
-	setRpc := &rpc.SetFsQuotaRpc{
-		Info:    mdsRpc,
-		Request: request,
-	}
-
-	// get rpc result
-	response, rpcError := rpc.GetRpcResponse(setRpc.Info, setRpc)
-	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
-		outputResult.Error = rpcError
-	} else {
-		result := response.(*mds.SetFsQuotaResponse)
-		if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
-			outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
-		}
-		outputResult.Result = result
+		outputResult.Error = err
 	}
+	outputResult.Result = result
 
 	// print result
 	if options.format == "json" {
@@ -145,3 +119,32 @@ func runSet(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions) erro
 
 	return nil
 }
+
+func SetFsQuota(cmd *cobra.Command, fsid uint32, maxBytes, maxInodes int64) (*mds.SetFsQuotaResponse, *errno.ErrorCode) {
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "setFsQuota")
+	if err != nil {
+		return nil, errno.ERR_RPC_FAILED.E(err)
+	}
+	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, fsid)
+	if epochErr != nil {
+		return nil, errno.ERR_RPC_FAILED.E(epochErr)
+	}
+
+	setRpc := &rpc.SetFsQuotaRpc{
+		Info: mdsRpc,
+		Request: &mds.SetFsQuotaRequest{
+			Context: &mds.Context{Epoch: epoch, IsBypassCache: true},
+			FsId:    fsid,
+			Quota:   &mds.Quota{MaxBytes: maxBytes, MaxInodes: maxInodes},
+		},
+	}
+	response, rpcError := rpc.GetRpcResponse(setRpc.Info, setRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.SetFsQuotaResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return result, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	return result, nil
+}
@@ -0,0 +1,326 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+// config of filesystem got and set by key, keys are named as flags of fs create,
+// capacity and inodes are fs quota, others are fields of fs info
+const (
+	TUNABLE_UNLIMITED = "unlimited"
+)
+
+type fsConfig struct {
+	fsInfo *mds.FsInfo
+	quota  *mds.Quota
+}
+
+type fsTunable struct {
+	key   string
+	usage string
+	get   func(c *fsConfig) string
+	// nil if the config can not be changed after fs is created
+	set func(c *fsConfig, value string) error
+}
+
+type FsConfigItem struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Mutable  bool   `json:"mutable"`
+	NewValue string `json:"new_value,omitempty"`
+}
+
+var fsTunables = []*fsTunable{
+	{
+		key:   "owner",
+		usage: "owner of filesystem",
+		get:   func(c *fsConfig) string { return c.fsInfo.GetOwner() },
+		set: func(c *fsConfig, value string) error {
+			if value == "" {
+				return fmt.Errorf("owner can not be empty")
+			}
+			c.fsInfo.Owner = value
+			return nil
+		},
+	},
+	{
+		key:   utils.DINGOFS_TRASH_DAYS,
+		usage: "days files are kept in trash after deleted, 0 disables trash",
+		get:   func(c *fsConfig) string { return fmt.Sprintf("%d", c.fsInfo.GetTrashDays()) },
+		set: func(c *fsConfig, value string) error {
+			days, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid trash days '%s'", value)
+			}
+			c.fsInfo.TrashDays = uint32(days)
+			return nil
+		},
+	},
+	{
+		key:   utils.DINGOFS_ENABLE_DIR_STATS,
+		usage: "whether mds maintains directory stats",
+		get:   func(c *fsConfig) string { return strconv.FormatBool(c.fsInfo.GetEnableDirStats()) },
+		set: func(c *fsConfig, value string) error {
+			enable, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid bool '%s', should be true or false", value)
+			}
+			c.fsInfo.EnableDirStats = enable
+			return nil
+		},
+	},
+	{
+		key:   utils.DINGOFS_QUOTA_CAPACITY,
+		usage: "capacity of fs quota, e.g. 100GiB, unlimited",
+		get: func(c *fsConfig) string {
+			return formatTunableLimit(c.quota.GetMaxBytes(), humanize.IBytes)
+		},
+		set: func(c *fsConfig, value string) error {
+			size, err := parseTunableLimit(value, utils.ParseSize)
+			if err != nil {
+				return err
+			}
+			c.quota.MaxBytes = size
+			return nil
+		},
+	},
+	{
+		key:   utils.DINGOFS_QUOTA_INODES,
+		usage: "inodes of fs quota, e.g. 1000000, unlimited",
+		get: func(c *fsConfig) string {
+			return formatTunableLimit(c.quota.GetMaxInodes(), func(n uint64) string { return humanize.Comma(int64(n)) })
+		},
+		set: func(c *fsConfig, value string) error {
+			inodes, err := parseTunableLimit(value, func(s string) (uint64, error) { return strconv.ParseUint(s, 10, 64) })
+			if err != nil {
+				return err
+			}
+			c.quota.MaxInodes = inodes
+			return nil
+		},
+	},
+	// object keys of existing data depend on them, so they are fixed
+	{
+		key:   utils.DINGOFS_BLOCKSIZE,
+		usage: "size of block in storage",
+		get:   func(c *fsConfig) string { return humanize.IBytes(c.fsInfo.GetBlockSize()) },
+	},
+	{
+		key:   utils.DINGOFS_CHUNKSIZE,
+		usage: "size of chunk",
+		get:   func(c *fsConfig) string { return humanize.IBytes(c.fsInfo.GetChunkSize()) },
+	},
+}
+
+func findFsTunable(key string) (*fsTunable, error) {
+	keys := []string{}
+	for _, tunable := range fsTunables {
+		if tunable.key == key {
+			return tunable, nil
+		}
+		keys = append(keys, tunable.key)
+	}
+	return nil, fmt.Errorf("unknown config '%s', should be one of: %s", key, strings.Join(keys, ", "))
+}
+
+// 0 or math.MaxInt64 of quota means unlimited
+func formatTunableLimit(limit int64, format func(uint64) string) string {
+	if limit <= 0 || limit == math.MaxInt64 {
+		return TUNABLE_UNLIMITED
+	}
+	return format(uint64(limit))
+}
+
+func parseTunableLimit(value string, parse func(string) (uint64, error)) (int64, error) {
+	if value == TUNABLE_UNLIMITED || value == "0" {
+		return math.MaxInt64, nil
+	}
+	limit, err := parse(value)
+	if err != nil || limit > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid limit '%s'", value)
+	}
+	return int64(limit), nil
+}
+
+func getFsConfig(cmd *cobra.Command, fsid uint32) (*fsConfig, error) {
+	fsInfo, err := rpc.GetFsInfo(cmd, fsid, "")
+	if err != nil {
+		return nil, err
+	}
+	_, result, quotaErr := GetFsQuotaData(cmd, fsid)
+	if quotaErr != nil {
+		return nil, quotaErr
+	}
+	quota := result.GetQuota()
+	if quota == nil {
+		quota = &mds.Quota{}
+	}
+	return &fsConfig{fsInfo: fsInfo, quota: quota}, nil
+}
+
+// get values of keys, all configs if no key is specified
+func runGetTunables(cmd *cobra.Command, options getOptions, keys []string) error {
+	tunables := fsTunables
+	if len(keys) > 0 {
+		tunables = []*fsTunable{}
+		for _, key := range keys {
+			tunable, err := findFsTunable(key)
+			if err != nil {
+				return err
+			}
+			tunables = append(tunables, tunable)
+		}
+	}
+
+	config, err := getFsConfig(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	items := []*FsConfigItem{}
+	for _, tunable := range tunables {
+		items = append(items, &FsConfigItem{Key: tunable.key, Value: tunable.get(config), Mutable: tunable.set != nil})
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(&common.OutputResult{Error: errno.ERR_OK, Result: items})
+	}
+	header := []string{common.ROW_KEY, common.ROW_VALUE, common.ROW_MUTABLE}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, item := range items {
+		rows = append(rows, map[string]string{
+			common.ROW_KEY:     item.Key,
+			common.ROW_VALUE:   item.Value,
+			common.ROW_MUTABLE: strconv.FormatBool(item.Mutable),
+		})
+	}
+	// keep order of keys
+	list := table.ListMap2ListSortByKeys(rows, header, []string{})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no config found")
+	return nil
+}
+
+// args are pairs of key and value, changes are shown as diff and confirmed before applied
+func runSetTunables(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions, args []string) error {
+	if len(args)%2 != 0 {
+		return fmt.Errorf("config should be pairs of KEY VALUE")
+	}
+
+	current, err := getFsConfig(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	config := &fsConfig{
+		fsInfo: proto.Clone(current.fsInfo).(*mds.FsInfo),
+		quota:  proto.Clone(current.quota).(*mds.Quota),
+	}
+	items := []*FsConfigItem{}
+	fsInfoChanged, quotaChanged := false, false
+	for i := 0; i < len(args); i += 2 {
+		tunable, err := findFsTunable(args[i])
+		if err != nil {
+			return err
+		}
+		if tunable.set == nil {
+			return fmt.Errorf("config '%s' can not be changed after filesystem is created", tunable.key)
+		}
+		if err := tunable.set(config, strings.TrimSpace(args[i+1])); err != nil {
+			return fmt.Errorf("invalid value of %s: %v", tunable.key, err)
+		}
+		oldValue, newValue := tunable.get(current), tunable.get(config)
+		if oldValue == newValue {
+			continue
+		}
+		items = append(items, &FsConfigItem{Key: tunable.key, Value: oldValue, Mutable: true, NewValue: newValue})
+		if tunable.key == utils.DINGOFS_QUOTA_CAPACITY || tunable.key == utils.DINGOFS_QUOTA_INODES {
+			quotaChanged = true
+		} else {
+			fsInfoChanged = true
+		}
+	}
+	if len(items) == 0 {
+		fmt.Printf("Config of fs %s is not changed\n", current.fsInfo.GetFsName())
+		return nil
+	}
+
+	if options.format != "json" {
+		for _, item := range items {
+			fmt.Printf("  %s: %s -> %s\n", item.Key, item.Value, item.NewValue)
+		}
+	}
+	if !utils.Confirm("Apply changes to fs %s?", current.fsInfo.GetFsName()) {
+		return fmt.Errorf("abort set config of fs %s", current.fsInfo.GetFsName())
+	}
+
+	outputResult := &common.OutputResult{
+		Error:  errno.ERR_OK,
+		Result: items,
+	}
+	if fsInfoChanged {
+		action := cli.NewAction(cli.ACTION_RPC, "UpdateFsInfo(fs=%s)", config.fsInfo.GetFsName())
+		err = dingocli.Perform(action, func() error {
+			return rpc.UpdateFsInfo(cmd, config.fsInfo.GetFsName(), config.fsInfo)
+		})
+		if err != nil {
+			outputResult.Error = errno.FromError(err)
+		}
+	}
+	if quotaChanged && outputResult.Error.GetCode() == errno.ERR_OK.GetCode() {
+		action := cli.NewAction(cli.ACTION_RPC, "SetFsQuota(fs=%s, capacity=%d, inodes=%d)",
+			config.fsInfo.GetFsName(), config.quota.GetMaxBytes(), config.quota.GetMaxInodes())
+		err = dingocli.Perform(action, func() error {
+			if _, setErr := SetFsQuota(cmd, options.fsid, config.quota.GetMaxBytes(), config.quota.GetMaxInodes()); setErr != nil {
+				return setErr
+			}
+			return nil
+		})
+		if err != nil {
+			outputResult.Error = errno.FromError(err)
+		}
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	if !dingocli.IsDryRun() {
+		fmt.Printf("Successfully set config of fs %s\n", current.fsInfo.GetFsName())
+	}
+	return nil
+}
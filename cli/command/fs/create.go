@@ -29,7 +29,6 @@ import (
 	"github.com/dingodb/dingocli/internal/utils"
 	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
 	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
-	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 )
 
@@ -92,19 +91,13 @@ func NewFsCreateCommand(dingocli *cli.DingoCli) *cobra.Command {
 			options.fsname = args[0]
 			//fsid
 			options.fsid = utils.GetUint32Flag(cmd, utils.DINGOFS_FSID)
-			// block size
-			blocksizeStr := utils.GetStringFlag(cmd, utils.DINGOFS_BLOCKSIZE)
-			blocksize, err := humanize.ParseBytes(blocksizeStr)
+			// block size and chunk size, e.g. 4MiB, 64m
+			blocksize, chunksize, err := utils.ParseBlockChunkSize(utils.GetStringFlag(cmd, utils.DINGOFS_BLOCKSIZE),
+				utils.GetStringFlag(cmd, utils.DINGOFS_CHUNKSIZE))
 			if err != nil {
-				return fmt.Errorf("invalid blocksize: %s", blocksizeStr)
+				return err
 			}
 			options.blocksize = blocksize
-			// chunk size
-			chunksizeStr := utils.GetStringFlag(cmd, utils.DINGOFS_CHUNKSIZE)
-			chunksize, err := humanize.ParseBytes(chunksizeStr)
-			if err != nil {
-				return fmt.Errorf("invalid chunksize: %s", chunksizeStr)
-			}
 			options.chunksize = chunksize
 			//storage type
 			storagetypeStr := strings.ToUpper(utils.GetStringFlag(cmd, utils.DINGOFS_STORAGETYPE))
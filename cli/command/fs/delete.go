@@ -18,6 +18,7 @@ package fs
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/common"
@@ -31,14 +32,20 @@ import (
 )
 
 const (
+	DELETE_FLAG_PURGE_DATA = "purge-data"
+
 	FS_DELETE_EXAMPLE = `Examples:
-   $ dingo fs delete dingofs1`
+   $ dingo fs delete dingofs1
+
+   # delete fs and all its data blocks in s3 or rados
+   $ dingo fs delete dingofs1 --purge-data`
 )
 
 type deleteOptions struct {
 	fsname    string
 	format    string
 	noConfirm bool
+	purgeData bool
 }
 
 func NewFsDeleteCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -55,6 +62,7 @@ func NewFsDeleteCommand(dingocli *cli.DingoCli) *cobra.Command {
 			options.fsname = args[0]
 			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
 			options.noConfirm = utils.GetBoolFlag(cmd, utils.DINGOFS_NOCONFIRM)
+			options.purgeData = utils.GetBoolFlag(cmd, DELETE_FLAG_PURGE_DATA)
 
 			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
 
@@ -68,6 +76,7 @@ func NewFsDeleteCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 	// add flags
 	utils.AddBoolFlag(cmd, utils.DINGOFS_NOCONFIRM, "Do not confirm the command")
+	cmd.Flags().Bool(DELETE_FLAG_PURGE_DATA, false, "Also delete data blocks of fs in s3 or rados")
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddFormatFlag(cmd)
 	utils.AddConfigFileFlag(cmd)
@@ -99,20 +108,76 @@ func runDelete(cmd *cobra.Command, dingocli *cli.DingoCli, options deleteOptions
 		},
 	}
 
-	if !options.noConfirm && !utils.AskConfirmation(fmt.Sprintf("Are you sure to delete fs %s?", options.fsname), options.fsname) {
+	// refuse to delete fs in use, data written by clients would be lost
+	fsInfo, err := rpc.GetFsInfo(cmd, 0, options.fsname)
+	if err != nil {
+		return err
+	}
+	if mountpoints := fsInfo.GetMountPoints(); len(mountpoints) > 0 {
+		clients := []string{}
+		for _, mountpoint := range mountpoints {
+			clients = append(clients, fmt.Sprintf("%s:%d:%s", mountpoint.GetIp(), mountpoint.GetPort(), mountpoint.GetPath()))
+		}
+		return errno.ERR_FILESYSTEM_IS_MOUNTED.F("fs %s is mounted by %d client(s): %s, please umount first",
+			options.fsname, len(clients), strings.Join(clients, ", "))
+	}
+
+	var store utils.ObjectStore
+	prompt := fmt.Sprintf("Are you sure to delete fs %s?", options.fsname)
+	if options.purgeData {
+		// block keys carry no fs id, purging a shared bucket or pool deletes data of other filesystems
+		fsInfos, err := rpc.ListFsInfo(cmd)
+		if err != nil {
+			return err
+		}
+		if others := utils.FsSharingObjectStore(fsInfos, fsInfo); len(others) > 0 {
+			return errno.ERR_OBJECT_STORE_SHARED.F("%s is also used by fs %s, can not purge data of fs %s",
+				utils.ObjectStoreId(fsInfo.GetExtra()), strings.Join(others, ", "), options.fsname)
+		}
+		if store, err = utils.NewObjectStore(fsInfo.GetExtra()); err != nil {
+			return errno.ERR_PURGE_FILESYSTEM_DATA_FAILED.E(err)
+		}
+		prompt = fmt.Sprintf("Are you sure to delete fs %s and all objects under %s in %s? data can NOT be recovered!",
+			options.fsname, utils.BLOCK_STORE_PREFIX, store)
+	}
+	if !options.noConfirm && !dingocli.IsDryRun() && !utils.AskConfirmation(prompt, options.fsname) {
 		return fmt.Errorf("abort delete fs")
 	}
 
 	// get rpc result
-	response, rpcError := rpc.GetRpcResponse(deleteRpc.Info, deleteRpc)
-	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
-		outputResult.Error = rpcError
-	} else {
+	action := cli.NewAction(cli.ACTION_RPC, "DeleteFs %s", options.fsname)
+	dingocli.Perform(action, func() error {
+		response, rpcError := rpc.GetRpcResponse(deleteRpc.Info, deleteRpc)
+		if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+			outputResult.Error = rpcError
+			return nil
+		}
 		result := response.(*mds.DeleteFsResponse)
 		if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
 			outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
 		}
 		outputResult.Result = result
+		return nil
+	})
+
+	// purge data only after fs is deleted, so no client can write it again
+	purged := int64(0)
+	if options.purgeData && outputResult.Error.GetCode() == errno.ERR_OK.GetCode() {
+		action := cli.NewAction(cli.ACTION_OBJECT, "delete all objects under %s in %s", utils.BLOCK_STORE_PREFIX, store)
+		err = dingocli.Perform(action, func() error {
+			spinner := output.NewSpinner(fmt.Sprintf("Purging data of fs %s in %s", options.fsname, store))
+			defer spinner.Finish()
+			purged, err = utils.PurgeObjects(store, utils.BLOCK_STORE_PREFIX, spinner.Set64)
+			return err
+		})
+		if err != nil {
+			outputResult.Error = errno.ERR_PURGE_FILESYSTEM_DATA_FAILED.F("fs %s is deleted, but purge data failed after %d objects deleted: %v",
+				options.fsname, purged, err)
+		}
+	}
+
+	if dingocli.IsDryRun() {
+		return nil
 	}
 
 	// print result
@@ -123,6 +188,9 @@ func runDelete(cmd *cobra.Command, dingocli *cli.DingoCli, options deleteOptions
 		return outputResult.Error
 	}
 	fmt.Printf("Successfully delete filesystem %s\n", options.fsname)
+	if options.purgeData {
+		fmt.Printf("Purged %d objects in %s\n", purged, store)
+	}
 
 	return nil
 }
@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/config"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_DF_EXAMPLE = `Examples:
+   $ dingo fs df
+
+   $ dingo fs df --fsname dingofs1 --humanize`
+
+	DF_UNLIMITED = "unlimited"
+)
+
+type dfOptions struct {
+	fsname   string
+	humanize bool
+	format   string
+}
+
+// usage counted by mds in fs quota, so no directory tree walk like fs usage
+type FsDiskFree struct {
+	FsId       uint32 `json:"fs_id"`
+	FsName     string `json:"fs_name"`
+	MaxBytes   int64  `json:"max_bytes"`
+	UsedBytes  int64  `json:"used_bytes"`
+	AvailBytes int64  `json:"avail_bytes"`
+	MaxInodes  int64  `json:"max_inodes"`
+	UsedInodes int64  `json:"used_inodes"`
+	FreeInodes int64  `json:"free_inodes"`
+	// false if no capacity or inodes limit is set
+	BytesLimited  bool `json:"bytes_limited"`
+	InodesLimited bool `json:"inodes_limited"`
+}
+
+func NewFsDfCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options dfOptions
+
+	cmd := &cobra.Command{
+		Use:     "df [OPTIONS]",
+		Short:   "Show capacity and inodes usage of filesystems",
+		Args:    utils.NoArgs,
+		Example: FS_DF_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.fsname = utils.GetStringFlag(cmd, utils.DINGOFS_FSNAME)
+			options.humanize = utils.GetBoolFlag(cmd, utils.DINGOFS_HUMANIZE)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runDf(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddStringFlag(cmd, utils.DINGOFS_FSNAME, "Filesystem name, all filesystems if not set")
+	utils.AddBoolFlag(cmd, utils.DINGOFS_HUMANIZE, "Humanize display")
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runDf(cmd *cobra.Command, dingocli *cli.DingoCli, options dfOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	fsInfos := []*mds.FsInfo{}
+	if len(options.fsname) == 0 {
+		infos, err := rpc.ListFsInfo(cmd)
+		if err != nil {
+			return err
+		}
+		for _, fsInfo := range infos {
+			if fsInfo.GetStatus() == mds.FsStatus_NORMAL {
+				fsInfos = append(fsInfos, fsInfo)
+			}
+		}
+	} else {
+		fsInfo, err := rpc.GetFsInfo(cmd, 0, options.fsname)
+		if err != nil {
+			return err
+		}
+		fsInfos = append(fsInfos, fsInfo)
+	}
+
+	// quotas of all filesystems are got at once
+	dfs, errs := rpc.Batch(cmd, fsInfos, func(fsInfo *mds.FsInfo) (*FsDiskFree, error) {
+		_, result, err := config.GetFsQuotaData(cmd, fsInfo.GetFsId())
+		if err != nil {
+			return nil, err
+		}
+		return newFsDiskFree(fsInfo, result.GetQuota()), nil
+	})
+	if err := rpc.FirstError(errs); err != nil {
+		outputResult.Error = err.(*errno.ErrorCode)
+		dfs = []*FsDiskFree{}
+	}
+	outputResult.Result = dfs
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	// set table header
+	header := []string{common.ROW_FS_ID, common.ROW_FS_NAME, common.ROW_CAPACITY, common.ROW_USED, common.ROW_AVAIL, common.ROW_USED_PERCNET,
+		common.ROW_INODES, common.ROW_INODES_IUSED, common.ROW_INODES_IFREE, common.ROW_INODES_PERCENT}
+	table.SetHeader(header)
+	// fill table
+	rows := make([]map[string]string, 0)
+	for _, df := range dfs {
+		row := make(map[string]string)
+		row[common.ROW_FS_ID] = fmt.Sprintf("%d", df.FsId)
+		row[common.ROW_FS_NAME] = df.FsName
+		row[common.ROW_USED] = formatDfBytes(df.UsedBytes, options.humanize)
+		row[common.ROW_INODES_IUSED] = formatDfInodes(df.UsedInodes, options.humanize)
+		row[common.ROW_CAPACITY], row[common.ROW_AVAIL], row[common.ROW_USED_PERCNET] = DF_UNLIMITED, common.ROW_VALUE_NO_VALUE, common.ROW_VALUE_NO_VALUE
+		if df.BytesLimited {
+			row[common.ROW_CAPACITY] = formatDfBytes(df.MaxBytes, options.humanize)
+			row[common.ROW_AVAIL] = formatDfBytes(df.AvailBytes, options.humanize)
+			row[common.ROW_USED_PERCNET] = dfPercent(df.UsedBytes, df.MaxBytes)
+		}
+		row[common.ROW_INODES], row[common.ROW_INODES_IFREE], row[common.ROW_INODES_PERCENT] = DF_UNLIMITED, common.ROW_VALUE_NO_VALUE, common.ROW_VALUE_NO_VALUE
+		if df.InodesLimited {
+			row[common.ROW_INODES] = formatDfInodes(df.MaxInodes, options.humanize)
+			row[common.ROW_INODES_IFREE] = formatDfInodes(df.FreeInodes, options.humanize)
+			row[common.ROW_INODES_PERCENT] = dfPercent(df.UsedInodes, df.MaxInodes)
+		}
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_FS_ID})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no fs in the cluster")
+
+	return nil
+}
+
+// quota of 0 or math.MaxInt64 means unlimited
+func newFsDiskFree(fsInfo *mds.FsInfo, quota *mds.Quota) *FsDiskFree {
+	df := &FsDiskFree{
+		FsId:       fsInfo.GetFsId(),
+		FsName:     fsInfo.GetFsName(),
+		MaxBytes:   quota.GetMaxBytes(),
+		UsedBytes:  quota.GetUsedBytes(),
+		MaxInodes:  quota.GetMaxInodes(),
+		UsedInodes: quota.GetUsedInodes(),
+	}
+	df.BytesLimited = df.MaxBytes > 0 && df.MaxBytes != math.MaxInt64
+	df.InodesLimited = df.MaxInodes > 0 && df.MaxInodes != math.MaxInt64
+	if df.BytesLimited {
+		df.AvailBytes = max(df.MaxBytes-df.UsedBytes, 0)
+	}
+	if df.InodesLimited {
+		df.FreeInodes = max(df.MaxInodes-df.UsedInodes, 0)
+	}
+	return df
+}
+
+func formatDfBytes(n int64, human bool) string {
+	if human {
+		return humanize.IBytes(uint64(max(n, 0)))
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+func formatDfInodes(n int64, human bool) string {
+	if human {
+		return humanize.Comma(n)
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+func dfPercent(used, total int64) string {
+	return fmt.Sprintf("%d", int(math.Round(float64(used)*100.0/float64(total))))
+}
@@ -142,7 +142,7 @@ func runInfoDir(cmd *cobra.Command, options infoOptions, ino uint64, epoch uint6
 		// recursive subtree aggregation done client-side (the mds has no
 		// server-side tree summary). fast path uses maintained counters when the
 		// fs has dir-stats enabled and --strict was not requested.
-		useFast, fastErr := useFastPath(cmd, options.fsid, options.strict)
+		useFast, fastErr := UseFastPath(cmd, options.fsid, options.strict)
 		if fastErr != nil {
 			outputResult.Error = errno.ERR_RPC_FAILED.S(fastErr.Error())
 			return outputErr(options.format, outputResult)
@@ -263,7 +263,7 @@ func runInfoFile(cmd *cobra.Command, options infoOptions, ino uint64, parent uin
 	if options.format == "json" {
 		result := map[string]interface{}{"info": header}
 		if options.raw {
-			result["chunks"] = buildSliceRows(chunks)
+			result["chunks"] = BuildSliceRows(chunks)
 		} else {
 			result["objects"] = buildObjectRows(chunks, chunkSize, blockSize)
 		}
@@ -282,7 +282,7 @@ func runInfoFile(cmd *cobra.Command, options infoOptions, ino uint64, parent uin
 		fmt.Println("slices:")
 		header := []string{common.ROW_CHUNK_INDEX, common.ROW_SLICE_ID, common.ROW_SIZE, common.ROW_OFFSET, common.ROW_LENGTH}
 		table.SetHeader(header)
-		rows := buildSliceRows(chunks)
+		rows := BuildSliceRows(chunks)
 		table.AppendBulk(table.ListMap2ListSortByKeys(rows, header, []string{}))
 		table.RenderWithNoData("no slices")
 	} else {
@@ -297,7 +297,7 @@ func runInfoFile(cmd *cobra.Command, options infoOptions, ino uint64, parent uin
 	return nil
 }
 
-func buildSliceRows(chunks []*mds.Chunk) []map[string]string {
+func BuildSliceRows(chunks []*mds.Chunk) []map[string]string {
 	rows := make([]map[string]string, 0)
 	for _, chunk := range chunks {
 		for _, slice := range chunk.GetSlices() {
@@ -331,10 +331,10 @@ func buildObjectRows(chunks []*mds.Chunk, chunkSize uint64, blockSize uint64) []
 	return rows
 }
 
-// useFastPath decides whether to read maintained dir-stat counters (fast) or
+// UseFastPath decides whether to read maintained dir-stat counters (fast) or
 // do an authoritative dentry scan (strict). The fast path requires the fs to
 // have dir-stats enabled and --strict not requested, mirroring the C++ client.
-func useFastPath(cmd *cobra.Command, fsId uint32, strict bool) (bool, error) {
+func UseFastPath(cmd *cobra.Command, fsId uint32, strict bool) (bool, error) {
 	if strict {
 		return false, nil
 	}
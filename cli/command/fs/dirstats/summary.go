@@ -135,7 +135,7 @@ func runSummary(cmd *cobra.Command, dingocli *cli.DingoCli, options summaryOptio
 	// the mds has no server-side tree summary; aggregate the subtree client-side
 	// (mirrors dingo-mds-client). fast path uses maintained counters unless
 	// --strict was requested or the fs has dir-stats disabled.
-	useFast, fastErr := useFastPath(cmd, options.fsid, options.strict)
+	useFast, fastErr := UseFastPath(cmd, options.fsid, options.strict)
 	if fastErr != nil {
 		outputResult.Error = errno.ERR_RPC_FAILED.S(fastErr.Error())
 		return outputErr(options.format, outputResult)
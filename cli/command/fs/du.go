@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/dirstats"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_DU_EXAMPLE = `Examples:
+   $ dingo fs du --fsname dingofs1 --path /dir1
+
+   # show 3 levels of directories larger than 10GiB
+   $ dingo fs du --fsname dingofs1 --path /dir1 --depth 3 --threshold 10GiB --humanize`
+
+	DU_FLAG_THRESHOLD = "threshold"
+)
+
+type duOptions struct {
+	fsid      uint32
+	path      string
+	depth     uint32
+	threshold uint64
+	strict    bool
+	humanize  bool
+	format    string
+}
+
+func NewFsDuCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options duOptions
+
+	cmd := &cobra.Command{
+		Use:     "du [OPTIONS]",
+		Short:   "Show recursive size, files and directories of a directory tree",
+		Args:    utils.NoArgs,
+		Example: FS_DU_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.path = path.Clean("/" + utils.GetStringFlag(cmd, utils.DINGOFS_PATH))
+			options.depth = utils.GetUint32Flag(cmd, utils.DINGOFS_DEPTH)
+			threshold, err := cmd.Flags().GetString(DU_FLAG_THRESHOLD)
+			if err != nil {
+				return err
+			}
+			if options.threshold, err = humanize.ParseBytes(threshold); err != nil {
+				return fmt.Errorf("invalid threshold %s: %v", threshold, err)
+			}
+			options.strict = utils.GetBoolFlag(cmd, utils.DINGOFS_STRICT)
+			options.humanize = utils.GetBoolFlag(cmd, utils.DINGOFS_HUMANIZE)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runDu(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_PATH, "Full path of the directory within the volume")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_DEPTH, "Levels of subdirectories to show")
+	cmd.Flags().String(DU_FLAG_THRESHOLD, "0", "Hide subdirectories smaller than threshold, e.g. 100MiB")
+	utils.AddBoolFlag(cmd, utils.DINGOFS_STRICT, "Scan all dentries instead of directory stats maintained by mds")
+	utils.AddBoolFlag(cmd, utils.DINGOFS_HUMANIZE, "Humanize display")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runDu(cmd *cobra.Command, dingocli *cli.DingoCli, options duOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	// epoch + router
+	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if epochErr != nil {
+		return epochErr
+	}
+	if routerErr := rpc.InitFsMDSRouter(cmd, options.fsid); routerErr != nil {
+		return routerErr
+	}
+	dirInodeId, inodeErr := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if inodeErr != nil {
+		return inodeErr
+	}
+
+	// mds keeps usage of each directory, so only directories are listed on the fast path
+	useFast, fastErr := dirstats.UseFastPath(cmd, options.fsid, options.strict)
+	if fastErr != nil {
+		return fastErr
+	}
+	tree, err := rpc.WalkDirTree(cmd, options.fsid, dirInodeId, options.path, useFast, int(options.depth), epoch)
+	if err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	} else {
+		pruneDirTree(tree, options.threshold)
+	}
+	outputResult.Result = tree
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_SIZE, common.ROW_FILES, common.ROW_DIRS, common.ROW_PATH}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	appendDuRows(tree, options.path, options.humanize, &rows)
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no data")
+
+	return nil
+}
+
+// drop subdirectories smaller than threshold, others are sorted by name
+func pruneDirTree(node *rpc.DirTreeNode, threshold uint64) {
+	children := node.Children[:0]
+	for _, child := range node.Children {
+		if child.Length >= threshold {
+			pruneDirTree(child, threshold)
+			children = append(children, child)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool { return children[i].Name < children[j].Name })
+	node.Children = children
+}
+
+// like du, subdirectories are listed before their parent
+func appendDuRows(node *rpc.DirTreeNode, dirPath string, human bool, rows *[][]string) {
+	for _, child := range node.Children {
+		appendDuRows(child, path.Join(dirPath, child.Name), human, rows)
+	}
+	size := fmt.Sprintf("%d", node.Length)
+	if human {
+		size = humanize.IBytes(node.Length)
+	}
+	*rows = append(*rows, []string{size, fmt.Sprintf("%d", node.Files), fmt.Sprintf("%d", node.Dirs), dirPath})
+}
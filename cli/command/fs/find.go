@@ -0,0 +1,364 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_FIND_EXAMPLE = `Examples:
+   # logs larger than 1GiB modified in last 7 days
+   $ dingo fs find --fsname dingofs1 --name '*.log' --size +1G --mtime -7d
+
+   # files not modified for 30 days under /data, at most 2 levels deep
+   $ dingo fs find --fsname dingofs1 --path /data --type f --mtime +30d --maxdepth 2
+
+   # size between 1GiB and 10GiB
+   $ dingo fs find --fsname dingofs1 --size +1G --size -10G --format json`
+)
+
+const (
+	FIND_TYPE_FILE      = "f"
+	FIND_TYPE_DIRECTORY = "d"
+	FIND_TYPE_SYMLINK   = "l"
+)
+
+type findOptions struct {
+	fsid    uint32
+	path    string
+	threads uint32
+	filter  *findFilter
+	format  string
+}
+
+// a predicate of size or mtime, larger than value if cmp is 1, smaller if -1, equal if 0
+type findCondition struct {
+	cmp   int
+	value uint64
+}
+
+// entries match if all predicates match, patterns match the name
+type findFilter struct {
+	names    []string
+	fileType mds.FileType
+	anyType  bool
+	sizes    []findCondition
+	mtimes   []findCondition // mtime in nanoseconds
+	maxDepth int             // negative is unlimited
+}
+
+// the tree is walked through mds directly instead of fuse, directories are walked concurrently,
+// inodes are only got if size or mtime is to be checked
+type findWalker struct {
+	cmd        *cobra.Command
+	fsid       uint32
+	epoch      uint64
+	filter     *findFilter
+	concurrent chan struct{}
+	stream     bool
+
+	mutex   sync.Mutex
+	matches []string
+}
+
+func NewFsFindCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options findOptions
+
+	cmd := &cobra.Command{
+		Use:     "find [OPTIONS]",
+		Short:   "Find files by name, type, size and mtime through mds",
+		Args:    utils.NoArgs,
+		Example: FS_FIND_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.path = path.Clean("/" + utils.GetStringFlag(cmd, utils.DINGOFS_PATH))
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			if options.filter, err = getFindFilter(cmd); err != nil {
+				return err
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runFind(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_PATH, "Full path of the directory to find in")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+	cmd.Flags().StringSlice("name", nil, "Name matches any of the patterns, e.g. '*.log'")
+	cmd.Flags().String("type", "", "Type of entry: f (file), d (directory), l (symlink)")
+	cmd.Flags().StringSlice("size", nil, "Size larger (+) or smaller (-) than or equal to the value, e.g. +1G")
+	cmd.Flags().StringSlice("mtime", nil, "Modified within (-) or before (+) the duration, e.g. -7d")
+	cmd.Flags().Int("maxdepth", -1, "Descend at most levels of directories, -1 is unlimited")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func getFindFilter(cmd *cobra.Command) (*findFilter, error) {
+	filter := &findFilter{anyType: true}
+	filter.names, _ = cmd.Flags().GetStringSlice("name")
+	for _, pattern := range filter.names {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %v", pattern, err)
+		}
+	}
+
+	switch fileType, _ := cmd.Flags().GetString("type"); fileType {
+	case "":
+	case FIND_TYPE_FILE:
+		filter.fileType, filter.anyType = mds.FileType_FILE, false
+	case FIND_TYPE_DIRECTORY:
+		filter.fileType, filter.anyType = mds.FileType_DIRECTORY, false
+	case FIND_TYPE_SYMLINK:
+		filter.fileType, filter.anyType = mds.FileType_SYM_LINK, false
+	default:
+		return nil, fmt.Errorf("invalid type %s, should be: f, d, l", fileType)
+	}
+
+	sizes, _ := cmd.Flags().GetStringSlice("size")
+	for _, value := range sizes {
+		cmp, number := findComparison(value)
+		size, err := utils.ParseSize(number)
+		if err != nil {
+			return nil, err
+		}
+		filter.sizes = append(filter.sizes, findCondition{cmp: cmp, value: size})
+	}
+
+	// modified within the duration means mtime is larger than now - duration
+	now := time.Now()
+	mtimes, _ := cmd.Flags().GetStringSlice("mtime")
+	for _, value := range mtimes {
+		cmp, number := findComparison(value)
+		if cmp == 0 {
+			return nil, fmt.Errorf("invalid mtime %s, should start with + or -, e.g. -7d", value)
+		}
+		duration, err := utils.ParseDuration(number)
+		if err != nil {
+			return nil, err
+		}
+		filter.mtimes = append(filter.mtimes, findCondition{cmp: -cmp, value: uint64(now.Add(-duration).UnixNano())})
+	}
+
+	filter.maxDepth, _ = cmd.Flags().GetInt("maxdepth")
+	return filter, nil
+}
+
+func findComparison(value string) (int, string) {
+	switch {
+	case strings.HasPrefix(value, "+"):
+		return 1, value[1:]
+	case strings.HasPrefix(value, "-"):
+		return -1, value[1:]
+	default:
+		return 0, value
+	}
+}
+
+func (condition findCondition) match(value uint64) bool {
+	switch condition.cmp {
+	case 1:
+		return value > condition.value
+	case -1:
+		return value < condition.value
+	default:
+		return value == condition.value
+	}
+}
+
+func (filter *findFilter) needInode() bool {
+	return len(filter.sizes) > 0 || len(filter.mtimes) > 0
+}
+
+func (filter *findFilter) matchEntry(entry *mds.Dentry) bool {
+	if !filter.anyType && entry.GetType() != filter.fileType {
+		return false
+	}
+	return len(filter.names) == 0 || matchAnyName(filter.names, entry.GetName())
+}
+
+func (filter *findFilter) matchInode(inode *mds.Inode) bool {
+	for _, condition := range filter.sizes {
+		if !condition.match(inode.GetLength()) {
+			return false
+		}
+	}
+	for _, condition := range filter.mtimes {
+		if !condition.match(inode.GetMtime()) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAnyName(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// mds has no search rpc, predicates are evaluated on metadata got from mds.
+// paths are printed once found, in json they are sorted and printed at last
+func runFind(cmd *cobra.Command, dingocli *cli.DingoCli, options findOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+	dirInodeId, err := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if err != nil {
+		return err
+	}
+
+	walker := &findWalker{
+		cmd:        cmd,
+		fsid:       options.fsid,
+		epoch:      epoch,
+		filter:     options.filter,
+		concurrent: make(chan struct{}, options.threads),
+		stream:     options.format != "json",
+		matches:    []string{},
+	}
+	if err := walker.walk(dirInodeId, options.path, 0); err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	}
+
+	// print result
+	if options.format == "json" {
+		sort.Strings(walker.matches)
+		outputResult.Result = walker.matches
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+func (w *findWalker) walk(ino uint64, dirPath string, depth int) error {
+	entries, err := rpc.ListDentry(w.cmd, w.fsid, ino, w.epoch)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var errMutex sync.Mutex
+	var walkErr error
+	for _, entry := range entries {
+		entryPath := path.Join(dirPath, entry.GetName())
+		if err := w.check(entry, entryPath); err != nil {
+			wg.Wait()
+			return err
+		}
+		if entry.GetType() != mds.FileType_DIRECTORY || (w.filter.maxDepth >= 0 && depth+1 >= w.filter.maxDepth) {
+			continue
+		}
+		select {
+		case w.concurrent <- struct{}{}:
+			wg.Add(1)
+			go func(ino uint64, dirPath string) {
+				defer wg.Done()
+				defer func() { <-w.concurrent }()
+				if err := w.walk(ino, dirPath, depth+1); err != nil {
+					errMutex.Lock()
+					walkErr = err
+					errMutex.Unlock()
+				}
+			}(entry.GetIno(), entryPath)
+		default:
+			// all threads are busy, walk in current one
+			if err := w.walk(entry.GetIno(), entryPath, depth+1); err != nil {
+				wg.Wait()
+				return err
+			}
+		}
+	}
+	wg.Wait()
+	return walkErr
+}
+
+func (w *findWalker) check(entry *mds.Dentry, entryPath string) error {
+	if !w.filter.matchEntry(entry) {
+		return nil
+	}
+	if w.filter.needInode() {
+		inode, err := rpc.GetInode(w.cmd, w.fsid, entry.GetIno(), entry.GetParent(), w.epoch)
+		if err != nil {
+			return fmt.Errorf("get inode of %s failed: %v", entryPath, err)
+		}
+		if !w.filter.matchInode(inode) {
+			return nil
+		}
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.stream {
+		fmt.Println(entryPath)
+	} else {
+		w.matches = append(w.matches, entryPath)
+	}
+	return nil
+}
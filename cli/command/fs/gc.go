@@ -0,0 +1,307 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_GC_EXAMPLE = `Examples:
+   # report orphan objects older than 1 day
+   $ dingo fs gc --fsname dingofs1
+
+   $ dingo fs gc --fsname dingofs1 --grace 7d --delete`
+
+	GC_FLAG_DELETE = "delete"
+	GC_FLAG_GRACE  = "grace"
+
+	GC_DEFAULT_GRACE = "1d"
+	GC_TRASH_PATH    = "/.trash"
+)
+
+type gcOptions struct {
+	fsid    uint32
+	fsname  string
+	grace   time.Duration
+	delete  bool
+	threads uint32
+	format  string
+}
+
+type GcObject struct {
+	Key   string    `json:"key"`
+	Size  int64     `json:"size"`
+	Mtime time.Time `json:"mtime"`
+}
+
+type GcReport struct {
+	FsId       uint32      `json:"fs_id"`
+	FsName     string      `json:"fs_name"`
+	Storage    string      `json:"storage"`
+	Objects    int64       `json:"objects"`
+	Referenced int64       `json:"referenced"`
+	Orphans    []*GcObject `json:"orphans"`
+	// orphans within grace period, which may be written by clients now
+	Skipped int64 `json:"skipped"`
+	Deleted int64 `json:"deleted"`
+}
+
+func NewFsGcCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options gcOptions
+
+	cmd := &cobra.Command{
+		Use:     "gc [OPTIONS]",
+		Short:   "Find and delete objects in storage not referenced by any file",
+		Args:    utils.NoArgs,
+		Example: FS_GC_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			fsname, err := rpc.GetFsName(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsname = fsname
+
+			grace, _ := cmd.Flags().GetString(GC_FLAG_GRACE)
+			if options.grace, err = utils.ParseDuration(grace); err != nil {
+				return fmt.Errorf("invalid --%s: %v", GC_FLAG_GRACE, err)
+			}
+			options.delete = utils.GetBoolFlag(cmd, GC_FLAG_DELETE)
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runGc(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().String(GC_FLAG_GRACE, GC_DEFAULT_GRACE, "Only objects older than grace period are orphans, e.g. 12h, 7d")
+	cmd.Flags().Bool(GC_FLAG_DELETE, false, "Delete orphan objects, only report them by default")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// objects in storage are cross-referenced with blocks of all files, including files in trash,
+// a bucket or pool shared by filesystems is refused as blocks have no fs id in keys
+func runGc(cmd *cobra.Command, dingocli *cli.DingoCli, options gcOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+	fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+	if err != nil {
+		return err
+	}
+	if fsInfo.GetChunkSize() == 0 {
+		return fmt.Errorf("invalid chunk size of fs %s", options.fsname)
+	}
+	// blocks of other filesystems would be reported and deleted as orphans
+	fsInfos, err := rpc.ListFsInfo(cmd)
+	if err != nil {
+		return err
+	}
+	if others := utils.FsSharingObjectStore(fsInfos, fsInfo); len(others) > 0 {
+		return errno.ERR_OBJECT_STORE_SHARED.F("%s is also used by fs %s, can not gc objects of fs %s",
+			utils.ObjectStoreId(fsInfo.GetExtra()), strings.Join(others, ", "), options.fsname)
+	}
+	store, err := utils.NewObjectStore(fsInfo.GetExtra())
+	if err != nil {
+		return err
+	}
+
+	// list objects before scan, so blocks written during scan are not orphans
+	objects := map[string]*GcObject{}
+	sizes := map[string]int64{}
+	spinner := output.NewSpinner(fmt.Sprintf("Listing objects in %s", store))
+	err = store.List(utils.BLOCK_STORE_PREFIX, func(key string, size int64, mtime time.Time) error {
+		objects[key] = &GcObject{Key: key, Size: size, Mtime: mtime}
+		sizes[key] = size
+		spinner.Add64(1)
+		return nil
+	})
+	spinner.Finish()
+	if err != nil {
+		return errno.ERR_GC_OBJECTS_FAILED.E(err)
+	}
+
+	checker := &fsChecker{
+		cmd:       cmd,
+		dingocli:  dingocli,
+		options:   checkOptions{fsid: options.fsid, fsname: options.fsname, path: "/", threads: options.threads},
+		epoch:     epoch,
+		chunkSize: fsInfo.GetChunkSize(),
+		blockSize: fsInfo.GetBlockSize(),
+		objects:   sizes,
+		refs:      map[string]bool{},
+		sem:       make(chan struct{}, options.threads),
+		report:    &FsckReport{FsId: options.fsid, FsName: options.fsname, Path: "/", Issues: []*FsckIssue{}},
+		links:     map[uint64]*fileLinks{},
+		dirs:      map[uint64]bool{common.ROOTINODEID: true},
+	}
+	spinner = output.NewSpinner(fmt.Sprintf("Scanning blocks of fs %s", options.fsname))
+	_, _, err = checker.walkDir(common.ROOTINODEID, "/")
+	if err == nil {
+		// trash is not a child of root, no trash if it is not enabled
+		if _, _, err = checker.walkDir(common.TRASHINODEID, GC_TRASH_PATH); isNotFound(err) {
+			err = nil
+		}
+	}
+	spinner.Finish()
+	if err != nil {
+		return errno.ERR_GC_OBJECTS_FAILED.E(err)
+	}
+	// blocks of these files are unknown, deleting would lose data
+	if checker.sliceErrors > 0 {
+		return errno.ERR_GC_OBJECTS_FAILED.F("read slices of %d files failed, run dingo fs check for details", checker.sliceErrors)
+	}
+
+	report := &GcReport{FsId: options.fsid, FsName: options.fsname, Storage: store.String(),
+		Objects: int64(len(objects)), Referenced: int64(len(checker.refs))}
+	stater, _ := store.(utils.ObjectStater)
+	report.Orphans, report.Skipped = gcOrphans(objects, checker.refs, stater, time.Now().Add(-options.grace))
+
+	if options.delete && len(report.Orphans) > 0 {
+		if !utils.Confirm("Are you sure to delete %d orphan objects (%s) in %s?", len(report.Orphans),
+			humanize.IBytes(uint64(gcOrphanBytes(report.Orphans))), store) {
+			return fmt.Errorf("abort gc of fs %s", options.fsname)
+		}
+		keys := make([]string, 0, len(report.Orphans))
+		for _, object := range report.Orphans {
+			keys = append(keys, object.Key)
+		}
+		action := cli.NewAction(cli.ACTION_OBJECT, "delete %d orphan objects in %s", len(keys), store)
+		err := dingocli.Perform(action, func() error {
+			return store.Delete(keys)
+		})
+		if err != nil {
+			outputResult.Error = errno.ERR_GC_OBJECTS_FAILED.E(err)
+		} else if !dingocli.IsDryRun() {
+			report.Deleted = int64(len(keys))
+		}
+	}
+	outputResult.Result = report
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_OBJECT_NAME, common.ROW_SIZE, common.ROW_MTIME}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, object := range report.Orphans {
+		row := make(map[string]string)
+		row[common.ROW_OBJECT_NAME] = object.Key
+		row[common.ROW_SIZE] = fmt.Sprintf("%d", object.Size)
+		row[common.ROW_MTIME] = object.Mtime.Local().Format(time.DateTime)
+		rows = append(rows, row)
+	}
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_OBJECT_NAME})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no orphan object found")
+
+	fmt.Printf("Found %d objects in %s, %d referenced, %d orphans (%s), %d skipped within grace period %s",
+		report.Objects, report.Storage, report.Referenced, len(report.Orphans),
+		humanize.IBytes(uint64(gcOrphanBytes(report.Orphans))), report.Skipped, options.grace)
+	if options.delete {
+		fmt.Printf(", %d deleted", report.Deleted)
+	}
+	fmt.Println()
+
+	return nil
+}
+
+// objects not referenced and modified before deadline, sorted by key, the others
+// are skipped, stater is used for objects listed without mtime and may be nil
+func gcOrphans(objects map[string]*GcObject, refs map[string]bool, stater utils.ObjectStater, deadline time.Time) ([]*GcObject, int64) {
+	orphans := []*GcObject{}
+	skipped := int64(0)
+	for key, object := range objects {
+		if refs[key] {
+			continue
+		}
+		if object.Mtime.IsZero() && stater != nil {
+			if size, mtime, err := stater.Stat(key); err == nil {
+				object.Size, object.Mtime = size, mtime
+			}
+		}
+		// unknown mtime is treated as new
+		if object.Mtime.IsZero() || object.Mtime.After(deadline) {
+			skipped++
+			continue
+		}
+		orphans = append(orphans, object)
+	}
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i].Key < orphans[j].Key })
+	return orphans, skipped
+}
+
+func gcOrphanBytes(objects []*GcObject) int64 {
+	total := int64(0)
+	for _, object := range objects {
+		total += max(object.Size, 0)
+	}
+	return total
+}
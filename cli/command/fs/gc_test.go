@@ -0,0 +1,107 @@
+package fs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStater map[string]time.Time
+
+func (s fakeStater) Stat(key string) (int64, time.Time, error) {
+	mtime, ok := s[key]
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("%s not found", key)
+	}
+	return 100, mtime, nil
+}
+
+func TestGcOrphans(t *testing.T) {
+	deadline := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	old, recent := deadline.Add(-time.Hour), deadline.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		objects []*GcObject
+		refs    []string
+		stater  fakeStater
+		orphans []string
+		skipped int64
+	}{
+		{
+			name:    "referenced",
+			objects: []*GcObject{{Key: "blocks/1", Size: 10, Mtime: old}},
+			refs:    []string{"blocks/1"},
+			orphans: []string{},
+		},
+		{
+			name: "orphans sorted by key",
+			objects: []*GcObject{
+				{Key: "blocks/2", Size: 10, Mtime: old},
+				{Key: "blocks/1", Size: 10, Mtime: old},
+				{Key: "blocks/3", Size: 10, Mtime: old},
+			},
+			refs:    []string{"blocks/3"},
+			orphans: []string{"blocks/1", "blocks/2"},
+		},
+		{
+			name:    "within grace period",
+			objects: []*GcObject{{Key: "blocks/1", Size: 10, Mtime: recent}},
+			orphans: []string{},
+			skipped: 1,
+		},
+		{
+			name:    "unknown mtime without stater",
+			objects: []*GcObject{{Key: "blocks/1", Size: -1}},
+			orphans: []string{},
+			skipped: 1,
+		},
+		{
+			name: "mtime by stater",
+			objects: []*GcObject{
+				{Key: "blocks/1", Size: -1},
+				{Key: "blocks/2", Size: -1},
+				{Key: "blocks/3", Size: -1},
+			},
+			stater:  fakeStater{"blocks/1": old, "blocks/2": recent},
+			orphans: []string{"blocks/1"},
+			skipped: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			objects := map[string]*GcObject{}
+			for _, object := range tt.objects {
+				objects[object.Key] = object
+			}
+			refs := map[string]bool{}
+			for _, key := range tt.refs {
+				refs[key] = true
+			}
+			// nil map is not a nil interface
+			var stater utils.ObjectStater
+			if tt.stater != nil {
+				stater = tt.stater
+			}
+			orphans, skipped := gcOrphans(objects, refs, stater, deadline)
+			keys := []string{}
+			for _, object := range orphans {
+				keys = append(keys, object.Key)
+				assert.False(object.Mtime.IsZero())
+			}
+			assert.Equal(tt.orphans, keys)
+			assert.Equal(tt.skipped, skipped)
+		})
+	}
+}
+
+func TestGcOrphanBytes(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(int64(0), gcOrphanBytes(nil))
+	// size of rados objects is unknown if stat failed
+	assert.Equal(int64(30), gcOrphanBytes([]*GcObject{{Size: 10}, {Size: -1}, {Size: 20}}))
+}
@@ -0,0 +1,432 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sort"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_HEALTH_EXAMPLE = `Examples:
+   $ dingo fs health
+
+   # print state changes every 10 seconds, and run a hook when any check changes
+   $ dingo fs health --watch --interval 10s --hook '/usr/local/bin/notify.sh'
+
+   # watchdog of local mountpoints under systemd, exits when any of them is unhealthy
+   $ dingo fs health --watch --check mountpoint --exit-on-unhealthy`
+
+	HEALTH_CHECK_MOUNTPOINT = "mountpoint"
+	HEALTH_CHECK_MDS        = "mds"
+	HEALTH_CHECK_CACHEGROUP = "cachegroup"
+
+	HEALTH_OK       = "ok"
+	HEALTH_DEGRADED = "degraded"
+	HEALTH_DOWN     = "down"
+	// check of a mountpoint which is umounted, or a member which is removed
+	HEALTH_REMOVED = "removed"
+	HEALTH_UNKNOWN = "unknown"
+
+	HEALTH_MDS_CLUSTER = "cluster"
+	HEALTH_TIME_FORMAT = "2006-01-02 15:04:05"
+)
+
+type healthOptions struct {
+	checks          []string
+	mountpoints     []string
+	group           string
+	timeout         time.Duration
+	slow            time.Duration
+	watch           bool
+	interval        time.Duration
+	exitOnUnhealthy bool
+	hook            string
+	format          string
+}
+
+// latency is in milliseconds, 0 if not measured
+type HealthCheck struct {
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	State   string `json:"state"`
+	Latency int64  `json:"latency_ms"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+func (c *HealthCheck) Key() string {
+	return c.Kind + " " + c.Name
+}
+
+type HealthTransition struct {
+	Time     time.Time    `json:"time"`
+	Previous string       `json:"previous"`
+	Check    *HealthCheck `json:"check"`
+}
+
+func NewFsHealthCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options healthOptions
+
+	cmd := &cobra.Command{
+		Use:     "health [OPTIONS]",
+		Short:   "Check health of mountpoints, mds and cache group members",
+		Args:    utils.NoArgs,
+		Example: FS_HEALTH_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.checks, _ = cmd.Flags().GetStringSlice("check")
+			for _, check := range options.checks {
+				switch check {
+				case HEALTH_CHECK_MOUNTPOINT, HEALTH_CHECK_MDS, HEALTH_CHECK_CACHEGROUP:
+				default:
+					return fmt.Errorf("invalid check %s, should be: %s, %s, %s",
+						check, HEALTH_CHECK_MOUNTPOINT, HEALTH_CHECK_MDS, HEALTH_CHECK_CACHEGROUP)
+				}
+			}
+			options.mountpoints, _ = cmd.Flags().GetStringSlice("mountpoint")
+			options.group, _ = cmd.Flags().GetString("group")
+			options.timeout, _ = cmd.Flags().GetDuration("timeout")
+			options.slow, _ = cmd.Flags().GetDuration("slow")
+			options.watch, _ = cmd.Flags().GetBool("watch")
+			options.interval, _ = cmd.Flags().GetDuration("interval")
+			if options.interval <= 0 || options.timeout <= 0 {
+				return fmt.Errorf("interval and timeout should be greater than 0")
+			}
+			options.exitOnUnhealthy, _ = cmd.Flags().GetBool("exit-on-unhealthy")
+			options.hook, _ = cmd.Flags().GetString("hook")
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runHealth(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().StringSlice("check", []string{HEALTH_CHECK_MOUNTPOINT, HEALTH_CHECK_MDS, HEALTH_CHECK_CACHEGROUP}, "Checks to run: mountpoint, mds, cachegroup")
+	cmd.Flags().StringSlice("mountpoint", nil, "Mountpoints to check, all local dingofs mountpoints if not set")
+	cmd.Flags().String("group", "", "Only check members of the cache group")
+	cmd.Flags().Duration("timeout", utils.MOUNTPOINT_PROBE_TIMEOUT, "Mountpoint not answering statfs in the timeout is hung")
+	cmd.Flags().Duration("slow", 500*time.Millisecond, "Mountpoint answering statfs slower than it is degraded")
+	cmd.Flags().BoolP("watch", "w", false, "Check periodically and print state changes until interrupted")
+	cmd.Flags().Duration("interval", 10*time.Second, "Interval of checks in watch mode")
+	cmd.Flags().Bool("exit-on-unhealthy", false, "Exit with error in watch mode once any check is not ok")
+	cmd.Flags().String("hook", "", "Shell command run on every state change in watch mode, with DINGO_HEALTH_* environment variables")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runHealth(cmd *cobra.Command, dingocli *cli.DingoCli, options healthOptions) error {
+	if options.watch {
+		return watchHealth(cmd, options)
+	}
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	checks := runHealthChecks(cmd, options)
+	if !allHealthy(checks) {
+		outputResult.Error = errno.ERR_FS_UNHEALTHY
+	}
+	outputResult.Result = checks
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_TYPE, common.ROW_NAME, common.ROW_STATE, common.ROW_LATENCY, common.ROW_REASON}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, check := range checks {
+		rows = append(rows, []string{
+			check.Kind,
+			check.Name,
+			check.State,
+			utils.Ternary(check.Latency > 0, fmt.Sprintf("%dms", check.Latency), common.ROW_VALUE_NO_VALUE),
+			utils.Ternary(check.Detail != "", check.Detail, common.ROW_VALUE_NO_VALUE),
+		})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("nothing to check")
+
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+func allHealthy(checks []*HealthCheck) bool {
+	for _, check := range checks {
+		if check.State != HEALTH_OK {
+			return false
+		}
+	}
+	return true
+}
+
+// checks run in the order of --check, and are sorted by name in each kind
+func runHealthChecks(cmd *cobra.Command, options healthOptions) []*HealthCheck {
+	checks := []*HealthCheck{}
+	for _, kind := range options.checks {
+		var items []*HealthCheck
+		switch kind {
+		case HEALTH_CHECK_MOUNTPOINT:
+			items = checkMountPoints(cmd, options)
+		case HEALTH_CHECK_MDS:
+			items = checkMDS(cmd)
+		case HEALTH_CHECK_CACHEGROUP:
+			items = checkCacheMembers(cmd, options)
+		}
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+		checks = append(checks, items...)
+	}
+	return checks
+}
+
+// mountpoints given by --mountpoint are down if not mounted
+func checkMountPoints(cmd *cobra.Command, options healthOptions) []*HealthCheck {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return []*HealthCheck{{Kind: HEALTH_CHECK_MOUNTPOINT, Name: "*", State: HEALTH_UNKNOWN, Detail: err.Error()}}
+	}
+	mounted := map[string]bool{}
+	paths := []string{}
+	for _, mountpoint := range mountpoints {
+		mounted[mountpoint.MountPoint] = true
+		if len(options.mountpoints) == 0 {
+			paths = append(paths, mountpoint.MountPoint)
+		}
+	}
+
+	checks := []*HealthCheck{}
+	for _, path := range options.mountpoints {
+		if mounted[path] {
+			paths = append(paths, path)
+		} else {
+			checks = append(checks, &HealthCheck{Kind: HEALTH_CHECK_MOUNTPOINT, Name: path, State: HEALTH_DOWN, Detail: "not mounted"})
+		}
+	}
+	// a hung mountpoint blocks its probe until timeout, so they are probed at once
+	probes, _ := rpc.Batch(cmd, paths, func(path string) (*HealthCheck, error) {
+		start := time.Now()
+		health, reason := utils.ProbeMountPoint(path, options.timeout)
+		check := &HealthCheck{Kind: HEALTH_CHECK_MOUNTPOINT, Name: path, State: HEALTH_OK, Detail: reason}
+		switch {
+		case health != utils.MOUNTPOINT_HEALTH_OK:
+			check.State, check.Detail = HEALTH_DOWN, health+": "+reason
+		default:
+			latency := time.Since(start)
+			check.Latency = latency.Milliseconds()
+			if latency > options.slow {
+				check.State, check.Detail = HEALTH_DEGRADED, fmt.Sprintf("statfs slower than %s", options.slow)
+			}
+		}
+		return check, nil
+	})
+	return append(checks, probes...)
+}
+
+// the cluster is down if mds list can not be got, an offline mds degrades the cluster
+func checkMDS(cmd *cobra.Command) []*HealthCheck {
+	start := time.Now()
+	mdsInfos, err := rpc.GetMDSList(cmd)
+	cluster := &HealthCheck{Kind: HEALTH_CHECK_MDS, Name: HEALTH_MDS_CLUSTER, State: HEALTH_OK, Latency: time.Since(start).Milliseconds()}
+	if err != nil {
+		cluster.State, cluster.Latency, cluster.Detail = HEALTH_DOWN, 0, err.Error()
+		return []*HealthCheck{cluster}
+	}
+
+	checks := []*HealthCheck{cluster}
+	for _, mdsInfo := range mdsInfos {
+		check := &HealthCheck{
+			Kind:  HEALTH_CHECK_MDS,
+			Name:  fmt.Sprintf("%d %s:%d", mdsInfo.GetId(), mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort()),
+			State: HEALTH_OK,
+		}
+		if !mdsInfo.GetIsOnline() {
+			check.State, check.Detail = HEALTH_DOWN, common.ROW_VALUE_OFFLINE
+			cluster.State, cluster.Detail = HEALTH_DEGRADED, "some mds are offline"
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+func checkCacheMembers(cmd *cobra.Command, options healthOptions) []*HealthCheck {
+	members, err := rpc.ListCacheMembers(cmd)
+	if err != nil {
+		return []*HealthCheck{{Kind: HEALTH_CHECK_CACHEGROUP, Name: "*", State: HEALTH_UNKNOWN, Detail: err.Error()}}
+	}
+
+	checks := []*HealthCheck{}
+	for _, member := range members {
+		if options.group != "" && member.GetGroupName() != options.group {
+			continue
+		}
+		check := &HealthCheck{
+			Kind:  HEALTH_CHECK_CACHEGROUP,
+			Name:  fmt.Sprintf("%s/%s:%d", member.GetGroupName(), member.GetIp(), member.GetPort()),
+			State: HEALTH_OK,
+		}
+		switch member.GetState() {
+		case mds.CacheGroupMemberState_CacheGroupMemberStateOnline:
+		case mds.CacheGroupMemberState_CacheGroupMemberStateUnstable:
+			check.State, check.Detail = HEALTH_DEGRADED, utils.TranslateCacheGroupMemberState(member.GetState())
+		default:
+			check.State, check.Detail = HEALTH_DOWN, utils.TranslateCacheGroupMemberState(member.GetState())
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// changes of states against the last round, the first round reports checks which are not ok
+func healthTransitions(last map[string]*HealthCheck, checks []*HealthCheck, now time.Time) []*HealthTransition {
+	transitions := []*HealthTransition{}
+	current := map[string]bool{}
+	for _, check := range checks {
+		current[check.Key()] = true
+		previous := HEALTH_UNKNOWN
+		if lastCheck, ok := last[check.Key()]; ok {
+			previous = lastCheck.State
+		} else if check.State == HEALTH_OK && len(last) == 0 {
+			continue
+		}
+		if previous != check.State {
+			transitions = append(transitions, &HealthTransition{Time: now, Previous: previous, Check: check})
+		}
+	}
+	for key, lastCheck := range last {
+		if !current[key] {
+			removed := *lastCheck
+			removed.State, removed.Latency, removed.Detail = HEALTH_REMOVED, 0, ""
+			transitions = append(transitions, &HealthTransition{Time: now, Previous: lastCheck.State, Check: &removed})
+		}
+	}
+	return transitions
+}
+
+// run until interrupted, pings watchdog of systemd after every round
+func watchHealth(cmd *cobra.Command, options healthOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// rpc timeouts and log level changed in configure file take effect while watching
+	rpc.WatchRpcConfig(cmd)
+	if err := utils.SdNotify(utils.SD_NOTIFY_READY); err != nil {
+		logger.Warnf("notify systemd failed: %v", err)
+	}
+	if options.format != "json" {
+		fmt.Printf("Every %s: checking %v, press Ctrl+C to stop\n", options.interval, options.checks)
+	}
+
+	last := map[string]*HealthCheck{}
+	ticker := time.NewTicker(options.interval)
+	defer ticker.Stop()
+	for {
+		checks := runHealthChecks(cmd, options)
+		for _, transition := range healthTransitions(last, checks, time.Now()) {
+			printHealthTransition(transition, options.format)
+			runHealthHook(options.hook, transition)
+		}
+		last = map[string]*HealthCheck{}
+		for _, check := range checks {
+			last[check.Key()] = check
+		}
+		utils.SdNotify(utils.SD_NOTIFY_WATCHDOG)
+		if options.exitOnUnhealthy && !allHealthy(checks) {
+			utils.SdNotify(utils.SD_NOTIFY_STOPPING)
+			return errno.ERR_FS_UNHEALTHY
+		}
+
+		select {
+		case <-ctx.Done():
+			utils.SdNotify(utils.SD_NOTIFY_STOPPING)
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func printHealthTransition(transition *HealthTransition, format string) {
+	if format == "json" {
+		data, _ := json.Marshal(transition)
+		fmt.Println(string(data))
+		return
+	}
+	check := transition.Check
+	line := fmt.Sprintf("%s %s %s: %s -> %s", transition.Time.Format(HEALTH_TIME_FORMAT), check.Kind, check.Name,
+		transition.Previous, check.State)
+	if check.Detail != "" {
+		line += " (" + check.Detail + ")"
+	}
+	fmt.Println(line)
+}
+
+// hook failures are logged, they never stop watching
+func runHealthHook(hook string, transition *HealthTransition) {
+	if hook == "" {
+		return
+	}
+	check := transition.Check
+	command := exec.Command("sh", "-c", hook)
+	command.Stdout, command.Stderr = os.Stderr, os.Stderr
+	command.Env = append(os.Environ(),
+		"DINGO_HEALTH_KIND="+check.Kind,
+		"DINGO_HEALTH_NAME="+check.Name,
+		"DINGO_HEALTH_STATE="+check.State,
+		"DINGO_HEALTH_PREVIOUS="+transition.Previous,
+		"DINGO_HEALTH_DETAIL="+check.Detail,
+	)
+	if err := command.Run(); err != nil {
+		logger.Warnf("health hook '%s' failed: %v", hook, err)
+	}
+}
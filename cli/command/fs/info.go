@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_INFO_EXAMPLE = `Examples:
+   $ dingo fs info /mnt/dingofs
+
+   $ dingo fs info /mnt/dingofs --format json`
+)
+
+type infoOptions struct {
+	path   string
+	format string
+}
+
+func NewFsInfoCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options infoOptions
+
+	cmd := &cobra.Command{
+		Use:               "info MOUNTPOINT [OPTIONS]",
+		Short:             "Show information of local mountpoint",
+		Args:              utils.ExactArgs(1),
+		Example:           FS_INFO_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.path = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runInfo(options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+// everything is gathered from the mountpoint and client process, no mds is required
+func runInfo(options infoOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	var info *utils.MountPointInfo
+	mountpoint, err := utils.FindDingoFSMountPoint(options.path)
+	if err != nil {
+		outputResult.Error = errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	} else {
+		info = utils.GetMountPointInfo(mountpoint)
+		outputResult.Result = info
+	}
+
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	orNoValue := func(value string) string {
+		return utils.Ternary(value != "", value, common.ROW_VALUE_NO_VALUE)
+	}
+	items := [][]string{
+		{"mountpoint", info.MountPoint},
+		{"fsname", orNoValue(info.FsName)},
+		{"fsid", orNoValue(info.FsId)},
+		{"version", utils.Ternary(info.Version != "", info.Version, common.ROW_VALUE_UNKNOWN)},
+		{"commit", utils.Ternary(info.Commit != "", info.Commit, common.ROW_VALUE_UNKNOWN)},
+		{"pid", common.ROW_VALUE_NO_VALUE},
+		{"uptime", common.ROW_VALUE_NO_VALUE},
+		{"health", info.Health},
+		{"mount options", orNoValue(strings.Join(info.MountOptions, ","))},
+		{"client args", orNoValue(strings.Join(info.ClientArgs, " "))},
+		{"mds", orNoValue(strings.Join(info.MDSAddrs, ","))},
+	}
+	if info.Pid > 0 {
+		items[5][1] = fmt.Sprintf("%d", info.Pid)
+	}
+	if !info.StartTime.IsZero() {
+		items[6][1] = info.Uptime().String()
+	}
+	if info.Error != "" {
+		items[7][1] = fmt.Sprintf("%s (%s)", info.Health, info.Error)
+	}
+	if len(info.CacheDirs) == 0 {
+		items = append(items, []string{"cache dir", common.ROW_VALUE_NO_VALUE})
+	}
+	for _, cacheDir := range info.CacheDirs {
+		usage := fmt.Sprintf("%s, used %s (%s files), disk %s free of %s", cacheDir.Dir,
+			humanize.IBytes(cacheDir.UsedBytes), humanize.Comma(int64(cacheDir.Files)),
+			humanize.IBytes(cacheDir.DiskFree), humanize.IBytes(cacheDir.DiskTotal))
+		if cacheDir.Error != "" {
+			usage = fmt.Sprintf("%s, %s", cacheDir.Dir, cacheDir.Error)
+		}
+		items = append(items, []string{"cache dir", usage})
+	}
+
+	table.SetHeader([]string{common.ROW_KEY, common.ROW_VALUE})
+	table.AppendBulk(items)
+	table.RenderWithNoData("no information of mountpoint")
+
+	return nil
+}
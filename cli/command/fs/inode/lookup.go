@@ -0,0 +1,267 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inode
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/dirstats"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	INODE_LOOKUP_EXAMPLE = `Examples:
+   # path in a local dingofs mountpoint
+   $ dingo fs inode lookup /mnt/dingofs/dir1/file.bin
+
+   # path within the filesystem
+   $ dingo fs inode lookup /dir1/file.bin --fsname dingofs1`
+)
+
+type lookupOptions struct {
+	path   string
+	format string
+}
+
+type InodeLookupResult struct {
+	FsId      uint32              `json:"fs_id"`
+	Path      string              `json:"path"`
+	Ino       uint64              `json:"ino"`
+	Parent    uint64              `json:"parent"`
+	Type      string              `json:"type"`
+	Length    uint64              `json:"length"`
+	Nlink     uint32              `json:"nlink"`
+	Mode      uint32              `json:"mode"`
+	Uid       uint32              `json:"uid"`
+	Gid       uint32              `json:"gid"`
+	Parents   []uint64            `json:"parents"`
+	ChunkSize uint64              `json:"chunk_size"`
+	Chunks    []map[string]string `json:"chunks"`
+}
+
+func NewInodeLookupCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options lookupOptions
+
+	cmd := &cobra.Command{
+		Use:     "lookup PATH [OPTIONS]",
+		Short:   "Lookup inode and chunk layout of path",
+		Args:    utils.ExactArgs(1),
+		Example: INODE_LOOKUP_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.path = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runLookup(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id, PATH is within the filesystem if set")
+	cmd.Flags().String("fsname", "", "Filesystem name, PATH is within the filesystem if set")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runLookup(cmd *cobra.Command, dingocli *cli.DingoCli, options lookupOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	result := &InodeLookupResult{Chunks: []map[string]string{}}
+	var epoch uint64
+	if cmd.Flag(utils.DINGOFS_FSID).Changed || cmd.Flag(utils.DINGOFS_FSNAME).Changed {
+		// path within filesystem, resolved by mds
+		fsid, err := rpc.GetFsId(cmd)
+		if err != nil {
+			return err
+		}
+		if epoch, err = initRouter(cmd, fsid); err != nil {
+			return err
+		}
+		result.FsId = fsid
+		result.Path = path.Clean("/" + options.path)
+		result.Ino, result.Parent, _, err = rpc.ResolvePathInode(cmd, fsid, result.Path, epoch)
+		if err != nil {
+			return err
+		}
+	} else {
+		// path in local mountpoint, inode is got by stat
+		absPath, err := filepath.Abs(options.path)
+		if err != nil {
+			return err
+		}
+		mountpoint, err := findMountPoint(absPath)
+		if err != nil {
+			return err
+		}
+		fsInfo, err := rpc.GetFsInfo(cmd, 0, utils.MountPointFsName(mountpoint))
+		if err != nil {
+			return err
+		}
+		if epoch, err = initRouter(cmd, fsInfo.GetFsId()); err != nil {
+			return err
+		}
+		result.FsId = fsInfo.GetFsId()
+		result.Path = utils.Path2DingofsPath(absPath, mountpoint)
+		if result.Ino, err = utils.GetFileInode(absPath); err != nil {
+			return err
+		}
+		result.Parent = result.Ino
+		if absPath != mountpoint.MountPoint {
+			if result.Parent, err = utils.GetFileInode(filepath.Dir(absPath)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := fillInodeLayout(cmd, result, epoch); err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	parents := make([]string, 0, len(result.Parents))
+	for _, parent := range result.Parents {
+		parents = append(parents, fmt.Sprintf("%d", parent))
+	}
+	fmt.Printf("inode: %d\npath: %s\ntype: %s\nlength: %s\nnlink: %d\nmode: %04o\nowner: %d:%d\nparents: %s\n",
+		result.Ino, result.Path, result.Type, humanize.IBytes(result.Length), result.Nlink, result.Mode&0o7777,
+		result.Uid, result.Gid, strings.Join(parents, ","))
+	if len(result.Chunks) == 0 {
+		return nil
+	}
+
+	fmt.Printf("chunks (chunk size %s):\n", humanize.IBytes(result.ChunkSize))
+	header := []string{common.ROW_CHUNK_INDEX, common.ROW_SLICE_ID, common.ROW_SIZE, common.ROW_OFFSET, common.ROW_LENGTH}
+	table.SetHeader(header)
+	table.AppendBulk(table.ListMap2ListSortByKeys(result.Chunks, header, []string{}))
+	table.RenderWithNoData("no slices")
+
+	return nil
+}
+
+func initRouter(cmd *cobra.Command, fsId uint32) (uint64, error) {
+	epoch, err := rpc.GetFsEpochByFsId(cmd, fsId)
+	if err != nil {
+		return 0, err
+	}
+	return epoch, rpc.InitFsMDSRouter(cmd, fsId)
+}
+
+// the deepest dingofs mountpoint containing path
+func findMountPoint(absPath string) (*mountinfo.MountInfo, error) {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return nil, err
+	}
+	var found *mountinfo.MountInfo
+	for _, mountpoint := range mountpoints {
+		mp := mountpoint.MountPoint
+		if absPath != mp && !strings.HasPrefix(absPath, strings.TrimSuffix(mp, "/")+"/") {
+			continue
+		}
+		if found == nil || len(mp) > len(found.MountPoint) {
+			found = mountpoint
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%s is not in a dingofs mountpoint, specify --fsname to lookup path within filesystem", absPath)
+	}
+	return found, nil
+}
+
+// attributes of inode and slices of all chunks if it is a file
+func fillInodeLayout(cmd *cobra.Command, result *InodeLookupResult, epoch uint64) error {
+	inode, err := rpc.GetInode(cmd, result.FsId, result.Ino, result.Parent, epoch)
+	if err != nil {
+		return err
+	}
+	result.Type = inodeTypeName(inode.GetType())
+	result.Length = inode.GetLength()
+	result.Nlink = inode.GetNlink()
+	result.Mode = inode.GetMode()
+	result.Uid = inode.GetUid()
+	result.Gid = inode.GetGid()
+	result.Parents = inode.GetParents()
+	if inode.GetType() != mds.FileType_FILE || result.Length == 0 {
+		return nil
+	}
+
+	fsInfo, err := rpc.GetFsInfo(cmd, result.FsId, "")
+	if err != nil {
+		return err
+	}
+	result.ChunkSize = fsInfo.GetChunkSize()
+	if result.ChunkSize == 0 {
+		return fmt.Errorf("invalid chunk size")
+	}
+	chunkNum := uint32((result.Length + result.ChunkSize - 1) / result.ChunkSize)
+	chunks, err := rpc.ReadSliceAll(cmd, result.FsId, result.Ino, result.Parent, chunkNum, epoch)
+	if err != nil {
+		return err
+	}
+	result.Chunks = dirstats.BuildSliceRows(chunks)
+	return nil
+}
+
+func inodeTypeName(fileType mds.FileType) string {
+	switch fileType {
+	case mds.FileType_DIRECTORY:
+		return "directory"
+	case mds.FileType_SYM_LINK:
+		return "symlink"
+	default:
+		return "file"
+	}
+}
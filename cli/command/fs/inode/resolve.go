@@ -0,0 +1,199 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inode
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	INODE_RESOLVE_EXAMPLE = `Examples:
+   $ dingo fs inode resolve --fsname dingofs1 --inodeid 1025361`
+
+	INODE_FLAG_INODEID = "inodeid"
+)
+
+type resolveOptions struct {
+	fsid    uint32
+	inodeId uint64
+	format  string
+}
+
+type InodePath struct {
+	Parent uint64 `json:"parent"`
+	Path   string `json:"path"`
+}
+
+type InodeResolveResult struct {
+	FsId  uint32       `json:"fs_id"`
+	Ino   uint64       `json:"ino"`
+	Type  string       `json:"type"`
+	Nlink uint32       `json:"nlink"`
+	Paths []*InodePath `json:"paths"`
+}
+
+func NewInodeResolveCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options resolveOptions
+
+	cmd := &cobra.Command{
+		Use:     "resolve [OPTIONS]",
+		Short:   "Resolve inode to full paths",
+		Args:    utils.NoArgs,
+		Example: INODE_RESOLVE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.inodeId, err = cmd.Flags().GetUint64(INODE_FLAG_INODEID)
+			if err != nil {
+				return err
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runResolve(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().Uint64(INODE_FLAG_INODEID, 0, "Inode id, e.g. reported in server logs")
+	cmd.MarkFlagRequired(INODE_FLAG_INODEID)
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runResolve(cmd *cobra.Command, dingocli *cli.DingoCli, options resolveOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	// epoch + router
+	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if epochErr != nil {
+		return epochErr
+	}
+	if routerErr := rpc.InitFsMDSRouter(cmd, options.fsid); routerErr != nil {
+		return routerErr
+	}
+
+	result, err := resolveInodePaths(cmd, options.fsid, options.inodeId, epoch)
+	if err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_INODE_ID, common.ROW_TYPE, common.ROW_NLINK, common.ROW_PARENT_ID, common.ROW_PATH}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, inodePath := range result.Paths {
+		rows = append(rows, map[string]string{
+			common.ROW_INODE_ID:  fmt.Sprintf("%d", result.Ino),
+			common.ROW_TYPE:      result.Type,
+			common.ROW_NLINK:     fmt.Sprintf("%d", result.Nlink),
+			common.ROW_PARENT_ID: fmt.Sprintf("%d", inodePath.Parent),
+			common.ROW_PATH:      inodePath.Path,
+		})
+	}
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_PATH})
+	table.AppendBulk(list)
+	table.RenderWithNoData(fmt.Sprintf("inode %d is not linked by any directory", options.inodeId))
+
+	return nil
+}
+
+// a file has one path for every hard link, so all parents are resolved
+func resolveInodePaths(cmd *cobra.Command, fsId uint32, inodeId uint64, epoch uint64) (*InodeResolveResult, error) {
+	result := &InodeResolveResult{FsId: fsId, Ino: inodeId, Paths: []*InodePath{}}
+	if inodeId == common.ROOTINODEID {
+		result.Type, result.Nlink = inodeTypeName(mds.FileType_DIRECTORY), 1
+		result.Paths = append(result.Paths, &InodePath{Parent: common.ROOTINODEID, Path: "/"})
+		return result, nil
+	}
+
+	inode, err := rpc.GetInode(cmd, fsId, inodeId, 0, epoch)
+	if err != nil {
+		return nil, err
+	}
+	result.Type = inodeTypeName(inode.GetType())
+	result.Nlink = inode.GetNlink()
+
+	seen := map[uint64]bool{}
+	for _, parent := range inode.GetParents() {
+		if seen[parent] {
+			continue
+		}
+		seen[parent] = true
+
+		parentPath, _, err := rpc.GetInodePath(cmd, fsId, parent, epoch)
+		if err != nil {
+			return nil, err
+		}
+		if parentPath == "" { // parent may be deleted
+			continue
+		}
+		entries, err := rpc.ListDentry(cmd, fsId, parent, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.GetIno() == inodeId {
+				result.Paths = append(result.Paths, &InodePath{Parent: parent, Path: path.Join(parentPath, entry.GetName())})
+			}
+		}
+	}
+
+	return result, nil
+}
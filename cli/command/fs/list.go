@@ -34,11 +34,15 @@ import (
 
 const (
 	FS_LIST_EXAMPLE = `Examples:
-   $ dingo fs list`
+   $ dingo fs list
+
+   # list dingofs mountpoints on this host with client status
+   $ dingo fs list --local`
 )
 
 type listOptions struct {
 	format string
+	local  bool
 }
 
 func NewFsListCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -56,6 +60,9 @@ func NewFsListCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
 
+			if options.local {
+				return runListLocal(options)
+			}
 			return runList(cmd, dingocli, options)
 		},
 		SilenceUsage:          false,
@@ -65,9 +72,12 @@ func NewFsListCommand(dingocli *cli.DingoCli) *cobra.Command {
 	utils.SetFlagErrorFunc(cmd)
 
 	// add flags
+	cmd.Flags().BoolVar(&options.local, "local", false, "List dingofs mountpoints on this host instead of fs in cluster")
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddConfigFileFlag(cmd)
 	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+	utils.EnablePager(cmd)
 
 	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
 	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
@@ -152,3 +162,56 @@ func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) er
 
 	return nil
 }
+
+// list mountpoints on this host, no mds is required
+func runListLocal(options listOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	clients := []*utils.MountPointClient{}
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		outputResult.Error = errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	} else {
+		for _, mountpoint := range mountpoints {
+			clients = append(clients, utils.GetMountPointClient(mountpoint))
+		}
+		outputResult.Result = clients
+	}
+
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_MOUNTPOINT, common.ROW_FS_NAME, common.ROW_FS_ID, common.ROW_VERSION, common.ROW_PID, common.ROW_UPTIME, common.ROW_HEALTH}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, client := range clients {
+		row := make(map[string]string)
+		row[common.ROW_MOUNTPOINT] = client.MountPoint
+		row[common.ROW_FS_NAME] = client.FsName
+		row[common.ROW_FS_ID] = utils.Ternary(client.FsId != "", client.FsId, common.ROW_VALUE_NO_VALUE)
+		row[common.ROW_VERSION] = utils.Ternary(client.Version != "", client.Version, common.ROW_VALUE_UNKNOWN)
+		row[common.ROW_PID] = common.ROW_VALUE_NO_VALUE
+		row[common.ROW_UPTIME] = common.ROW_VALUE_NO_VALUE
+		if client.Pid > 0 {
+			row[common.ROW_PID] = fmt.Sprintf("%d", client.Pid)
+		}
+		if !client.StartTime.IsZero() {
+			row[common.ROW_UPTIME] = client.Uptime().String()
+		}
+		row[common.ROW_HEALTH] = client.Health
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_MOUNTPOINT})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no dingofs mountpoint on this host")
+
+	return nil
+}
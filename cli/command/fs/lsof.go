@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_LSOF_EXAMPLE = `Examples:
+   $ dingo fs lsof --fsname dingofs1
+
+   $ dingo fs lsof /mnt/dingofs --format json`
+)
+
+type lsofOptions struct {
+	mountpoint string
+	fsname     string
+	format     string
+}
+
+func NewFsLsofCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options lsofOptions
+
+	cmd := &cobra.Command{
+		Use:               "lsof [MOUNTPOINT] [OPTIONS]",
+		Short:             "List files opened by processes on local mountpoints",
+		Args:              utils.RequiresMaxArgs(1),
+		Example:           FS_LSOF_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				options.mountpoint = filepath.Clean(args[0])
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runLsof(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().StringVar(&options.fsname, "fsname", "", "Only list files of the filesystem")
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+// open files are found in /proc of this host, so they are what blocks umount of a local mountpoint
+func runLsof(cmd *cobra.Command, dingocli *cli.DingoCli, options lsofOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	}
+	selected := []*mountinfo.MountInfo{}
+	for _, mountpoint := range mountpoints {
+		if options.mountpoint != "" && mountpoint.MountPoint != options.mountpoint {
+			continue
+		}
+		if options.fsname != "" && utils.MountPointFsName(mountpoint) != options.fsname {
+			continue
+		}
+		selected = append(selected, mountpoint)
+	}
+	if options.mountpoint != "" && len(selected) == 0 {
+		return fmt.Errorf("%s is not a dingofs mountpoint", options.mountpoint)
+	}
+
+	files, err := utils.ListOpenFiles(selected)
+	if err != nil {
+		outputResult.Error = errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].MountPoint != files[j].MountPoint {
+			return files[i].MountPoint < files[j].MountPoint
+		}
+		if files[i].Pid != files[j].Pid {
+			return files[i].Pid < files[j].Pid
+		}
+		return files[i].Path < files[j].Path
+	})
+	outputResult.Result = files
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_MOUNTPOINT, common.ROW_CLIENT_PID, common.ROW_PID, common.ROW_COMMAND,
+		common.ROW_FD, common.ROW_MODE, common.ROW_PATH, common.ROW_AGE}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, file := range files {
+		clientPid := common.ROW_VALUE_UNKNOWN
+		if file.ClientPid > 0 {
+			clientPid = fmt.Sprintf("%d", file.ClientPid)
+		}
+		rows = append(rows, []string{
+			file.MountPoint,
+			clientPid,
+			fmt.Sprintf("%d", file.Pid),
+			file.Command,
+			file.Fd,
+			utils.Ternary(file.Mode != "", file.Mode, common.ROW_VALUE_NO_VALUE),
+			file.Path,
+			file.Age().String(),
+		})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no open file")
+
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package meta
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+// snapshot is json lines, a header followed by entries of all inodes,
+// a directory is always before its children, gzipped if the file name ends with .gz
+const (
+	META_SNAPSHOT_VERSION = 1
+	META_STDIO            = "-"
+
+	META_TYPE_DIRECTORY = "directory"
+	META_TYPE_FILE      = "file"
+	META_TYPE_SYMLINK   = "symlink"
+)
+
+type MetaHeader struct {
+	Version   uint32 `json:"version"`
+	FsName    string `json:"fs_name"`
+	FsId      uint32 `json:"fs_id"`
+	ChunkSize uint64 `json:"chunk_size"`
+	BlockSize uint64 `json:"block_size"`
+	Storage   string `json:"storage,omitempty"`
+	DumpedAt  string `json:"dumped_at"`
+}
+
+// times are in nanoseconds, link is the path of the first entry of a hard linked inode
+type MetaEntry struct {
+	Path    string       `json:"path"`
+	Ino     uint64       `json:"ino"`
+	Type    string       `json:"type"`
+	Mode    uint32       `json:"mode"`
+	Uid     uint32       `json:"uid"`
+	Gid     uint32       `json:"gid"`
+	Length  uint64       `json:"length"`
+	Atime   uint64       `json:"atime"`
+	Mtime   uint64       `json:"mtime"`
+	Ctime   uint64       `json:"ctime"`
+	Nlink   uint32       `json:"nlink"`
+	Symlink string       `json:"symlink,omitempty"`
+	Link    string       `json:"link,omitempty"`
+	Chunks  []*MetaChunk `json:"chunks,omitempty"`
+}
+
+type MetaChunk struct {
+	Index  uint32       `json:"index"`
+	Slices []*MetaSlice `json:"slices"`
+}
+
+type MetaSlice struct {
+	Id   uint64 `json:"id"`
+	Pos  uint32 `json:"pos"`
+	Size uint32 `json:"size"`
+	Len  uint32 `json:"len"`
+}
+
+func NewMetaCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "meta",
+		Short: "Dump and load metadata snapshot of filesystem",
+		Args:  utils.NoArgs,
+	}
+
+	cmd.AddCommand(
+		NewMetaDumpCommand(dingocli),
+		NewMetaLoadCommand(dingocli),
+	)
+
+	return cmd
+}
+
+func metaTypeName(fileType mds.FileType) string {
+	switch fileType {
+	case mds.FileType_DIRECTORY:
+		return META_TYPE_DIRECTORY
+	case mds.FileType_SYM_LINK:
+		return META_TYPE_SYMLINK
+	default:
+		return META_TYPE_FILE
+	}
+}
+
+func toMetaChunks(chunks []*mds.Chunk) []*MetaChunk {
+	metaChunks := []*MetaChunk{}
+	for _, chunk := range chunks {
+		if len(chunk.GetSlices()) == 0 {
+			continue
+		}
+		metaChunk := &MetaChunk{Index: chunk.GetIndex()}
+		for _, slice := range chunk.GetSlices() {
+			metaChunk.Slices = append(metaChunk.Slices, &MetaSlice{
+				Id:   slice.GetId(),
+				Pos:  slice.GetPos(),
+				Size: slice.GetSize(),
+				Len:  slice.GetLen(),
+			})
+		}
+		metaChunks = append(metaChunks, metaChunk)
+	}
+	return metaChunks
+}
+
+func toMdsChunks(metaChunks []*MetaChunk) []*mds.Chunk {
+	chunks := make([]*mds.Chunk, 0, len(metaChunks))
+	for _, metaChunk := range metaChunks {
+		chunk := &mds.Chunk{Index: metaChunk.Index}
+		for _, slice := range metaChunk.Slices {
+			chunk.Slices = append(chunk.Slices, &mds.Slice{Id: slice.Id, Pos: slice.Pos, Size: slice.Size, Len: slice.Len})
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+type snapshotWriter struct {
+	file    *os.File
+	gzip    *gzip.Writer
+	buffer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func newSnapshotWriter(name string) (*snapshotWriter, error) {
+	writer := &snapshotWriter{file: os.Stdout}
+	if name != META_STDIO {
+		file, err := os.Create(name)
+		if err != nil {
+			return nil, err
+		}
+		writer.file = file
+	}
+	var out io.Writer = writer.file
+	if strings.HasSuffix(name, ".gz") {
+		writer.gzip = gzip.NewWriter(out)
+		out = writer.gzip
+	}
+	writer.buffer = bufio.NewWriter(out)
+	writer.encoder = json.NewEncoder(writer.buffer)
+	return writer, nil
+}
+
+func (writer *snapshotWriter) Write(item interface{}) error {
+	return writer.encoder.Encode(item)
+}
+
+func (writer *snapshotWriter) Close() error {
+	err := writer.buffer.Flush()
+	if writer.gzip != nil {
+		if gzErr := writer.gzip.Close(); err == nil {
+			err = gzErr
+		}
+	}
+	if writer.file != os.Stdout {
+		if closeErr := writer.file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+type snapshotReader struct {
+	file    *os.File
+	gzip    *gzip.Reader
+	decoder *json.Decoder
+}
+
+// header is read when opened
+func newSnapshotReader(name string) (*snapshotReader, *MetaHeader, error) {
+	reader := &snapshotReader{file: os.Stdin}
+	if name != META_STDIO {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		reader.file = file
+	}
+	var in io.Reader = bufio.NewReader(reader.file)
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(in)
+		if err != nil {
+			reader.Close()
+			return nil, nil, fmt.Errorf("read %s failed: %v", name, err)
+		}
+		reader.gzip = gz
+		in = gz
+	}
+	reader.decoder = json.NewDecoder(in)
+
+	header := &MetaHeader{}
+	if err := reader.decoder.Decode(header); err != nil {
+		reader.Close()
+		return nil, nil, fmt.Errorf("read header of %s failed: %v", name, err)
+	}
+	if header.Version != META_SNAPSHOT_VERSION {
+		reader.Close()
+		return nil, nil, fmt.Errorf("unsupported snapshot version %d of %s", header.Version, name)
+	}
+	return reader, header, nil
+}
+
+// nil at the end of snapshot
+func (reader *snapshotReader) Next() (*MetaEntry, error) {
+	entry := &MetaEntry{}
+	if err := reader.decoder.Decode(entry); err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (reader *snapshotReader) Close() {
+	if reader.gzip != nil {
+		reader.gzip.Close()
+	}
+	if reader.file != os.Stdin {
+		reader.file.Close()
+	}
+}
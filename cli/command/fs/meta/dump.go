@@ -0,0 +1,247 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package meta
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	META_DUMP_EXAMPLE = `Examples:
+   $ dingo fs meta dump --fsname dingofs --output meta.json.gz
+
+   # inspect snapshot with jq
+   $ dingo fs meta dump --fsname dingofs | jq -c 'select(.type == "file" and .length > 1073741824) | .path'`
+)
+
+type dumpOptions struct {
+	fsid   uint32
+	output string
+}
+
+type dumpSummary struct {
+	dirs     uint64
+	files    uint64
+	symlinks uint64
+	links    uint64
+}
+
+type metaDumper struct {
+	cmd       *cobra.Command
+	fsId      uint32
+	epoch     uint64
+	chunkSize uint64
+	writer    *snapshotWriter
+	linked    map[uint64]string // first path of inodes with more than one link
+	summary   dumpSummary
+}
+
+func NewMetaDumpCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options dumpOptions
+
+	cmd := &cobra.Command{
+		Use:     "dump [OPTIONS]",
+		Short:   "Dump directory tree, attributes and chunk references of filesystem",
+		Args:    utils.NoArgs,
+		Example: META_DUMP_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.output, _ = cmd.Flags().GetString("output")
+
+			return runDump(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().StringP("output", "o", META_STDIO, "Snapshot file, gzipped if ends with .gz, - is stdout")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// the filesystem is not frozen, files changed during dump may be inconsistent
+func runDump(cmd *cobra.Command, dingocli *cli.DingoCli, options dumpOptions) error {
+	fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+	if err != nil {
+		return err
+	}
+	if fsInfo.GetChunkSize() == 0 {
+		return fmt.Errorf("invalid chunk size")
+	}
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+
+	header := &MetaHeader{
+		Version:   META_SNAPSHOT_VERSION,
+		FsName:    fsInfo.GetFsName(),
+		FsId:      fsInfo.GetFsId(),
+		ChunkSize: fsInfo.GetChunkSize(),
+		BlockSize: fsInfo.GetBlockSize(),
+		DumpedAt:  time.Now().Format(time.RFC3339),
+	}
+	if store, err := utils.NewObjectStore(fsInfo.GetExtra()); err == nil {
+		header.Storage = store.String()
+	}
+
+	writer, err := newSnapshotWriter(options.output)
+	if err != nil {
+		return err
+	}
+	dumper := &metaDumper{
+		cmd:       cmd,
+		fsId:      options.fsid,
+		epoch:     epoch,
+		chunkSize: fsInfo.GetChunkSize(),
+		writer:    writer,
+		linked:    map[uint64]string{},
+	}
+	err = writer.Write(header)
+	if err == nil {
+		err = dumper.dumpTree()
+	}
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	summary := dumper.summary
+	fmt.Fprintf(os.Stderr, "Dumped %d directories, %d files, %d symlinks and %d hard links of %s\n",
+		summary.dirs, summary.files, summary.symlinks, summary.links, header.FsName)
+	return nil
+}
+
+func (dumper *metaDumper) dumpTree() error {
+	root, err := rpc.GetInode(dumper.cmd, dumper.fsId, common.ROOTINODEID, 0, dumper.epoch)
+	if err != nil {
+		return err
+	}
+	if err := dumper.dumpInode("/", root, 0); err != nil {
+		return err
+	}
+	return dumper.dumpDir("/", common.ROOTINODEID)
+}
+
+// entries are dumped in order of name, so snapshots of the same tree are comparable
+func (dumper *metaDumper) dumpDir(dirPath string, dirIno uint64) error {
+	entries, err := rpc.ListDentry(dumper.cmd, dumper.fsId, dirIno, dumper.epoch)
+	if err != nil {
+		return fmt.Errorf("list %s failed: %v", dirPath, err)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].GetName() < entries[j].GetName()
+	})
+	for _, entry := range entries {
+		entryPath := path.Join(dirPath, entry.GetName())
+		inode, err := rpc.GetInode(dumper.cmd, dumper.fsId, entry.GetIno(), dirIno, dumper.epoch)
+		if err != nil {
+			return fmt.Errorf("get inode of %s failed: %v", entryPath, err)
+		}
+		if err := dumper.dumpInode(entryPath, inode, dirIno); err != nil {
+			return err
+		}
+		if entry.GetType() == mds.FileType_DIRECTORY {
+			if err := dumper.dumpDir(entryPath, entry.GetIno()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (dumper *metaDumper) dumpInode(entryPath string, inode *mds.Inode, parent uint64) error {
+	entry := &MetaEntry{
+		Path:    entryPath,
+		Ino:     inode.GetIno(),
+		Type:    metaTypeName(inode.GetType()),
+		Mode:    inode.GetMode(),
+		Uid:     inode.GetUid(),
+		Gid:     inode.GetGid(),
+		Length:  inode.GetLength(),
+		Atime:   inode.GetAtime(),
+		Mtime:   inode.GetMtime(),
+		Ctime:   inode.GetCtime(),
+		Nlink:   inode.GetNlink(),
+		Symlink: inode.GetSymlink(),
+	}
+
+	switch entry.Type {
+	case META_TYPE_DIRECTORY:
+		dumper.summary.dirs++
+	case META_TYPE_SYMLINK:
+		dumper.summary.symlinks++
+	default:
+		if first, ok := dumper.linked[entry.Ino]; ok {
+			// chunks are only in the first entry of the inode
+			entry.Link = first
+			dumper.summary.links++
+			return dumper.writer.Write(entry)
+		}
+		if entry.Nlink > 1 {
+			dumper.linked[entry.Ino] = entryPath
+		}
+		dumper.summary.files++
+		if entry.Length > 0 {
+			chunkNum := uint32((entry.Length + dumper.chunkSize - 1) / dumper.chunkSize)
+			chunks, err := rpc.ReadSliceAll(dumper.cmd, dumper.fsId, entry.Ino, parent, chunkNum, dumper.epoch)
+			if err != nil {
+				return fmt.Errorf("read slices of %s failed: %v", entryPath, err)
+			}
+			entry.Chunks = toMetaChunks(chunks)
+		}
+	}
+	return dumper.writer.Write(entry)
+}
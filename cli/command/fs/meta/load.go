@@ -0,0 +1,317 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package meta
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	META_LOAD_EXAMPLE = `Examples:
+   # recreate directory tree and attributes in an empty filesystem
+   $ dingo fs meta load meta.json.gz --fsname dingofs2
+
+   # load into an existing directory
+   $ dingo fs meta load meta.json.gz --fsname dingofs2 --path /restore
+
+   # files also refer to the objects in storage of the dumped filesystem
+   $ dingo fs meta load meta.json.gz --fsname dingofs2 --with-chunks`
+)
+
+type loadOptions struct {
+	fsid       uint32
+	file       string
+	path       string
+	withChunks bool
+	format     string
+}
+
+type LoadFailure struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+type LoadResult struct {
+	Dirs     uint64         `json:"dirs"`
+	Files    uint64         `json:"files"`
+	Symlinks uint64         `json:"symlinks"`
+	Links    uint64         `json:"links"`
+	Failures []*LoadFailure `json:"failures"`
+}
+
+type metaLoader struct {
+	cmd        *cobra.Command
+	fsId       uint32
+	epoch      uint64
+	root       string
+	withChunks bool
+	dirs       map[string]uint64 // inode of loaded directories by path in snapshot
+	files      map[string]uint64 // inode of loaded files which have more than one link
+	dirTimes   map[uint64]*MetaEntry
+	result     *LoadResult
+}
+
+func NewMetaLoadCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options loadOptions
+
+	cmd := &cobra.Command{
+		Use:     "load FILE [OPTIONS]",
+		Short:   "Load metadata snapshot dumped by meta dump into filesystem",
+		Args:    utils.ExactArgs(1),
+		Example: META_LOAD_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.file = args[0]
+			options.path, _ = cmd.Flags().GetString("path")
+			options.withChunks, _ = cmd.Flags().GetBool("with-chunks")
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runLoad(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+	// inodes and slices are written by mknod, symlink, link and write slice of mds api v4
+	rpc.RequireMDSVersion(cmd, "v4.0.0")
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().String("path", "/", "Existing directory to load the snapshot into")
+	cmd.Flags().Bool("with-chunks", false, "Load chunk references of files, objects are not copied")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// without chunks files are loaded empty, entries under a failed directory are failed too
+func runLoad(cmd *cobra.Command, dingocli *cli.DingoCli, options loadOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	reader, header, err := newSnapshotReader(options.file)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	if options.withChunks {
+		fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+		if err != nil {
+			return err
+		}
+		if fsInfo.GetChunkSize() != header.ChunkSize || fsInfo.GetBlockSize() != header.BlockSize {
+			return fmt.Errorf("chunk size %d or block size %d of snapshot differs from %d and %d of %s",
+				header.ChunkSize, header.BlockSize, fsInfo.GetChunkSize(), fsInfo.GetBlockSize(), fsInfo.GetFsName())
+		}
+		if store, err := utils.NewObjectStore(fsInfo.GetExtra()); err == nil && store.String() != header.Storage {
+			fmt.Fprintf(os.Stderr, "Warning: storage %s of snapshot differs from %s, objects should be copied before reading files\n",
+				header.Storage, store.String())
+		}
+	}
+
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+	rootIno, err := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if err != nil {
+		return err
+	}
+
+	loader := &metaLoader{
+		cmd:        cmd,
+		fsId:       options.fsid,
+		epoch:      epoch,
+		root:       options.path,
+		withChunks: options.withChunks,
+		dirs:       map[string]uint64{"/": rootIno},
+		files:      map[string]uint64{},
+		dirTimes:   map[uint64]*MetaEntry{},
+		result:     &LoadResult{Failures: []*LoadFailure{}},
+	}
+	action := cli.NewAction(cli.ACTION_RPC, "LoadMeta(file=%s, from=%s, fsid=%d, path=%s, with-chunks=%t)",
+		options.file, header.FsName, options.fsid, options.path, options.withChunks)
+	if err := dingocli.Perform(action, func() error {
+		return loader.load(reader)
+	}); err != nil {
+		return err
+	}
+	if dingocli.IsDryRun() {
+		return nil
+	}
+	result := loader.result
+	if len(result.Failures) > 0 {
+		outputResult.Error = errno.ERR_LOAD_META_FAILED
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	if len(result.Failures) > 0 {
+		header := []string{common.ROW_PATH, common.ROW_REASON}
+		table.SetHeader(header)
+		rows := make([][]string, 0)
+		for _, failure := range result.Failures {
+			rows = append(rows, []string{failure.Path, failure.Error})
+		}
+		table.AppendBulk(rows)
+		table.RenderWithNoData("no failures")
+	}
+	fmt.Printf("Loaded %d directories, %d files, %d symlinks and %d hard links into %s\n",
+		result.Dirs, result.Files, result.Symlinks, result.Links, options.path)
+
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+func (loader *metaLoader) load(reader *snapshotReader) error {
+	for {
+		entry, err := reader.Next()
+		if err != nil {
+			return fmt.Errorf("read snapshot failed: %v", err)
+		}
+		if entry == nil {
+			break
+		}
+		if err := loader.loadEntry(entry); err != nil {
+			loader.result.Failures = append(loader.result.Failures, &LoadFailure{
+				Path:  path.Join(loader.root, entry.Path),
+				Error: err.Error(),
+			})
+		}
+	}
+
+	// times of directories are changed by creating children, so they are set at last
+	for ino, entry := range loader.dirTimes {
+		_, err := rpc.SetInodeAttr(loader.cmd, loader.fsId, ino, 0, rpc.SET_ATTR_ATIME|rpc.SET_ATTR_MTIME, &mds.Inode{
+			Atime: entry.Atime,
+			Mtime: entry.Mtime,
+		}, loader.epoch)
+		if err != nil {
+			loader.result.Failures = append(loader.result.Failures, &LoadFailure{
+				Path:  path.Join(loader.root, entry.Path),
+				Error: err.Error(),
+			})
+		}
+	}
+	return nil
+}
+
+func (loader *metaLoader) loadEntry(entry *MetaEntry) error {
+	if entry.Path == "/" {
+		// attributes of the directory loaded into
+		ino := loader.dirs["/"]
+		_, err := rpc.SetAttr(loader.cmd, loader.fsId, ino, 0, rpc.SET_ATTR_MODE|rpc.SET_ATTR_UID|rpc.SET_ATTR_GID,
+			entry.Mode, entry.Uid, entry.Gid, loader.epoch)
+		loader.dirTimes[ino] = entry
+		return err
+	}
+
+	parent, ok := loader.dirs[path.Dir(entry.Path)]
+	if !ok {
+		return fmt.Errorf("parent directory is not loaded")
+	}
+	name := path.Base(entry.Path)
+
+	switch {
+	case entry.Type == META_TYPE_DIRECTORY:
+		inode, err := rpc.MkDir(loader.cmd, loader.fsId, parent, name, entry.Mode, entry.Uid, entry.Gid, loader.epoch)
+		if err != nil {
+			return err
+		}
+		loader.dirs[entry.Path] = inode.GetIno()
+		loader.dirTimes[inode.GetIno()] = entry
+		loader.result.Dirs++
+	case entry.Type == META_TYPE_SYMLINK:
+		if _, err := rpc.Symlink(loader.cmd, loader.fsId, parent, name, entry.Symlink, entry.Uid, entry.Gid, loader.epoch); err != nil {
+			return err
+		}
+		loader.result.Symlinks++
+	case entry.Link != "":
+		ino, ok := loader.files[entry.Link]
+		if !ok {
+			return fmt.Errorf("linked file %s is not loaded", entry.Link)
+		}
+		if _, err := rpc.Link(loader.cmd, loader.fsId, ino, parent, name, loader.epoch); err != nil {
+			return err
+		}
+		loader.result.Links++
+	default:
+		inode, err := rpc.MkNod(loader.cmd, loader.fsId, parent, name, entry.Mode, entry.Uid, entry.Gid, loader.epoch)
+		if err != nil {
+			return err
+		}
+		ino := inode.GetIno()
+		if entry.Nlink > 1 {
+			loader.files[entry.Path] = ino
+		}
+		toSet, attr := rpc.SET_ATTR_ATIME|rpc.SET_ATTR_MTIME, &mds.Inode{Atime: entry.Atime, Mtime: entry.Mtime}
+		if loader.withChunks && len(entry.Chunks) > 0 {
+			if err := rpc.WriteSlice(loader.cmd, loader.fsId, ino, parent, toMdsChunks(entry.Chunks), loader.epoch); err != nil {
+				return err
+			}
+		}
+		if loader.withChunks {
+			toSet, attr.Length = toSet|rpc.SET_ATTR_SIZE, entry.Length
+		}
+		if _, err := rpc.SetInodeAttr(loader.cmd, loader.fsId, ino, parent, toSet, attr, loader.epoch); err != nil {
+			return err
+		}
+		loader.result.Files++
+	}
+	return nil
+}
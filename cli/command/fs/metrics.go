@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_METRICS_EXAMPLE = `Examples:
+   $ dingo fs metrics --target mds --filter 'latency'
+
+   # rates of counters over 5 seconds on clients of a filesystem
+   $ dingo fs metrics --target client --fsname dingofs1 --filter '^dingofs_fuse_op_.*_count$' --interval 5s
+
+   # members of a cache group, or endpoints given explicitly
+   $ dingo fs metrics --target cachegroup --group group1
+   $ dingo fs metrics --addr 10.0.0.1:7400,10.0.0.2:7400 --format json`
+
+	METRICS_TARGET_MDS        = "mds"
+	METRICS_TARGET_CLIENT     = "client"
+	METRICS_TARGET_CACHEGROUP = "cachegroup"
+
+	METRICS_SCRAPE_TIMEOUT = 5 * time.Second
+)
+
+type metricsOptions struct {
+	target   string
+	addrs    []string
+	fsname   string
+	group    string
+	path     string
+	filter   *regexp.Regexp
+	interval time.Duration
+	format   string
+}
+
+// rate is per second over --interval, only for counters
+type MetricValue struct {
+	Target string            `json:"target"`
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Type   string            `json:"type"`
+	Value  float64           `json:"value"`
+	Rate   *float64          `json:"rate,omitempty"`
+}
+
+type MetricsResult struct {
+	Metrics []*MetricValue    `json:"metrics"`
+	Errors  map[string]string `json:"errors,omitempty"`
+}
+
+func NewFsMetricsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options metricsOptions
+
+	cmd := &cobra.Command{
+		Use:     "metrics [OPTIONS]",
+		Short:   "Show prometheus metrics of mds, clients or cache group members",
+		Args:    utils.NoArgs,
+		Example: FS_METRICS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.target, _ = cmd.Flags().GetString("target")
+			switch options.target {
+			case METRICS_TARGET_MDS, METRICS_TARGET_CLIENT, METRICS_TARGET_CACHEGROUP:
+			default:
+				return fmt.Errorf("invalid target %s, should be: %s, %s, %s",
+					options.target, METRICS_TARGET_MDS, METRICS_TARGET_CLIENT, METRICS_TARGET_CACHEGROUP)
+			}
+			options.addrs, _ = cmd.Flags().GetStringSlice("addr")
+			options.fsname, _ = cmd.Flags().GetString("fsname")
+			options.group, _ = cmd.Flags().GetString("group")
+			options.path, _ = cmd.Flags().GetString("path")
+			filter, _ := cmd.Flags().GetString("filter")
+			regex, err := regexp.Compile(filter)
+			if err != nil {
+				return fmt.Errorf("invalid --filter %s: %v", filter, err)
+			}
+			options.filter = regex
+			options.interval, _ = cmd.Flags().GetDuration("interval")
+			if options.interval < 0 {
+				return fmt.Errorf("invalid interval %s", options.interval)
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runMetrics(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().String("target", METRICS_TARGET_MDS, "Services to scrape, should be: mds, client, cachegroup")
+	cmd.Flags().StringSlice("addr", nil, "Scrape these endpoints (host:port) instead of services of target")
+	cmd.Flags().String("fsname", "", "Only clients of the filesystem, for client target")
+	cmd.Flags().String("group", "", "Only members of the cache group, for cachegroup target")
+	cmd.Flags().String("filter", "", "Regular expression of metric names to show")
+	cmd.Flags().Duration("interval", 0, "Scrape twice in the interval to show rates of counters")
+	cmd.Flags().String("path", utils.METRICS_PATH, "Http path of metrics")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// metrics are served by brpc servers on the same port as rpc, addresses are found from mds
+func metricsTargets(cmd *cobra.Command, options metricsOptions) ([]string, error) {
+	if len(options.addrs) > 0 {
+		return options.addrs, nil
+	}
+
+	addrs := []string{}
+	switch options.target {
+	case METRICS_TARGET_MDS:
+		mdses, err := rpc.GetMDSList(cmd)
+		if err != nil {
+			return nil, err
+		}
+		for _, mdsInfo := range mdses {
+			addrs = append(addrs, fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort()))
+		}
+	case METRICS_TARGET_CLIENT:
+		fsInfos := []*mds.FsInfo{}
+		if options.fsname != "" {
+			fsInfo, err := rpc.GetFsInfo(cmd, 0, options.fsname)
+			if err != nil {
+				return nil, err
+			}
+			fsInfos = append(fsInfos, fsInfo)
+		} else {
+			infos, err := rpc.ListFsInfo(cmd)
+			if err != nil {
+				return nil, err
+			}
+			fsInfos = infos
+		}
+		for _, fsInfo := range fsInfos {
+			for _, mountPoint := range fsInfo.GetMountPoints() {
+				addrs = append(addrs, fmt.Sprintf("%s:%d", mountPoint.GetIp(), mountPoint.GetPort()))
+			}
+		}
+	case METRICS_TARGET_CACHEGROUP:
+		members, err := rpc.ListCacheMembers(cmd)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if options.group != "" && member.GetGroupName() != options.group {
+				continue
+			}
+			addrs = append(addrs, fmt.Sprintf("%s:%d", member.GetIp(), member.GetPort()))
+		}
+	}
+	return utils.RemoveDuplicates(addrs), nil
+}
+
+// samples of all targets by target address, targets failed are in errors
+func scrapeTargets(cmd *cobra.Command, addrs []string, options metricsOptions, errors map[string]string) map[string][]*utils.MetricSample {
+	results, errs := rpc.Batch(cmd, addrs, func(addr string) ([]*utils.MetricSample, error) {
+		return utils.ScrapeMetrics(addr, options.path, METRICS_SCRAPE_TIMEOUT)
+	})
+	samples := map[string][]*utils.MetricSample{}
+	for i, addr := range addrs {
+		if errs[i] != nil {
+			errors[addr] = errs[i].Error()
+			continue
+		}
+		for _, sample := range results[i] {
+			if options.filter.MatchString(sample.Name) {
+				samples[addr] = append(samples[addr], sample)
+			}
+		}
+	}
+	return samples
+}
+
+func runMetrics(cmd *cobra.Command, dingocli *cli.DingoCli, options metricsOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	addrs, err := metricsTargets(cmd, options)
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("no %s found to scrape", options.target)
+	}
+
+	result := &MetricsResult{Metrics: []*MetricValue{}, Errors: map[string]string{}}
+	var first map[string][]*utils.MetricSample
+	if options.interval > 0 {
+		first = scrapeTargets(cmd, addrs, options, map[string]string{})
+		time.Sleep(options.interval)
+	}
+	samples := scrapeTargets(cmd, addrs, options, result.Errors)
+
+	for _, addr := range addrs {
+		previous := map[string]float64{}
+		for _, sample := range first[addr] {
+			previous[sample.Key()] = sample.Value
+		}
+		for _, sample := range samples[addr] {
+			value := &MetricValue{Target: addr, Name: sample.Name, Labels: sample.Labels, Type: sample.Type, Value: sample.Value}
+			if last, ok := previous[sample.Key()]; ok && sample.Cumulative() {
+				rate := (sample.Value - last) / options.interval.Seconds()
+				value.Rate = &rate
+			}
+			result.Metrics = append(result.Metrics, value)
+		}
+	}
+	sort.SliceStable(result.Metrics, func(i, j int) bool {
+		return result.Metrics[i].Name < result.Metrics[j].Name
+	})
+	if len(result.Errors) > 0 {
+		failed := []string{}
+		for addr, reason := range result.Errors {
+			failed = append(failed, fmt.Sprintf("%s: %s", addr, reason))
+		}
+		sort.Strings(failed)
+		outputResult.Error = errno.ERR_SCRAPE_METRICS_FAILED.S(strings.Join(failed, "; "))
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_NAME, common.ROW_ADDR, common.ROW_LABELS, common.ROW_TYPE, common.ROW_VALUE}
+	if options.interval > 0 {
+		header = append(header, common.ROW_RATE)
+	}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, metric := range result.Metrics {
+		sample := &utils.MetricSample{Name: metric.Name, Labels: metric.Labels}
+		row := []string{
+			metric.Name,
+			metric.Target,
+			utils.Ternary(len(metric.Labels) > 0, sample.LabelString(), common.ROW_VALUE_NO_VALUE),
+			metric.Type,
+			fmt.Sprintf("%g", metric.Value),
+		}
+		if options.interval > 0 {
+			row = append(row, common.ROW_VALUE_NO_VALUE)
+			if metric.Rate != nil {
+				row[len(row)-1] = fmt.Sprintf("%.2f/s", *metric.Rate)
+			}
+		}
+		rows = append(rows, row)
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no metric matched")
+
+	for _, addr := range addrs {
+		if reason, ok := result.Errors[addr]; ok {
+			fmt.Printf("scrape %s failed: %s\n", addr, reason)
+		}
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
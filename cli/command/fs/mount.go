@@ -26,6 +26,7 @@ import (
 
 	"github.com/dingodb/dingocli/cli/cli"
 	compmgr "github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/output"
 	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -34,7 +35,9 @@ import (
 const (
 	FS_MOUNT_EXAMPLE = `Examples:
 	   $ dingo fs mount mds://10.220.69.6:7400/myfs /mnt/dingofs
-	   $ dingo fs mount local://myfs /mnt/dingofs`
+	   $ dingo fs mount local://myfs /mnt/dingofs
+	   $ dingo fs mount --generate systemd mds://10.220.69.6:7400/myfs /mnt/dingofs > /etc/systemd/system/dingofs-mnt-dingofs.service
+	   $ dingo fs mount --generate fstab mds://10.220.69.6:7400/myfs /mnt/dingofs >> /etc/fstab`
 )
 
 var (
@@ -59,6 +62,10 @@ func NewFsMountCommand(dingocli *cli.DingoCli) *cobra.Command {
 		DisableFlagParsing: true,
 		Example:            FS_MOUNT_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			args, generate, err := extractGenerateArg(args)
+			if err != nil {
+				return err
+			}
 			options.cmdArgs = args
 
 			componentManager, err := compmgr.NewComponentManager()
@@ -109,6 +116,10 @@ func NewFsMountCommand(dingocli *cli.DingoCli) *cobra.Command {
 				return fmt.Errorf("\"dingocli fs mount\" requires exactly 2 arguments\n\nUsage: dingocli fs mount METAURL MOUNTPOINT [OPTIONS]")
 			}
 
+			if generate != "" {
+				return runMountGenerate(options, generate)
+			}
+
 			fmt.Println(color.CyanString("use %s:%s(%s)", component.Name, component.Version, options.clientBinary))
 
 			return runMount(cmd, dingocli, options)
@@ -151,6 +162,8 @@ func runMount(cmd *cobra.Command, dingocli *cli.DingoCli, options mountOptions)
 	isReady := make(chan bool, 1)
 	isTimeout := make(chan bool, 1)
 
+	spinner := output.NewSpinner(fmt.Sprintf("Waiting for %s ready", options.mountpoint))
+
 	// mount completed
 	go func() {
 		filename := filepath.Join(options.mountpoint, ".stats")
@@ -160,6 +173,7 @@ func runMount(cmd *cobra.Command, dingocli *cli.DingoCli, options mountOptions)
 		mountTimeout := time.After(10 * time.Second)
 
 		for range ticker.C {
+			spinner.Add64(1)
 			if _, err := os.Stat(filename); err != nil {
 				select {
 				case <-mountTimeout:
@@ -178,10 +192,12 @@ func runMount(cmd *cobra.Command, dingocli *cli.DingoCli, options mountOptions)
 
 	select {
 	case <-isReady: // start success
+		spinner.Finish()
 		fmt.Printf("Successfully mounted at %s\n", options.mountpoint)
 		return nil
 
 	case _ = <-isTimeout: //mount failed
+		spinner.Finish()
 		return fmt.Errorf("Failed mount at %s\n", options.mountpoint)
 	}
 }
@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+// dingo fs mount --generate systemd|fstab METAURL MOUNTPOINT [OPTIONS]
+// prints a systemd service or fstab entry instead of mounting, so the mount survives reboots
+const (
+	MOUNT_FLAG_GENERATE = "--generate"
+
+	GENERATE_SYSTEMD = "systemd"
+	GENERATE_FSTAB   = "fstab"
+
+	SYSTEMD_UNIT_DIR = "/etc/systemd/system"
+)
+
+var (
+	systemdServiceTemplate = `# %[1]s/%[2]s
+# install: systemctl daemon-reload && systemctl enable --now '%[2]s'
+[Unit]
+Description=DingoFS %[3]s mounted at %[4]s
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+Type=simple
+ExecStart=%[5]s
+ExecStop=/bin/umount %[6]s
+Restart=on-failure
+RestartSec=5
+
+[Install]
+WantedBy=multi-user.target
+`
+)
+
+// remove --generate and its value from args passed to dingo-client
+func extractGenerateArg(args []string) ([]string, string, error) {
+	rest := []string{}
+	generate := ""
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == MOUNT_FLAG_GENERATE {
+			if i+1 >= len(args) {
+				return nil, "", fmt.Errorf("flag needs an argument: %s", MOUNT_FLAG_GENERATE)
+			}
+			generate = args[i+1]
+			i++
+			continue
+		} else if strings.HasPrefix(arg, MOUNT_FLAG_GENERATE+"=") {
+			generate = strings.TrimPrefix(arg, MOUNT_FLAG_GENERATE+"=")
+			continue
+		}
+		rest = append(rest, arg)
+	}
+
+	if generate != "" && generate != GENERATE_SYSTEMD && generate != GENERATE_FSTAB {
+		return nil, "", fmt.Errorf("invalid %s '%s', must be %s or %s", MOUNT_FLAG_GENERATE, generate, GENERATE_SYSTEMD, GENERATE_FSTAB)
+	}
+	return rest, generate, nil
+}
+
+func runMountGenerate(options mountOptions, generate string) error {
+	metaurl, rawMountpoint := extractPositionalArgs(options.cmdArgs)
+	mountpoint, err := filepath.Abs(rawMountpoint)
+	if err != nil {
+		return err
+	}
+	// relative mountpoint is not valid after reboot
+	args := []string{}
+	for _, arg := range options.cmdArgs {
+		args = append(args, utils.Ternary(arg == rawMountpoint, mountpoint, arg))
+	}
+	options.cmdArgs = args
+
+	if generate == GENERATE_FSTAB {
+		fmt.Print(generateFstab(options, metaurl, mountpoint))
+	} else {
+		fmt.Print(generateSystemdService(options, metaurl, mountpoint))
+	}
+	return nil
+}
+
+// dingo-client runs in foreground and is supervised by systemd
+func generateSystemdService(options mountOptions, metaurl, mountpoint string) string {
+	command := []string{quoteSystemdArg(options.clientBinary)}
+	for _, arg := range translateAllowOther(options.cmdArgs, options.allowOther) {
+		if arg == "--daemonize" || arg == "-d" {
+			continue
+		}
+		command = append(command, quoteSystemdArg(arg))
+	}
+
+	unit := fmt.Sprintf("dingofs-%s.service", escapeSystemdPath(mountpoint))
+	return fmt.Sprintf(systemdServiceTemplate, SYSTEMD_UNIT_DIR, unit, metaurl, mountpoint,
+		strings.Join(command, " "), quoteSystemdArg(mountpoint))
+}
+
+// mount.fuse runs "<client> <metaurl> <mountpoint> -o <options>" for the entry,
+// client options other than allow_other can not be expressed in fstab
+func generateFstab(options mountOptions, metaurl, mountpoint string) string {
+	mountOptions := []string{"_netdev", "nofail"}
+	if options.allowOther {
+		mountOptions = append(mountOptions, "allow_other")
+	}
+
+	ignored := []string{}
+	for _, arg := range options.cmdArgs {
+		if strings.HasPrefix(arg, "-") && arg != "--allow_other" && arg != "--daemonize" && arg != "-d" {
+			ignored = append(ignored, arg)
+		}
+	}
+	if len(ignored) > 0 {
+		fmt.Fprintf(os.Stderr, "options %s are ignored in fstab entry, use --generate systemd instead\n",
+			strings.Join(ignored, " "))
+	}
+
+	return fmt.Sprintf("# /etc/fstab\n%s#%s %s fuse %s 0 0\n", escapeFstabField(options.clientBinary),
+		escapeFstabField(metaurl), escapeFstabField(mountpoint), strings.Join(mountOptions, ","))
+}
+
+// same as systemd-escape --path, e.g. /mnt/dingo-fs -> mnt-dingo\x2dfs
+func escapeSystemdPath(path string) string {
+	path = strings.Trim(filepath.Clean(path), "/")
+	if path == "" {
+		return "-"
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c == '/':
+			b.WriteByte('-')
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9',
+			c == ':', c == '_', c == '.' && i > 0:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "\\x%02x", c)
+		}
+	}
+	return b.String()
+}
+
+func quoteSystemdArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\"'\\") {
+		return arg
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(arg) + `"`
+}
+
+// spaces in fstab fields are written as \040
+func escapeFstabField(field string) string {
+	return strings.NewReplacer(" ", `\040`, "\t", `\011`).Replace(field)
+}
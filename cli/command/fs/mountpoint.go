@@ -66,6 +66,8 @@ func NewFsMountpointCommand(dingocli *cli.DingoCli) *cobra.Command {
 	// add flags
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+	utils.EnablePager(cmd)
 	utils.AddConfigFileFlag(cmd)
 
 	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
@@ -0,0 +1,286 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_PROFILE_EXAMPLE = `Examples:
+   $ dingo fs profile /mnt/dingofs
+
+   $ dingo fs profile /mnt/dingofs --duration 1m --interval 2s`
+
+	// every fuse operation has metrics in .stats, e.g. dingofs_fuse_op_lookup_qps_total_count
+	// and dingofs_fuse_op_lookup_lat_total_value (in us), dingofs_fuse_op_all is sum of all
+	PROFILE_OP_PREFIX        = "dingofs_fuse_op_"
+	PROFILE_OP_COUNT_SUFFIX  = "_qps_total_count"
+	PROFILE_OP_LAT_SUFFIX    = "_lat_total_value"
+	PROFILE_OP_ALL           = "all"
+	PROFILE_OP_TYPE_DATA     = "data"
+	PROFILE_OP_TYPE_METADATA = "metadata"
+)
+
+// operations read or write file data, others are metadata operations
+var profileDataOps = map[string]bool{
+	"read":            true,
+	"write":           true,
+	"flush":           true,
+	"fsync":           true,
+	"fallocate":       true,
+	"copy_file_range": true,
+}
+
+// upper bounds in ms of latency buckets, the last bucket has no upper bound
+var profileBuckets = []float64{1, 10, 100, 1000}
+
+type profileOptions struct {
+	mountpoint string
+	duration   time.Duration
+	interval   time.Duration
+	format     string
+}
+
+// latency of an interval is the average of operations in the interval,
+// histogram counts operations by latency of the interval they belong to
+type ProfileOp struct {
+	Op          string   `json:"op"`
+	Type        string   `json:"type"`
+	Count       uint64   `json:"count"`
+	OpsPerSec   float64  `json:"ops_per_second"`
+	AvgLatency  float64  `json:"avg_latency_ms"`
+	MaxLatency  float64  `json:"max_latency_ms"`
+	TimePercent float64  `json:"time_percent"`
+	Histogram   []uint64 `json:"histogram"`
+	totalTime   float64
+}
+
+type ProfileReport struct {
+	MountPoint      string       `json:"mountpoint"`
+	Duration        string       `json:"duration"`
+	Buckets         []string     `json:"buckets"`
+	Ops             []*ProfileOp `json:"ops"`
+	MetadataPercent float64      `json:"metadata_percent"`
+	DataPercent     float64      `json:"data_percent"`
+}
+
+func NewFsProfileCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options profileOptions
+
+	cmd := &cobra.Command{
+		Use:               "profile MOUNTPOINT [OPTIONS]",
+		Short:             "Profile latency of fuse operations of mountpoint",
+		Args:              utils.ExactArgs(1),
+		Example:           FS_PROFILE_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.mountpoint = args[0]
+			if options.interval <= 0 || options.duration < options.interval {
+				return fmt.Errorf("--interval must be greater than 0 and not greater than --duration")
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runProfile(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().DurationVarP(&options.duration, "duration", "d", 30*time.Second, "Time window to collect")
+	cmd.Flags().DurationVarP(&options.interval, "interval", "i", 1*time.Second, "Interval of sampling")
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runProfile(cmd *cobra.Command, dingocli *cli.DingoCli, options profileOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	mountpoint, err := utils.FindDingoFSMountPoint(options.mountpoint)
+	if err != nil {
+		return errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	}
+	report, err := collectProfile(mountpoint.MountPoint, options)
+	if err != nil {
+		outputResult.Error = errno.FromError(err)
+	}
+	outputResult.Result = report
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_OP, common.ROW_TYPE, common.ROW_COUNT, common.ROW_OPS_PER_SECOND,
+		common.ROW_AVG_LATENCY, common.ROW_MAX_LATENCY, common.ROW_TIME_PERCENT}
+	header = append(header, report.Buckets...)
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, op := range report.Ops {
+		row := []string{op.Op, op.Type, fmt.Sprintf("%d", op.Count), fmt.Sprintf("%.1f", op.OpsPerSec),
+			fmt.Sprintf("%.3f ms", op.AvgLatency), fmt.Sprintf("%.3f ms", op.MaxLatency), fmt.Sprintf("%.1f", op.TimePercent)}
+		for _, count := range op.Histogram {
+			row = append(row, fmt.Sprintf("%d", count))
+		}
+		rows = append(rows, row)
+	}
+	// ops are sorted by time spent
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no fuse operation in " + report.Duration)
+	if len(report.Ops) > 0 {
+		fmt.Printf("Time spent in %s: metadata %.1f%%, data %.1f%%\n",
+			report.Duration, report.MetadataPercent, report.DataPercent)
+	}
+
+	return nil
+}
+
+// sample .stats every interval and aggregate operations in the window
+func collectProfile(mountpoint string, options profileOptions) (*ProfileReport, error) {
+	report := &ProfileReport{
+		MountPoint: mountpoint,
+		Duration:   options.duration.String(),
+		Buckets:    profileBucketNames(),
+		Ops:        []*ProfileOp{},
+	}
+
+	last, err := readStatsFile(mountpoint)
+	if err != nil {
+		return nil, err
+	}
+	var progress *output.Progress
+	if options.format != "json" {
+		progress = output.NewProgress(int64(options.duration/time.Second), fmt.Sprintf("Profiling %s", mountpoint))
+	}
+	ops := map[string]*ProfileOp{}
+	start := time.Now()
+	for elapsed := time.Duration(0); elapsed < options.duration; {
+		time.Sleep(options.interval)
+		current, err := readStatsFile(mountpoint)
+		if err != nil {
+			if progress != nil {
+				progress.Finish()
+			}
+			return nil, err
+		}
+		for name := range current {
+			op, ok := profileOpName(name)
+			if !ok {
+				continue
+			}
+			count := current[name] - last[name]
+			if count <= 0 {
+				continue
+			}
+			latName := PROFILE_OP_PREFIX + op + PROFILE_OP_LAT_SUFFIX
+			latency := (current[latName] - last[latName]) / 1000 // us -> ms
+			if ops[op] == nil {
+				ops[op] = &ProfileOp{Op: op, Type: profileOpType(op), Histogram: make([]uint64, len(profileBuckets)+1)}
+			}
+			ops[op].add(uint64(count), latency)
+		}
+		last = current
+		elapsed = time.Since(start)
+		if progress != nil {
+			progress.Set64(int64(elapsed / time.Second))
+		}
+	}
+	if progress != nil {
+		progress.Finish()
+	}
+
+	totalTime, metadataTime := 0.0, 0.0
+	seconds := time.Since(start).Seconds()
+	for _, op := range ops {
+		op.OpsPerSec = float64(op.Count) / seconds
+		op.AvgLatency = op.totalTime / float64(op.Count)
+		totalTime += op.totalTime
+		if op.Type == PROFILE_OP_TYPE_METADATA {
+			metadataTime += op.totalTime
+		}
+		report.Ops = append(report.Ops, op)
+	}
+	if totalTime > 0 {
+		for _, op := range report.Ops {
+			op.TimePercent = op.totalTime / totalTime * 100
+		}
+		report.MetadataPercent = metadataTime / totalTime * 100
+		report.DataPercent = 100 - report.MetadataPercent
+	}
+	sort.Slice(report.Ops, func(i, j int) bool {
+		return report.Ops[i].totalTime > report.Ops[j].totalTime
+	})
+	return report, nil
+}
+
+// count of operations in an interval and their total latency in ms
+func (p *ProfileOp) add(count uint64, latency float64) {
+	p.Count += count
+	p.totalTime += latency
+	avg := latency / float64(count)
+	p.MaxLatency = math.Max(p.MaxLatency, avg)
+	index := sort.SearchFloat64s(profileBuckets, avg)
+	p.Histogram[index] += count
+}
+
+func profileOpName(metric string) (string, bool) {
+	if !strings.HasPrefix(metric, PROFILE_OP_PREFIX) || !strings.HasSuffix(metric, PROFILE_OP_COUNT_SUFFIX) {
+		return "", false
+	}
+	op := strings.TrimSuffix(strings.TrimPrefix(metric, PROFILE_OP_PREFIX), PROFILE_OP_COUNT_SUFFIX)
+	return op, op != "" && op != PROFILE_OP_ALL
+}
+
+func profileOpType(op string) string {
+	if profileDataOps[op] {
+		return PROFILE_OP_TYPE_DATA
+	}
+	return PROFILE_OP_TYPE_METADATA
+}
+
+// e.g. <1ms, 1-10ms, 10-100ms, 100ms-1s, >1s
+func profileBucketNames() []string {
+	format := func(ms float64) string {
+		return time.Duration(ms * float64(time.Millisecond)).String()
+	}
+	names := []string{"<" + format(profileBuckets[0])}
+	for i := 1; i < len(profileBuckets); i++ {
+		names = append(names, format(profileBuckets[i-1])+"-"+format(profileBuckets[i]))
+	}
+	return append(names, ">"+format(profileBuckets[len(profileBuckets)-1]))
+}
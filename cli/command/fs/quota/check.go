@@ -28,12 +28,23 @@ import (
 	"github.com/dingodb/dingocli/internal/utils"
 	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
 	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 )
 
 const (
 	QUOTA_CHECK_EXAMPLE = `Examples:
-   $ dingo fs quota check--fsname fs1 --path /dir1`
+   $ dingo fs quota check --fsname fs1 --path /dir1
+
+   # recalculate by scanning all dentries instead of directory stats maintained by mds
+   $ dingo fs quota check --fsname fs1 --path /dir1 --strict
+
+   # reset used bytes and inodes of quota to the real usage
+   $ dingo fs quota check --fsname fs1 --path /dir1 --repair`
+
+	QUOTA_STATUS_REPAIRED = "repaired"
+	QUOTA_METHOD_DIRSTATS = "dirstats"
+	QUOTA_METHOD_SCAN     = "scan"
 )
 
 type checkOptions struct {
@@ -42,6 +53,25 @@ type checkOptions struct {
 	threads uint32
 	format  string
 	repair  bool
+	strict  bool
+}
+
+type QuotaCheckResult struct {
+	FsId           uint32 `json:"fs_id"`
+	Path           string `json:"path"`
+	Ino            uint64 `json:"ino"`
+	MaxBytes       int64  `json:"max_bytes"`
+	UsedBytes      int64  `json:"used_bytes"`
+	RealUsedBytes  int64  `json:"real_used_bytes"`
+	BytesDelta     int64  `json:"bytes_delta"`
+	MaxInodes      int64  `json:"max_inodes"`
+	UsedInodes     int64  `json:"used_inodes"`
+	RealUsedInodes int64  `json:"real_used_inodes"`
+	InodesDelta    int64  `json:"inodes_delta"`
+	// real usage is counted by directory stats of mds or by scanning dentries
+	Method     string `json:"method"`
+	Consistent bool   `json:"consistent"`
+	Repaired   bool   `json:"repaired"`
 }
 
 func NewQuotaCheckCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -74,6 +104,7 @@ func NewQuotaCheckCommand(dingocli *cli.DingoCli) *cobra.Command {
 				return err
 			}
 
+			options.strict = utils.GetBoolFlag(cmd, utils.DINGOFS_STRICT)
 			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
 
 			return runCheck(cmd, dingocli, options)
@@ -90,6 +121,7 @@ func NewQuotaCheckCommand(dingocli *cli.DingoCli) *cobra.Command {
 	utils.AddStringRequiredFlag(cmd, "path", "full path of the directory within the volume")
 	cmd.Flags().Uint32("threads", 8, "Number of check threads")
 	cmd.Flags().Bool("repair", false, "Repair inconsistent quota")
+	utils.AddBoolFlag(cmd, utils.DINGOFS_STRICT, "Scan all dentries instead of directory stats maintained by mds")
 
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddConfigFileFlag(cmd)
@@ -128,8 +160,48 @@ func runCheck(cmd *cobra.Command, dingocli *cli.DingoCli, options checkOptions)
 	_, result, err := GetDirQuotaData(cmd, options.fsid, dirInodeId, epoch)
 	if err != nil {
 		outputResult.Error = err
+		if options.format == "json" {
+			return output.OutputJson(outputResult)
+		}
+		return err
+	}
+	dirQuota := result.GetQuota()
+
+	// get real usage of directory
+	realUsedBytes, realUsedInodes, method, getErr := getDirRealUsage(cmd, options, dirInodeId, epoch)
+	if getErr != nil {
+		return getErr
 	}
-	outputResult.Result = result
+
+	checkResult := &QuotaCheckResult{
+		FsId:           options.fsid,
+		Path:           options.path,
+		Ino:            dirInodeId,
+		MaxBytes:       dirQuota.GetMaxBytes(),
+		UsedBytes:      dirQuota.GetUsedBytes(),
+		RealUsedBytes:  realUsedBytes,
+		BytesDelta:     dirQuota.GetUsedBytes() - realUsedBytes,
+		MaxInodes:      dirQuota.GetMaxInodes(),
+		UsedInodes:     dirQuota.GetUsedInodes(),
+		RealUsedInodes: realUsedInodes,
+		InodesDelta:    dirQuota.GetUsedInodes() - realUsedInodes,
+		Method:         method,
+	}
+	checkResult.Consistent = checkResult.BytesDelta == 0 && checkResult.InodesDelta == 0
+
+	if options.repair && !checkResult.Consistent { // inconsistent and need to repair
+		action := cli.NewAction(cli.ACTION_RPC, "SetDirQuota(fsid=%d, path=%s, inode=%d, usedBytes=%d, usedInodes=%d)",
+			options.fsid, options.path, dirInodeId, realUsedBytes, realUsedInodes)
+		repairErr := dingocli.Perform(action, func() error {
+			return repairDirQuota(cmd, options.fsid, dirInodeId, realUsedBytes, realUsedInodes, epoch)
+		})
+		if repairErr != nil {
+			outputResult.Error = errno.ERR_RPC_FAILED.S(repairErr.Error())
+		} else {
+			checkResult.Repaired = !dingocli.IsDryRun()
+		}
+	}
+	outputResult.Result = checkResult
 
 	// print result
 	if options.format == "json" {
@@ -139,64 +211,92 @@ func runCheck(cmd *cobra.Command, dingocli *cli.DingoCli, options checkOptions)
 		return outputResult.Error
 	}
 
-	// get filesystem usage
-	dirUsedBytes, dirUsedInodes, getErr := rpc.GetDirectorySizeAndInodes(cmd, options.fsid, dirInodeId, false, epoch, options.threads)
-	if getErr != nil {
-		return getErr
+	values, _ := utils.CheckQuota(checkResult.MaxBytes, checkResult.UsedBytes, checkResult.MaxInodes, checkResult.UsedInodes, realUsedBytes, realUsedInodes)
+	status := values[6]
+	if checkResult.Repaired {
+		status = QUOTA_STATUS_REPAIRED
+	}
+
+	header := []string{common.ROW_INODE_ID, common.ROW_NAME, common.ROW_CAPACITY, common.ROW_USED, common.ROW_REAL_USED, common.ROW_USED_DELTA,
+		common.ROW_INODES, common.ROW_INODES_IUSED, common.ROW_INODES_REAL_IUSED, common.ROW_INODES_DELTA, common.ROW_STATUS}
+	table.SetHeader(header)
+
+	row := map[string]string{
+		common.ROW_INODE_ID:          fmt.Sprintf("%d", dirInodeId),
+		common.ROW_NAME:              options.path,
+		common.ROW_CAPACITY:          values[0],
+		common.ROW_USED:              values[1],
+		common.ROW_REAL_USED:         values[2],
+		common.ROW_USED_DELTA:        formatQuotaDelta(checkResult.BytesDelta),
+		common.ROW_INODES:            values[3],
+		common.ROW_INODES_IUSED:      values[4],
+		common.ROW_INODES_REAL_IUSED: values[5],
+		common.ROW_INODES_DELTA:      formatQuotaDelta(checkResult.InodesDelta),
+		common.ROW_STATUS:            status,
 	}
+	table.Append(table.Map2List(row, header))
+	table.RenderWithNoData("no dir quota found")
 
-	fsQuota := result.GetQuota()
-	checkResult, ok := utils.CheckQuota(fsQuota.GetMaxBytes(), fsQuota.GetUsedBytes(), fsQuota.GetMaxInodes(), fsQuota.GetUsedInodes(), dirUsedBytes, dirUsedInodes)
+	return nil
+}
 
-	if options.repair && !ok { // inconsistent and need to repair
-		// new prc
-		mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "SetDirQuota")
+// directory stats are maintained by mds for each directory, so only directories are listed
+// instead of getting every inode, fall back to scan dentries if dir stats is disabled or strict
+func getDirRealUsage(cmd *cobra.Command, options checkOptions, dirInodeId uint64, epoch uint64) (int64, int64, string, error) {
+	if !options.strict {
+		fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
 		if err != nil {
-			return err
+			return 0, 0, "", err
 		}
-		// set request info
-		request := &mds.SetDirQuotaRequest{
-			Context: &mds.Context{Epoch: epoch, IsBypassCache: true},
-			FsId:    options.fsid,
-			Ino:     dirInodeId,
-			Quota:   &mds.Quota{UsedBytes: dirUsedBytes, UsedInodes: dirUsedInodes},
+		if fsInfo.GetEnableDirStats() {
+			tree, err := rpc.WalkDirTree(cmd, options.fsid, dirInodeId, options.path, true, 0, epoch)
+			if err != nil {
+				return 0, 0, "", err
+			}
+			// dirs of tree includes the directory itself, same as quota
+			return int64(tree.Length), int64(tree.Files + tree.Dirs), QUOTA_METHOD_DIRSTATS, nil
 		}
+	}
 
-		setDirQuotaRpc := &rpc.SetDirQuotaRpc{
-			Info:    mdsRpc,
-			Request: request,
-		}
+	usedBytes, usedInodes, err := rpc.GetDirectorySizeAndInodes(cmd, options.fsid, dirInodeId, false, epoch, options.threads)
+	return usedBytes, usedInodes, QUOTA_METHOD_SCAN, err
+}
 
-		// get rpc result
-		response, rpcError := rpc.GetRpcResponse(setDirQuotaRpc.Info, setDirQuotaRpc)
-		if rpcError.GetCode() != errno.ERR_OK.GetCode() {
-			return rpcError
-		} else {
-			result := response.(*mds.SetDirQuotaResponse)
-			if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
-				return errno.ERR_RPC_FAILED.S(mdsErr.String())
-			}
-		}
+func repairDirQuota(cmd *cobra.Command, fsId uint32, dirInodeId uint64, usedBytes int64, usedInodes int64, epoch uint64) error {
+	// new prc
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "SetDirQuota")
+	if err != nil {
+		return err
+	}
+	// set request info
+	request := &mds.SetDirQuotaRequest{
+		Context: &mds.Context{Epoch: epoch, IsBypassCache: true},
+		FsId:    fsId,
+		Ino:     dirInodeId,
+		Quota:   &mds.Quota{UsedBytes: usedBytes, UsedInodes: usedInodes},
+	}
 
-		fmt.Println("Successfully repair dir inconsistent quota")
-	} else {
-		header := []string{common.ROW_INODE_ID, common.ROW_NAME, common.ROW_CAPACITY, common.ROW_USED, common.ROW_REAL_USED, common.ROW_INODES, common.ROW_INODES_IUSED, common.ROW_INODES_REAL_IUSED, common.ROW_STATUS}
-		table.SetHeader(header)
-
-		row := map[string]string{
-			common.ROW_INODE_ID:          fmt.Sprintf("%d", dirInodeId),
-			common.ROW_NAME:              options.path,
-			common.ROW_CAPACITY:          checkResult[0],
-			common.ROW_USED:              checkResult[1],
-			common.ROW_REAL_USED:         checkResult[2],
-			common.ROW_INODES:            checkResult[3],
-			common.ROW_INODES_IUSED:      checkResult[4],
-			common.ROW_INODES_REAL_IUSED: checkResult[5],
-			common.ROW_STATUS:            checkResult[6],
-		}
-		table.Append(table.Map2List(row, header))
-		table.RenderWithNoData("no dir quota found")
+	setDirQuotaRpc := &rpc.SetDirQuotaRpc{
+		Info:    mdsRpc,
+		Request: request,
 	}
 
+	// get rpc result
+	response, rpcError := rpc.GetRpcResponse(setDirQuotaRpc.Info, setDirQuotaRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+	result := response.(*mds.SetDirQuotaResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
 	return nil
 }
+
+// delta is stored usage minus real usage, e.g. +4,096 means quota counts 4096 bytes more
+func formatQuotaDelta(delta int64) string {
+	if delta > 0 {
+		return "+" + humanize.Comma(delta)
+	}
+	return humanize.Comma(delta)
+}
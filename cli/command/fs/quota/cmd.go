@@ -35,6 +35,10 @@ func NewQuotaCommand(dingocli *cli.DingoCli) *cobra.Command {
 		NewQuotaCheckCommand(dingocli),
 		NewQuotaListCommand(dingocli),
 		NewQuotaDeleteCommand(dingocli),
+		NewQuotaInheritCommand(dingocli),
+		NewQuotaReportCommand(dingocli),
+		NewQuotaExportCommand(dingocli),
+		NewQuotaImportCommand(dingocli),
 	)
 
 	return cmd
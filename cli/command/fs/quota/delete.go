@@ -32,7 +32,8 @@ import (
 
 const (
 	QUOTA_DELETE_EXAMPLE = `Examples:
-   $ dingo fs quota delete --fsname dingofs --path /dir1`
+   $ dingo fs quota delete --fsname dingofs --path /dir1
+   $ dingo fs quota delete --fsname dingofs --path /dir1 --yes`
 )
 
 type deleteOptions struct {
@@ -121,16 +122,30 @@ func runDelete(cmd *cobra.Command, dingocli *cli.DingoCli, options deleteOptions
 		},
 	}
 
+	if !dingocli.IsDryRun() && !utils.Confirm("Are you sure to delete quota of directory %s?", options.path) {
+		return fmt.Errorf("abort delete directory quota")
+	}
+
 	// get rpc result
-	response, rpcError := rpc.GetRpcResponse(deleteRpc.Info, deleteRpc)
-	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
-		outputResult.Error = rpcError
-	} else {
-		result := response.(*mds.DeleteDirQuotaResponse)
-		if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
-			outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
+	action := cli.NewAction(cli.ACTION_RPC, "DeleteDirQuota(endpoint=%s, fsid=%d, path=%s, inode=%d)",
+		endpoint, options.fsid, options.path, dirInodeId)
+	dingocli.Perform(action, func() error {
+		response, rpcError := rpc.GetRpcResponse(deleteRpc.Info, deleteRpc)
+		if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+			outputResult.Error = rpcError
+		} else {
+			result := response.(*mds.DeleteDirQuotaResponse)
+			if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+				outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
+			} else if err := deleteQuotaWarn(dingocli, options.fsid, dirInodeId); err != nil {
+				outputResult.Error = errno.FromError(err)
+			}
+			outputResult.Result = result
 		}
-		outputResult.Result = result
+		return nil
+	})
+	if dingocli.IsDryRun() {
+		return nil
 	}
 
 	// print result
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/config"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	QUOTA_EXPORT_EXAMPLE = `Examples:
+   $ dingo fs quota export --fsname dingofs > quotas.yaml`
+)
+
+type exportOptions struct {
+	fsid uint32
+}
+
+// quotas of a filesystem which can be reviewed and replayed by quota import,
+// limits are in bytes and inodes, 0 is unlimited
+type QuotaExport struct {
+	FsName      string            `yaml:"fs_name"`
+	ExportedAt  string            `yaml:"exported_at"`
+	FsQuota     *QuotaLimit       `yaml:"fs_quota,omitempty"`
+	Directories []*DirQuotaExport `yaml:"directories"`
+	Templates   []*DirQuotaExport `yaml:"templates,omitempty"`
+}
+
+type QuotaLimit struct {
+	Capacity int64 `yaml:"capacity"`
+	Inodes   int64 `yaml:"inodes"`
+}
+
+type DirQuotaExport struct {
+	Path     string `yaml:"path"`
+	Capacity int64  `yaml:"capacity"`
+	Inodes   int64  `yaml:"inodes"`
+	WarnAt   uint32 `yaml:"warn_at,omitempty"`
+}
+
+func NewQuotaExportCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options exportOptions
+
+	cmd := &cobra.Command{
+		Use:     "export [OPTIONS]",
+		Short:   "Export quotas of filesystem as yaml",
+		Args:    utils.NoArgs,
+		Example: QUOTA_EXPORT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+
+			return runExport(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// directories are identified by path, so quotas can be imported to another filesystem
+func runExport(cmd *cobra.Command, dingocli *cli.DingoCli, options exportOptions) error {
+	fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+	if err != nil {
+		return err
+	}
+	export := &QuotaExport{
+		FsName:      fsInfo.GetFsName(),
+		ExportedAt:  time.Now().Format(time.RFC3339),
+		Directories: []*DirQuotaExport{},
+	}
+
+	_, fsQuota, errCode := config.GetFsQuotaData(cmd, options.fsid)
+	if errCode != nil {
+		return errCode
+	}
+	if limit := quotaLimit(fsQuota.GetQuota().GetMaxBytes(), fsQuota.GetQuota().GetMaxInodes()); limit.Capacity > 0 || limit.Inodes > 0 {
+		export.FsQuota = limit
+	}
+
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+	quotas, err := LoadDirQuotas(cmd, options.fsid, epoch)
+	if err != nil {
+		return err
+	}
+	warns, err := getQuotaWarns(dingocli, options.fsid)
+	if err != nil {
+		return err
+	}
+	dirPaths, err := resolveQuotaPaths(cmd, options.fsid, quotaInodes(quotas), epoch)
+	if err != nil {
+		return err
+	}
+	for ino, quota := range quotas {
+		dirPath, ok := dirPaths[ino]
+		if !ok { // directory may be deleted, not export
+			continue
+		}
+		limit := quotaLimit(quota.GetMaxBytes(), quota.GetMaxInodes())
+		dir := &DirQuotaExport{Path: dirPath, Capacity: limit.Capacity, Inodes: limit.Inodes}
+		if warn, ok := warns[ino]; ok {
+			dir.WarnAt = warn.WarnAt
+		}
+		export.Directories = append(export.Directories, dir)
+	}
+	sort.Slice(export.Directories, func(i, j int) bool {
+		return export.Directories[i].Path < export.Directories[j].Path
+	})
+
+	templates, err := getQuotaTemplates(dingocli, options.fsid)
+	if err != nil {
+		return err
+	}
+	for _, template := range templates {
+		limit := quotaLimit(template.MaxBytes, template.MaxInodes)
+		export.Templates = append(export.Templates, &DirQuotaExport{Path: template.Path, Capacity: limit.Capacity, Inodes: limit.Inodes})
+	}
+	sort.Slice(export.Templates, func(i, j int) bool {
+		return export.Templates[i].Path < export.Templates[j].Path
+	})
+
+	data, err := yaml.Marshal(export)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	if err == nil {
+		fmt.Fprintf(os.Stderr, "Exported %d directory quotas and %d templates of %s\n",
+			len(export.Directories), len(export.Templates), export.FsName)
+	}
+	return err
+}
+
+// unlimited is 0 in export, which is MaxInt64 or 0 in mds
+func quotaLimit(maxBytes, maxInodes int64) *QuotaLimit {
+	limit := &QuotaLimit{Capacity: maxBytes, Inodes: maxInodes}
+	if limit.Capacity < 0 || limit.Capacity == math.MaxInt64 {
+		limit.Capacity = 0
+	}
+	if limit.Inodes < 0 || limit.Inodes == math.MaxInt64 {
+		limit.Inodes = 0
+	}
+	return limit
+}
+
+func mdsQuotaLimit(limit int64) int64 {
+	if limit <= 0 {
+		return math.MaxInt64
+	}
+	return limit
+}
@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/config"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	QUOTA_IMPORT_EXAMPLE = `Examples:
+   $ dingo fs quota import quotas.yaml --fsname dingofs2
+
+   # show quotas to set without setting them
+   $ dingo --dry-run fs quota import quotas.yaml --fsname dingofs2`
+
+	QUOTA_IMPORT_STDIN = "-"
+
+	QUOTA_KIND_FS        = "fs"
+	QUOTA_KIND_DIRECTORY = "directory"
+	QUOTA_KIND_TEMPLATE  = "template"
+
+	QUOTA_IMPORT_OK     = "ok"
+	QUOTA_IMPORT_FAILED = "failed"
+)
+
+type importOptions struct {
+	fsid    uint32
+	file    string
+	threads uint32
+	format  string
+}
+
+type QuotaImportResult struct {
+	Kind   string `json:"kind"`
+	Path   string `json:"path"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+func NewQuotaImportCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options importOptions
+
+	cmd := &cobra.Command{
+		Use:     "import FILE [OPTIONS]",
+		Short:   "Import quotas exported by quota export",
+		Args:    utils.ExactArgs(1),
+		Example: QUOTA_IMPORT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.file = args[0]
+			options.threads, _ = cmd.Flags().GetUint32("threads")
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runImport(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().Uint32("threads", 8, "Number of threads calculate directory usage")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func readQuotaExport(file string) (*QuotaExport, error) {
+	var data []byte
+	var err error
+	if file == QUOTA_IMPORT_STDIN {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	export := &QuotaExport{}
+	if err := yaml.Unmarshal(data, export); err != nil {
+		return nil, fmt.Errorf("parse %s failed: %v", file, err)
+	}
+	return export, nil
+}
+
+// usage of directories is counted again, directories missing in the filesystem are reported as failed
+func runImport(cmd *cobra.Command, dingocli *cli.DingoCli, options importOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	export, err := readQuotaExport(options.file)
+	if err != nil {
+		return err
+	}
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+
+	results := []*QuotaImportResult{}
+	record := func(kind, path string, err error) {
+		result := &QuotaImportResult{Kind: kind, Path: path, Result: QUOTA_IMPORT_OK}
+		if err != nil {
+			result.Result, result.Error = QUOTA_IMPORT_FAILED, err.Error()
+			outputResult.Error = errno.ERR_IMPORT_QUOTA_FAILED
+		}
+		results = append(results, result)
+	}
+
+	if limit := export.FsQuota; limit != nil {
+		action := cli.NewAction(cli.ACTION_RPC, "SetFsQuota(fsid=%d, capacity=%d, inodes=%d)", options.fsid, limit.Capacity, limit.Inodes)
+		record(QUOTA_KIND_FS, "/", dingocli.Perform(action, func() error {
+			if _, errCode := config.SetFsQuota(cmd, options.fsid, mdsQuotaLimit(limit.Capacity), mdsQuotaLimit(limit.Inodes)); errCode != nil {
+				return errCode
+			}
+			return nil
+		}))
+	}
+
+	for _, dir := range export.Directories {
+		record(QUOTA_KIND_DIRECTORY, dir.Path, importDirQuota(cmd, dingocli, options, dir, epoch))
+	}
+
+	for _, dir := range export.Templates {
+		template := &QuotaTemplate{FsId: options.fsid, Path: dir.Path, MaxBytes: mdsQuotaLimit(dir.Capacity), MaxInodes: mdsQuotaLimit(dir.Inodes)}
+		action := cli.NewAction(cli.ACTION_FILE, "SetQuotaTemplate(fsid=%d, path=%s, capacity=%d, inodes=%d)",
+			options.fsid, dir.Path, dir.Capacity, dir.Inodes)
+		record(QUOTA_KIND_TEMPLATE, dir.Path, dingocli.Perform(action, func() error {
+			data, err := json.Marshal(template)
+			if err != nil {
+				return err
+			}
+			if err := dingocli.Storage().SetQuotaTemplate(quotaTemplateId(template.FsId, template.Path), string(data)); err != nil {
+				return errno.ERR_INSERT_QUOTA_TEMPLATE_FAILED.E(err)
+			}
+			_, err = applyQuotaTemplate(cmd, dingocli, template, options.threads)
+			return err
+		}))
+	}
+	outputResult.Result = results
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if dingocli.IsDryRun() {
+		return nil
+	}
+
+	header := []string{common.ROW_TYPE, common.ROW_PATH, common.ROW_RESULT, common.ROW_REASON}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, result := range results {
+		rows = append(rows, []string{
+			result.Kind,
+			result.Path,
+			result.Result,
+			utils.Ternary(result.Error != "", result.Error, common.ROW_VALUE_NO_VALUE),
+		})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no quota in " + options.file)
+
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+func importDirQuota(cmd *cobra.Command, dingocli *cli.DingoCli, options importOptions, dir *DirQuotaExport, epoch uint64) error {
+	dirInodeId, err := rpc.GetDirPathInodeId(cmd, options.fsid, dir.Path, epoch)
+	if err != nil {
+		return err
+	}
+	maxBytes, maxInodes := mdsQuotaLimit(dir.Capacity), mdsQuotaLimit(dir.Inodes)
+	action := cli.NewAction(cli.ACTION_RPC, "SetDirQuota(fsid=%d, path=%s, inode=%d, capacity=%d, inodes=%d)",
+		options.fsid, dir.Path, dirInodeId, dir.Capacity, dir.Inodes)
+	return dingocli.Perform(action, func() error {
+		if _, errCode := setDirQuota(cmd, options.fsid, dirInodeId, maxBytes, maxInodes, epoch, options.threads); errCode.GetCode() != errno.ERR_OK.GetCode() {
+			return errCode
+		}
+		if dir.WarnAt == 0 {
+			return deleteQuotaWarn(dingocli, options.fsid, dirInodeId)
+		}
+		return setQuotaWarn(dingocli, &QuotaWarn{FsId: options.fsid, Ino: dirInodeId, Path: dir.Path, WarnAt: dir.WarnAt})
+	})
+}
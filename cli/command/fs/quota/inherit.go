@@ -0,0 +1,337 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+// mds has no hook on mkdir, so quota templates are recorded in local database of dingo
+// and applied to sub directories without quota by dingo fs quota inherit, e.g. by cron
+const (
+	QUOTA_INHERIT_EXAMPLE = `Examples:
+   # set quota of new sub directories by templates of all filesystems
+   $ dingo fs quota inherit
+
+   $ dingo fs quota inherit --fsname dingofs
+
+   $ dingo fs quota inherit --list
+
+   $ dingo fs quota inherit --fsname dingofs --path /projects --remove`
+
+	QUOTA_FLAG_INHERIT = "inherit"
+)
+
+type inheritOptions struct {
+	path    string
+	list    bool
+	remove  bool
+	threads uint32
+	format  string
+}
+
+// quota set on every sub directory of the path
+type QuotaTemplate struct {
+	FsId      uint32 `json:"fs_id"`
+	Path      string `json:"path"`
+	MaxBytes  int64  `json:"max_bytes"`
+	MaxInodes int64  `json:"max_inodes"`
+}
+
+type QuotaInheritResult struct {
+	FsId    uint32   `json:"fs_id"`
+	Path    string   `json:"path"`
+	Applied []string `json:"applied"`
+	Error   string   `json:"error,omitempty"`
+}
+
+func NewQuotaInheritCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options inheritOptions
+
+	cmd := &cobra.Command{
+		Use:     "inherit [OPTIONS]",
+		Short:   "Set quota of new sub directories by quota templates",
+		Args:    utils.NoArgs,
+		Example: QUOTA_INHERIT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.path = utils.GetStringFlag(cmd, "path")
+			options.list, _ = cmd.Flags().GetBool("list")
+			options.remove, _ = cmd.Flags().GetBool("remove")
+			options.threads, _ = cmd.Flags().GetUint32("threads")
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runInherit(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id, all filesystems if fsid and fsname are not set")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().String("path", "", "Parent directory of the template, only for --remove")
+	cmd.Flags().Bool("list", false, "List quota templates")
+	cmd.Flags().Bool("remove", false, "Remove quota template of the path, quotas already set are kept")
+	cmd.Flags().Uint32("threads", 8, "Number of threads calculate directory usage")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runInherit(cmd *cobra.Command, dingocli *cli.DingoCli, options inheritOptions) error {
+	fsId := uint32(0)
+	if cmd.Flags().Changed("fsid") || cmd.Flags().Changed("fsname") {
+		id, err := rpc.GetFsId(cmd)
+		if err != nil {
+			return err
+		}
+		fsId = id
+	}
+
+	if options.remove {
+		if fsId == 0 || len(options.path) == 0 {
+			return fmt.Errorf("--fsid or --fsname and --path are required to remove quota template")
+		}
+		id := quotaTemplateId(fsId, path.Clean(options.path))
+		if err := dingocli.Storage().DeleteQuotaTemplate(id); err != nil {
+			return errno.ERR_DELETE_QUOTA_TEMPLATE_FAILED.E(err)
+		}
+		fmt.Printf("Successfully remove quota template of directory[%s]\n", options.path)
+		return nil
+	}
+
+	templates, err := getQuotaTemplates(dingocli, fsId)
+	if err != nil {
+		return err
+	}
+	if options.list {
+		return listQuotaTemplates(templates, options.format)
+	}
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	results := []*QuotaInheritResult{}
+	for _, template := range templates {
+		result := &QuotaInheritResult{FsId: template.FsId, Path: template.Path, Applied: []string{}}
+		result.Applied, err = applyQuotaTemplate(cmd, dingocli, template, options.threads)
+		if err != nil {
+			result.Error = err.Error()
+			outputResult.Error = errno.FromError(err)
+		}
+		results = append(results, result)
+	}
+	outputResult.Result = results
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	for _, result := range results {
+		printQuotaInheritResult(result)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+// record the template and apply it to existing sub directories
+func runSetInherit(cmd *cobra.Command, dingocli *cli.DingoCli, template *QuotaTemplate, threads uint32, format string) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	if !dingocli.IsDryRun() {
+		data, err := json.Marshal(template)
+		if err != nil {
+			return err
+		}
+		if err := dingocli.Storage().SetQuotaTemplate(quotaTemplateId(template.FsId, template.Path), string(data)); err != nil {
+			return errno.ERR_INSERT_QUOTA_TEMPLATE_FAILED.E(err)
+		}
+	}
+
+	result := &QuotaInheritResult{FsId: template.FsId, Path: template.Path, Applied: []string{}}
+	applied, err := applyQuotaTemplate(cmd, dingocli, template, threads)
+	if err != nil {
+		result.Error = err.Error()
+		outputResult.Error = errno.FromError(err)
+	}
+	result.Applied = applied
+	outputResult.Result = result
+
+	// print result
+	if format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if dingocli.IsDryRun() {
+		return nil
+	}
+	fmt.Printf("Successfully set quota template of directory[%s], capacity: %s, inodes: %s\n",
+		template.Path, formatTemplateLimit(template.MaxBytes, true), formatTemplateLimit(template.MaxInodes, false))
+	printQuotaInheritResult(result)
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+// set quota of sub directories which have no quota, returns their paths
+func applyQuotaTemplate(cmd *cobra.Command, dingocli *cli.DingoCli, template *QuotaTemplate, threads uint32) ([]string, error) {
+	applied := []string{}
+	epoch, err := rpc.GetFsEpochByFsId(cmd, template.FsId)
+	if err != nil {
+		return applied, err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, template.FsId); err != nil {
+		return applied, err
+	}
+	parentInodeId, err := rpc.GetDirPathInodeId(cmd, template.FsId, template.Path, epoch)
+	if err != nil {
+		return applied, err
+	}
+	quotas, err := LoadDirQuotas(cmd, template.FsId, epoch)
+	if err != nil {
+		return applied, err
+	}
+	entries, err := rpc.ListDentry(cmd, template.FsId, parentInodeId, epoch)
+	if err != nil {
+		return applied, err
+	}
+
+	for _, entry := range entries {
+		if entry.GetType() != mds.FileType_DIRECTORY {
+			continue
+		} else if _, ok := quotas[entry.GetIno()]; ok { // set by template before or by user
+			continue
+		}
+
+		dirPath := path.Join(template.Path, entry.GetName())
+		action := cli.NewAction(cli.ACTION_RPC, "SetDirQuota(fsid=%d, path=%s, capacity=%d, inodes=%d)",
+			template.FsId, dirPath, template.MaxBytes, template.MaxInodes)
+		err := dingocli.Perform(action, func() error {
+			_, setErr := setDirQuota(cmd, template.FsId, entry.GetIno(), template.MaxBytes, template.MaxInodes, epoch, threads)
+			if setErr.GetCode() != errno.ERR_OK.GetCode() {
+				return setErr
+			}
+			return nil
+		})
+		if err != nil {
+			return applied, err
+		}
+		logger.Infof("set quota of directory %s by template of %s", dirPath, template.Path)
+		applied = append(applied, dirPath)
+	}
+	return applied, nil
+}
+
+// templates of the fs, all templates if fsId is 0
+func getQuotaTemplates(dingocli *cli.DingoCli, fsId uint32) ([]*QuotaTemplate, error) {
+	items, err := dingocli.Storage().GetQuotaTemplates()
+	if err != nil {
+		return nil, errno.ERR_SELECT_QUOTA_TEMPLATE_FAILED.E(err)
+	}
+	templates := []*QuotaTemplate{}
+	for _, item := range items {
+		template := &QuotaTemplate{}
+		if err := json.Unmarshal([]byte(item.Data), template); err != nil {
+			logger.Warnf("invalid quota template %s: %v", item.Id, err)
+			continue
+		}
+		if fsId == 0 || template.FsId == fsId {
+			templates = append(templates, template)
+		}
+	}
+	return templates, nil
+}
+
+func listQuotaTemplates(templates []*QuotaTemplate, format string) error {
+	if format == "json" {
+		return output.OutputJson(&common.OutputResult{Error: errno.ERR_OK, Result: templates})
+	}
+
+	header := []string{common.ROW_FS_ID, common.ROW_PATH, common.ROW_CAPACITY, common.ROW_INODES}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, template := range templates {
+		row := make(map[string]string)
+		row[common.ROW_FS_ID] = fmt.Sprintf("%d", template.FsId)
+		row[common.ROW_PATH] = template.Path
+		row[common.ROW_CAPACITY] = formatTemplateLimit(template.MaxBytes, true)
+		row[common.ROW_INODES] = formatTemplateLimit(template.MaxInodes, false)
+		rows = append(rows, row)
+	}
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_FS_ID, common.ROW_PATH})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no quota template found")
+	return nil
+}
+
+func printQuotaInheritResult(result *QuotaInheritResult) {
+	if len(result.Error) > 0 {
+		fmt.Printf("Set quota of sub directories of [%s] failed: %s\n", result.Path, result.Error)
+	}
+	if len(result.Applied) == 0 {
+		fmt.Printf("No new sub directory of [%s]\n", result.Path)
+		return
+	}
+	fmt.Printf("Set quota of %d sub directories of [%s]: %s\n", len(result.Applied), result.Path, strings.Join(result.Applied, ", "))
+}
+
+func quotaTemplateId(fsId uint32, path string) string {
+	return fmt.Sprintf("%d:%s", fsId, path)
+}
+
+func formatTemplateLimit(limit int64, bytes bool) string {
+	if limit <= 0 || limit == math.MaxInt64 {
+		return "unlimited"
+	} else if bytes {
+		return humanize.IBytes(uint64(limit))
+	}
+	return humanize.Comma(limit)
+}
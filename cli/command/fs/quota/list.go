@@ -78,10 +78,13 @@ func NewQuotaListCommand(dingocli *cli.DingoCli) *cobra.Command {
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddConfigFileFlag(cmd)
 	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+	utils.EnablePager(cmd)
 
 	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
 	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
 	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
 
 	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
 
@@ -140,20 +143,18 @@ func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) er
 	table.SetHeader(header)
 
 	dirQuotas := result.GetQuotas()
+	dirPaths, err := resolveQuotaPaths(cmd, options.fsid, quotaInodes(dirQuotas), epoch)
+	if err != nil {
+		return err
+	}
 	// fill table
 	rows := make([]map[string]string, 0)
 	for dirInode, quota := range dirQuotas {
 		row := make(map[string]string)
 		quotaValueSlice := utils.ConvertQuotaToHumanizeValue(uint64(quota.GetMaxBytes()), quota.GetUsedBytes(), uint64(quota.GetMaxInodes()), quota.GetUsedInodes())
 
-		dirPath, _, dirErr := rpc.GetInodePath(cmd, options.fsid, dirInode, epoch)
-		if errors.Is(dirErr, syscall.ENOENT) {
-			continue
-		}
-		if dirErr != nil {
-			return dirErr
-		}
-		if dirPath == "" { // directory may be deleted,not show
+		dirPath, ok := dirPaths[dirInode]
+		if !ok { // directory may be deleted,not show
 			continue
 		}
 		row[common.ROW_INODE_ID] = fmt.Sprintf("%d", dirInode)
@@ -172,3 +173,61 @@ func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) er
 
 	return nil
 }
+
+// quotas of all directories in fs by inode id
+func LoadDirQuotas(cmd *cobra.Command, fsId uint32, epoch uint64) (map[uint64]*mds.Quota, error) {
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "LoadDirQuotas")
+	if err != nil {
+		return nil, err
+	}
+	listQuotaRpc := &rpc.ListDirQuotaRpc{
+		Info: mdsRpc,
+		Request: &mds.LoadDirQuotasRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsId:    fsId,
+		},
+	}
+	response, rpcError := rpc.GetRpcResponse(listQuotaRpc.Info, listQuotaRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.LoadDirQuotasResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+	quotas := map[uint64]*mds.Quota{}
+	for ino, quota := range result.GetQuotas() {
+		quotas[ino] = quota
+	}
+	return quotas, nil
+}
+
+// paths of quota directories by inode id, resolved at once,
+// deleted directories are not in result
+func resolveQuotaPaths(cmd *cobra.Command, fsId uint32, inos []uint64, epoch uint64) (map[uint64]string, error) {
+	paths, errs := rpc.Batch(cmd, inos, func(ino uint64) (string, error) {
+		dirPath, _, err := rpc.GetInodePath(cmd, fsId, ino, epoch)
+		if errors.Is(err, syscall.ENOENT) {
+			return "", nil
+		}
+		return dirPath, err
+	})
+	if err := rpc.FirstError(errs); err != nil {
+		return nil, err
+	}
+	result := map[uint64]string{}
+	for i, ino := range inos {
+		if paths[i] != "" {
+			result[ino] = paths[i]
+		}
+	}
+	return result, nil
+}
+
+func quotaInodes(quotas map[uint64]*mds.Quota) []uint64 {
+	inos := make([]uint64, 0, len(quotas))
+	for ino := range quotas {
+		inos = append(inos, ino)
+	}
+	return inos
+}
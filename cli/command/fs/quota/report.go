@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	QUOTA_REPORT_EXAMPLE = `Examples:
+   $ dingo fs quota report --fsname dingofs
+
+   # cron job which mails when any quota is above its threshold
+   $ dingo fs quota report --fsname dingofs > /tmp/quota.txt || mail -s "dingofs quota warning" admin@example.com < /tmp/quota.txt`
+)
+
+type reportOptions struct {
+	fsid   uint32
+	warnAt uint32
+	format string
+}
+
+// usage percentages are -1 if the limit is unlimited
+type QuotaReportItem struct {
+	Ino           uint64  `json:"ino"`
+	Path          string  `json:"path"`
+	MaxBytes      int64   `json:"max_bytes"`
+	UsedBytes     int64   `json:"used_bytes"`
+	BytesPercent  float64 `json:"bytes_percent"`
+	MaxInodes     int64   `json:"max_inodes"`
+	UsedInodes    int64   `json:"used_inodes"`
+	InodesPercent float64 `json:"inodes_percent"`
+	WarnAt        uint32  `json:"warn_at"`
+	DefaultWarnAt bool    `json:"default_warn_at"`
+}
+
+func NewQuotaReportCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options reportOptions
+
+	cmd := &cobra.Command{
+		Use:     "report [OPTIONS]",
+		Short:   "Report directory quotas above warning threshold",
+		Args:    utils.NoArgs,
+		Example: QUOTA_REPORT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+
+			value, _ := cmd.Flags().GetString(QUOTA_FLAG_WARN_AT)
+			if options.warnAt, err = parseWarnAt(value); err != nil {
+				return err
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runReport(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().String(QUOTA_FLAG_WARN_AT, QUOTA_DEFAULT_WARN_AT, "Threshold of quotas which have no threshold set by quota set")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// exits with error if any quota is above threshold, so it can be checked by scripts
+func runReport(cmd *cobra.Command, dingocli *cli.DingoCli, options reportOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+	quotas, err := LoadDirQuotas(cmd, options.fsid, epoch)
+	if err != nil {
+		return err
+	}
+	warns, err := getQuotaWarns(dingocli, options.fsid)
+	if err != nil {
+		return err
+	}
+
+	items := []*QuotaReportItem{}
+	for ino, quota := range quotas {
+		item := &QuotaReportItem{
+			Ino:           ino,
+			MaxBytes:      quota.GetMaxBytes(),
+			UsedBytes:     quota.GetUsedBytes(),
+			BytesPercent:  usagePercent(quota.GetUsedBytes(), quota.GetMaxBytes()),
+			MaxInodes:     quota.GetMaxInodes(),
+			UsedInodes:    quota.GetUsedInodes(),
+			InodesPercent: usagePercent(quota.GetUsedInodes(), quota.GetMaxInodes()),
+			WarnAt:        options.warnAt,
+			DefaultWarnAt: true,
+		}
+		if warn, ok := warns[ino]; ok {
+			item.WarnAt, item.DefaultWarnAt = warn.WarnAt, false
+		}
+		if math.Max(item.BytesPercent, item.InodesPercent) < float64(item.WarnAt) {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	// only paths of directories above threshold are resolved
+	inos := make([]uint64, 0, len(items))
+	for _, item := range items {
+		inos = append(inos, item.Ino)
+	}
+	dirPaths, err := resolveQuotaPaths(cmd, options.fsid, inos, epoch)
+	if err != nil {
+		return err
+	}
+	reported := make([]*QuotaReportItem, 0, len(items))
+	for _, item := range items {
+		if dirPath, ok := dirPaths[item.Ino]; ok { // directory may be deleted, not report
+			item.Path = dirPath
+			reported = append(reported, item)
+		}
+	}
+	items = reported
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Path < items[j].Path
+	})
+	if len(items) > 0 {
+		outputResult.Error = errno.ERR_QUOTA_ABOVE_WARNING
+	}
+	outputResult.Result = items
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_INODE_ID, common.ROW_PATH, common.ROW_CAPACITY, common.ROW_USED, common.ROW_USED_PERCNET,
+		common.ROW_INODES, common.ROW_INODES_IUSED, common.ROW_INODES_PERCENT, common.ROW_WARN_AT}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, item := range items {
+		values := utils.ConvertQuotaToHumanizeValue(uint64(item.MaxBytes), item.UsedBytes, uint64(item.MaxInodes), item.UsedInodes)
+		warnAt := fmt.Sprintf("%d%%", item.WarnAt)
+		if item.DefaultWarnAt {
+			warnAt += " (default)"
+		}
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", item.Ino),
+			item.Path,
+			values[0],
+			values[1],
+			values[2],
+			values[3],
+			values[4],
+			values[5],
+			warnAt,
+		})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no directory quota above warning threshold")
+
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+func usagePercent(used, limit int64) float64 {
+	if limit <= 0 || limit == math.MaxInt64 {
+		return -1
+	}
+	return float64(used) * 100.0 / float64(limit)
+}
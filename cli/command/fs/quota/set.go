@@ -33,7 +33,16 @@ import (
 
 const (
 	QUOTA_SET_EXAMPLE = `Examples:
-   $ dingo fs quota set --fsname dingofs --capacity 10 --inodes 1000000`
+   $ dingo fs quota set --fsname dingofs --capacity 10 --inodes 1000000
+
+   # sub directories of /projects get the quota, including ones created later by dingo fs quota inherit
+   $ dingo fs quota set --fsname dingofs --path /projects --capacity 100 --inodes 1000000 --inherit
+
+   # warn by dingo fs quota report when usage of capacity or inodes reaches 90%
+   $ dingo fs quota set --fsname dingofs --path /dir1 --capacity 10 --warn-at 90%
+
+   # only change warning threshold of an existing quota
+   $ dingo fs quota set --fsname dingofs --path /dir1 --warn-at 85%`
 )
 
 type setOptions struct {
@@ -42,6 +51,8 @@ type setOptions struct {
 	capacity int64
 	inodes   int64
 	threads  uint32
+	inherit  bool
+	warnAt   uint32
 	format   string
 }
 
@@ -69,11 +80,22 @@ func NewQuotaSetCommand(dingocli *cli.DingoCli) *cobra.Command {
 			}
 
 			options.path = utils.GetStringFlag(cmd, "path")
+			options.inherit, _ = cmd.Flags().GetBool(QUOTA_FLAG_INHERIT)
 
-			options.capacity, options.inodes, err = utils.GetQuotaValue(cmd)
+			options.warnAt, err = getWarnAt(cmd)
 			if err != nil {
 				return err
 			}
+			if options.warnAt > 0 && options.inherit {
+				return fmt.Errorf("--%s can not be used with --%s", QUOTA_FLAG_WARN_AT, QUOTA_FLAG_INHERIT)
+			}
+			// limits are kept if only warning threshold is set
+			if options.warnAt == 0 || cmd.Flag(utils.DINGOFS_QUOTA_CAPACITY).Changed || cmd.Flag(utils.DINGOFS_QUOTA_INODES).Changed {
+				options.capacity, options.inodes, err = utils.GetQuotaValue(cmd)
+				if err != nil {
+					return err
+				}
+			}
 
 			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
 
@@ -91,6 +113,8 @@ func NewQuotaSetCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.Flags().Uint64("capacity", 0, "Hard quota for usage space in GiB")
 	cmd.Flags().Uint64("inodes", 0, "Hard quota for inodes")
 	cmd.Flags().Uint32("threads", 8, "Number of threads calculate directory usage")
+	cmd.Flags().Bool(QUOTA_FLAG_INHERIT, false, "Set quota on sub directories instead, as template of new sub directories")
+	cmd.Flags().String(QUOTA_FLAG_WARN_AT, "", "Usage percentage of capacity or inodes to warn in quota report, e.g. 80%")
 	utils.AddStringRequiredFlag(cmd, "path", "full path of the directory within the volume")
 
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
@@ -110,11 +134,7 @@ func runSet(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions) erro
 	outputResult := &common.OutputResult{
 		Error: errno.ERR_OK,
 	}
-	// check flags values
-	maxBytes, maxInodes, quotaErr := utils.GetQuotaValue(cmd)
-	if quotaErr != nil {
-		return quotaErr
-	}
+	maxBytes, maxInodes := options.capacity, options.inodes
 	// get epoch id
 	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
 	if epochErr != nil {
@@ -131,19 +151,82 @@ func runSet(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions) erro
 	if inodeErr != nil {
 		return inodeErr
 	}
+
+	if options.inherit {
+		template := &QuotaTemplate{FsId: options.fsid, Path: options.path, MaxBytes: maxBytes, MaxInodes: maxInodes}
+		return runSetInherit(cmd, dingocli, template, options.threads, options.format)
+	}
+
+	if maxBytes == 0 && maxInodes == 0 {
+		return runSetWarn(cmd, dingocli, options, dirInodeId, epoch)
+	}
+
+	outputResult.Result, outputResult.Error = setDirQuota(cmd, options.fsid, dirInodeId, maxBytes, maxInodes, epoch, options.threads)
+	if options.warnAt > 0 && outputResult.Error.GetCode() == errno.ERR_OK.GetCode() && !dingocli.IsDryRun() {
+		warn := &QuotaWarn{FsId: options.fsid, Ino: dirInodeId, Path: options.path, WarnAt: options.warnAt}
+		if err := setQuotaWarn(dingocli, warn); err != nil {
+			outputResult.Error = errno.FromError(err)
+		}
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	fmt.Printf("Successfully set directory[%s] quota, capacity: %s, inodes: %s\n", options.path, humanize.IBytes(uint64(options.capacity)), humanize.Comma(options.inodes))
+	if options.warnAt > 0 {
+		fmt.Printf("Warning threshold: %d%%\n", options.warnAt)
+	}
+
+	return nil
+}
+
+// only record warning threshold, the directory must have a quota
+func runSetWarn(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions, dirInodeId uint64, epoch uint64) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	warn := &QuotaWarn{FsId: options.fsid, Ino: dirInodeId, Path: options.path, WarnAt: options.warnAt}
+	if _, _, err := GetDirQuotaData(cmd, options.fsid, dirInodeId, epoch); err != nil {
+		outputResult.Error = err
+	} else if !dingocli.IsDryRun() {
+		if err := setQuotaWarn(dingocli, warn); err != nil {
+			outputResult.Error = errno.FromError(err)
+		}
+	}
+	outputResult.Result = warn
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	fmt.Printf("Successfully set directory[%s] quota warning threshold: %d%%\n", options.path, options.warnAt)
+
+	return nil
+}
+
+// usage is counted by walking the directory, then set with limits
+func setDirQuota(cmd *cobra.Command, fsId uint32, dirInodeId uint64, maxBytes, maxInodes int64, epoch uint64, threads uint32) (interface{}, *errno.ErrorCode) {
 	endpoint := rpc.GetEndPoint(dirInodeId)
 	mdsRpc := rpc.CreateNewMdsRpcWithEndPoint(cmd, endpoint, "SetDirQuota")
 
 	// get real used space
-	dirUsedBytes, dirUsedInodes, getErr := rpc.GetDirectorySizeAndInodes(cmd, options.fsid, dirInodeId, false, epoch, options.threads)
+	dirUsedBytes, dirUsedInodes, getErr := rpc.GetDirectorySizeAndInodes(cmd, fsId, dirInodeId, false, epoch, threads)
 	if getErr != nil {
-		return getErr
+		return nil, errno.FromError(getErr)
 	}
 
 	// set request info
 	request := &mds.SetDirQuotaRequest{
 		Context: &mds.Context{Epoch: epoch},
-		FsId:    options.fsid,
+		FsId:    fsId,
 		Ino:     dirInodeId,
 		Quota:   &mds.Quota{MaxBytes: maxBytes, MaxInodes: maxInodes, UsedBytes: dirUsedBytes, UsedInodes: dirUsedInodes},
 	}
@@ -155,23 +238,11 @@ func runSet(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions) erro
 	// get rpc result
 	response, rpcError := rpc.GetRpcResponse(setRpc.Info, setRpc)
 	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
-		outputResult.Error = rpcError
-	} else {
-		result := response.(*mds.SetDirQuotaResponse)
-		if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
-			outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr.String())
-		}
-		outputResult.Result = result
-	}
-
-	// print result
-	if options.format == "json" {
-		return output.OutputJson(outputResult)
+		return nil, rpcError
 	}
-	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
-		return outputResult.Error
+	result := response.(*mds.SetDirQuotaResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return result, errno.ERR_RPC_FAILED.S(mdsErr.String())
 	}
-	fmt.Printf("Successfully set directory[%s] quota, capacity: %s, inodes: %s\n", options.path, humanize.IBytes(uint64(options.capacity)), humanize.Comma(options.inodes))
-
-	return nil
+	return result, errno.ERR_OK
 }
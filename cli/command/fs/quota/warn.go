@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quota
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+// warning thresholds are not known by mds, they are recorded in local storage like quota templates
+const (
+	QUOTA_FLAG_WARN_AT = "warn-at"
+
+	QUOTA_DEFAULT_WARN_AT = "80%"
+)
+
+type QuotaWarn struct {
+	FsId   uint32 `json:"fs_id"`
+	Ino    uint64 `json:"ino"`
+	Path   string `json:"path"`
+	WarnAt uint32 `json:"warn_at"`
+}
+
+// percentage of usage, e.g. 80% or 80
+func parseWarnAt(value string) (uint32, error) {
+	percent, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimSpace(value), "%"), 10, 32)
+	if err != nil || percent == 0 || percent > 100 {
+		return 0, fmt.Errorf("invalid --%s %s, should be a percentage in (0%%, 100%%]", QUOTA_FLAG_WARN_AT, value)
+	}
+	return uint32(percent), nil
+}
+
+// 0 if the flag is not set
+func getWarnAt(cmd *cobra.Command) (uint32, error) {
+	if !cmd.Flag(QUOTA_FLAG_WARN_AT).Changed {
+		return 0, nil
+	}
+	value, _ := cmd.Flags().GetString(QUOTA_FLAG_WARN_AT)
+	return parseWarnAt(value)
+}
+
+func quotaWarnId(fsId uint32, ino uint64) string {
+	return fmt.Sprintf("%d:%d", fsId, ino)
+}
+
+func setQuotaWarn(dingocli *cli.DingoCli, warn *QuotaWarn) error {
+	data, err := json.Marshal(warn)
+	if err != nil {
+		return err
+	}
+	if err := dingocli.Storage().SetQuotaWarn(quotaWarnId(warn.FsId, warn.Ino), string(data)); err != nil {
+		return errno.ERR_INSERT_QUOTA_WARN_FAILED.E(err)
+	}
+	return nil
+}
+
+func deleteQuotaWarn(dingocli *cli.DingoCli, fsId uint32, ino uint64) error {
+	if err := dingocli.Storage().DeleteQuotaWarn(quotaWarnId(fsId, ino)); err != nil {
+		return errno.ERR_DELETE_QUOTA_WARN_FAILED.E(err)
+	}
+	return nil
+}
+
+// thresholds of the fs by inode id of directory
+func getQuotaWarns(dingocli *cli.DingoCli, fsId uint32) (map[uint64]*QuotaWarn, error) {
+	items, err := dingocli.Storage().GetQuotaWarns()
+	if err != nil {
+		return nil, errno.ERR_SELECT_QUOTA_WARN_FAILED.E(err)
+	}
+	warns := map[uint64]*QuotaWarn{}
+	for _, item := range items {
+		warn := &QuotaWarn{}
+		if err := json.Unmarshal([]byte(item.Data), warn); err != nil {
+			logger.Warnf("invalid quota warning threshold %s: %v", item.Id, err)
+			continue
+		}
+		if warn.FsId == fsId {
+			warns[warn.Ino] = warn
+		}
+	}
+	return warns, nil
+}
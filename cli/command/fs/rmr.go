@@ -0,0 +1,296 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_RMR_EXAMPLE = `Examples:
+   $ dingo fs rmr --fsname dingofs1 /dir1
+
+   $ dingo fs rmr --fsname dingofs1 /dir1 --threads 32 --noconfirm`
+)
+
+type rmrOptions struct {
+	fsid      uint32
+	path      string
+	threads   uint32
+	noConfirm bool
+	format    string
+}
+
+type RmrResult struct {
+	Path      string `json:"path"`
+	Files     uint64 `json:"files"`
+	Dirs      uint64 `json:"dirs"`
+	TrashDays uint32 `json:"trash_days"`
+}
+
+// the tree is removed through mds directly instead of fuse, entries of a directory
+// are unlinked before the directory itself, sub directories are removed concurrently.
+// in dry-run mode entries are only counted, so a huge tree costs no memory
+type rmrWalker struct {
+	cmd        *cobra.Command
+	dryRun     bool
+	fsid       uint32
+	epoch      uint64
+	concurrent chan struct{}
+	spinner    *output.Progress
+
+	files uint64
+	dirs  uint64
+}
+
+func NewFsRmrCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options rmrOptions
+
+	cmd := &cobra.Command{
+		Use:     "rmr PATH [OPTIONS]",
+		Short:   "Remove a file or directory tree by mds, data is moved to trash if enabled",
+		Args:    utils.ExactArgs(1),
+		Example: FS_RMR_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			if options.path, err = rmrPath(args[0]); err != nil {
+				return err
+			}
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			options.noConfirm = utils.GetBoolFlag(cmd, utils.DINGOFS_NOCONFIRM)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runRmr(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+	utils.AddBoolFlag(cmd, utils.DINGOFS_NOCONFIRM, "Do not confirm the command")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runRmr(cmd *cobra.Command, dingocli *cli.DingoCli, options rmrOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+	if err != nil {
+		return err
+	}
+	// epoch + router
+	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if epochErr != nil {
+		return epochErr
+	}
+	if routerErr := rpc.InitFsMDSRouter(cmd, options.fsid); routerErr != nil {
+		return routerErr
+	}
+	ino, parent, fileType, resolveErr := rpc.ResolvePathInode(cmd, options.fsid, options.path, epoch)
+	if resolveErr != nil {
+		return fmt.Errorf("%s: %v", options.path, resolveErr)
+	}
+
+	prompt := rmrPrompt(options.path, fsInfo.GetFsName(), fsInfo.GetTrashDays())
+	if !options.noConfirm && !dingocli.IsDryRun() && !utils.AskConfirmation(prompt, options.path) {
+		return fmt.Errorf("abort remove %s", options.path)
+	}
+
+	walker := &rmrWalker{
+		cmd:        cmd,
+		dryRun:     dingocli.IsDryRun(),
+		fsid:       options.fsid,
+		epoch:      epoch,
+		concurrent: make(chan struct{}, options.threads),
+	}
+	if options.format != "json" {
+		walker.spinner = output.NewSpinner(fmt.Sprintf("Removing %s", options.path))
+	}
+	name := path.Base(options.path)
+	if fileType == mds.FileType_DIRECTORY {
+		err = walker.removeDir(parent, ino, name)
+	} else {
+		err = walker.removeFile(parent, name)
+	}
+	if walker.spinner != nil {
+		walker.spinner.Finish()
+	}
+	if err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	}
+	result := &RmrResult{
+		Path:      options.path,
+		Files:     atomic.LoadUint64(&walker.files),
+		Dirs:      atomic.LoadUint64(&walker.dirs),
+		TrashDays: fsInfo.GetTrashDays(),
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		fmt.Printf("%s files and %s directories removed before failure\n",
+			humanize.Comma(int64(result.Files)), humanize.Comma(int64(result.Dirs)))
+		return outputResult.Error
+	}
+
+	if dingocli.IsDryRun() {
+		fmt.Printf("[dry-run] %s files, %s directories of %s would be removed\n",
+			humanize.Comma(int64(result.Files)), humanize.Comma(int64(result.Dirs)), options.path)
+		return nil
+	}
+	fmt.Printf("Successfully remove %s: %s files, %s directories\n", options.path,
+		humanize.Comma(int64(result.Files)), humanize.Comma(int64(result.Dirs)))
+	return nil
+}
+
+// path in filesystem, relative path is under root, root can not be removed
+func rmrPath(arg string) (string, error) {
+	fsPath := path.Clean("/" + arg)
+	if fsPath == "/" {
+		return "", fmt.Errorf("root directory can not be removed")
+	}
+	return fsPath, nil
+}
+
+// unlinked files are kept in trash by mds if trash is enabled
+func rmrPrompt(fsPath, fsName string, trashDays uint32) string {
+	if trashDays == 0 {
+		return fmt.Sprintf("Are you sure to remove %s of fs %s? trash is disabled, data can NOT be recovered!",
+			fsPath, fsName)
+	}
+	return fmt.Sprintf("Are you sure to remove %s of fs %s? files are moved to trash and kept for %d days",
+		fsPath, fsName, trashDays)
+}
+
+func (w *rmrWalker) removeFile(parent uint64, name string) error {
+	if !w.dryRun {
+		if err := rpc.DeleteFile(w.cmd, w.fsid, parent, name, w.epoch); err != nil {
+			return fmt.Errorf("unlink %s of inode %d: %v", name, parent, err)
+		}
+	}
+	atomic.AddUint64(&w.files, 1)
+	if w.spinner != nil {
+		w.spinner.Add64(1)
+	}
+	return nil
+}
+
+func (w *rmrWalker) removeDir(parent uint64, ino uint64, name string) error {
+	entries, err := rpc.ListDentry(w.cmd, w.fsid, ino, w.epoch)
+	if err != nil {
+		return err
+	}
+
+	// the first error of sub directories is kept, no more of them are started after it
+	var wg sync.WaitGroup
+	var errMutex sync.Mutex
+	var removeErr error
+	failed := func() bool {
+		errMutex.Lock()
+		defer errMutex.Unlock()
+		return removeErr != nil
+	}
+	for _, entry := range entries {
+		if failed() {
+			break
+		}
+		if entry.GetType() != mds.FileType_DIRECTORY {
+			if err := w.removeFile(ino, entry.GetName()); err != nil {
+				wg.Wait()
+				return err
+			}
+			continue
+		}
+		select {
+		case w.concurrent <- struct{}{}:
+			wg.Add(1)
+			go func(e *mds.Dentry) {
+				defer wg.Done()
+				defer func() { <-w.concurrent }()
+				if err := w.removeDir(ino, e.GetIno(), e.GetName()); err != nil {
+					errMutex.Lock()
+					if removeErr == nil {
+						removeErr = err
+					}
+					errMutex.Unlock()
+				}
+			}(entry)
+		default:
+			// all threads are busy, remove in current one
+			if err := w.removeDir(ino, entry.GetIno(), entry.GetName()); err != nil {
+				wg.Wait()
+				return err
+			}
+		}
+	}
+	wg.Wait()
+	if removeErr != nil {
+		return removeErr
+	}
+
+	if !w.dryRun {
+		if err := rpc.DeleteDirectory(w.cmd, w.fsid, parent, name, w.epoch); err != nil {
+			return fmt.Errorf("rmdir %s of inode %d: %v", name, parent, err)
+		}
+	}
+	atomic.AddUint64(&w.dirs, 1)
+	if w.spinner != nil {
+		w.spinner.Add64(1)
+	}
+	return nil
+}
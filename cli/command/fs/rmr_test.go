@@ -0,0 +1,52 @@
+package fs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRmrPath(t *testing.T) {
+	tests := []struct {
+		arg  string
+		path string
+		err  bool
+	}{
+		{arg: "/dir1", path: "/dir1"},
+		{arg: "dir1/dir2/", path: "/dir1/dir2"},
+		{arg: "/dir1/../dir2", path: "/dir2"},
+		{arg: "/", err: true},
+		{arg: "", err: true},
+		{arg: "/dir1/..", err: true},
+		{arg: "../..", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			fsPath, err := rmrPath(tt.arg)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.path, fsPath)
+		})
+	}
+}
+
+func TestRmrPrompt(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("Are you sure to remove /dir1 of fs dingofs1? files are moved to trash and kept for 7 days",
+		rmrPrompt("/dir1", "dingofs1", 7))
+	assert.Equal("Are you sure to remove /dir1 of fs dingofs1? trash is disabled, data can NOT be recovered!",
+		rmrPrompt("/dir1", "dingofs1", 0))
+}
+
+// no rpc is sent in dry-run, the walker has no command to send it
+func TestRmrRemoveFileDryRun(t *testing.T) {
+	assert := assert.New(t)
+	walker := &rmrWalker{dryRun: true}
+	assert.NoError(walker.removeFile(1, "file1"))
+	assert.NoError(walker.removeFile(1, "file2"))
+	assert.Equal(uint64(2), walker.files)
+	assert.Equal(uint64(0), walker.dirs)
+}
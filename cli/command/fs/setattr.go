@@ -0,0 +1,257 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+// shared by chown and chmod
+type setattrOptions struct {
+	fsid      uint32
+	path      string
+	recursive bool
+	threads   uint32
+	format    string
+}
+
+type SetattrResult struct {
+	Path    string `json:"path"`
+	Files   uint64 `json:"files"`
+	Dirs    uint64 `json:"dirs"`
+	Skipped uint64 `json:"skipped"`
+	DryRun  bool   `json:"dry_run"`
+}
+
+// setattr of an entry, entries of skipped types are left unchanged, e.g. symlink for chmod
+type setattrFunc func(ino uint64, parent uint64, fileType mds.FileType, epoch uint64) error
+
+// the tree is walked and changed through mds directly instead of fuse,
+// in dry-run mode entries are only counted
+type setattrWalker struct {
+	cmd        *cobra.Command
+	fsid       uint32
+	epoch      uint64
+	dryRun     bool
+	concurrent chan struct{}
+	spinner    *output.Progress
+	fn         setattrFunc
+	skipTypes  []mds.FileType
+
+	files   uint64
+	dirs    uint64
+	skipped uint64
+}
+
+func addSetattrFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().BoolP("recursive", "R", false, "Change the whole directory tree")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+}
+
+func getSetattrOptions(cmd *cobra.Command, fsPath string) (setattrOptions, error) {
+	utils.ReadCommandConfig(cmd)
+	output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+	options := setattrOptions{}
+	fsid, err := rpc.GetFsId(cmd)
+	if err != nil {
+		return options, err
+	}
+	options.fsid = fsid
+	options.path = path.Clean("/" + fsPath)
+	options.recursive = utils.GetBoolFlag(cmd, "recursive")
+	options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+	options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+	return options, nil
+}
+
+func runSetattr(cmd *cobra.Command, dingocli *cli.DingoCli, options setattrOptions, action cli.Action,
+	skipTypes []mds.FileType, fn setattrFunc) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	// epoch + router
+	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if epochErr != nil {
+		return epochErr
+	}
+	if routerErr := rpc.InitFsMDSRouter(cmd, options.fsid); routerErr != nil {
+		return routerErr
+	}
+	ino, parent, fileType, resolveErr := rpc.ResolvePathInode(cmd, options.fsid, options.path, epoch)
+	if resolveErr != nil {
+		return fmt.Errorf("%s: %v", options.path, resolveErr)
+	}
+
+	walker := &setattrWalker{
+		cmd:        cmd,
+		fsid:       options.fsid,
+		epoch:      epoch,
+		dryRun:     dingocli.IsDryRun(),
+		concurrent: make(chan struct{}, options.threads),
+		fn:         fn,
+		skipTypes:  skipTypes,
+	}
+	// the action is reported once in dry-run mode, entries are counted by walker
+	dingocli.Perform(action, func() error { return nil })
+	if options.format != "json" && options.recursive {
+		walker.spinner = output.NewSpinner(fmt.Sprintf("Changing %s", options.path))
+	}
+	var err error
+	if options.recursive && fileType == mds.FileType_DIRECTORY {
+		err = walker.walk(ino, parent)
+	} else {
+		err = walker.apply(ino, parent, fileType)
+	}
+	if walker.spinner != nil {
+		walker.spinner.Finish()
+	}
+	if err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	}
+	result := &SetattrResult{
+		Path:    options.path,
+		Files:   atomic.LoadUint64(&walker.files),
+		Dirs:    atomic.LoadUint64(&walker.dirs),
+		Skipped: atomic.LoadUint64(&walker.skipped),
+		DryRun:  walker.dryRun,
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	verb := "Successfully change"
+	if walker.dryRun {
+		verb = "Would change"
+	}
+	fmt.Printf("%s %s: %s files, %s directories, %s skipped\n", verb, options.path,
+		humanize.Comma(int64(result.Files)), humanize.Comma(int64(result.Dirs)), humanize.Comma(int64(result.Skipped)))
+	return nil
+}
+
+func (w *setattrWalker) apply(ino uint64, parent uint64, fileType mds.FileType) error {
+	skipped := false
+	for _, skipType := range w.skipTypes {
+		skipped = skipped || fileType == skipType
+	}
+	if !skipped && !w.dryRun {
+		if err := w.fn(ino, parent, fileType, w.epoch); err != nil {
+			return fmt.Errorf("setattr of inode %d: %v", ino, err)
+		}
+	}
+	switch {
+	case skipped:
+		atomic.AddUint64(&w.skipped, 1)
+	case fileType == mds.FileType_DIRECTORY:
+		atomic.AddUint64(&w.dirs, 1)
+	default:
+		atomic.AddUint64(&w.files, 1)
+	}
+	if w.spinner != nil {
+		w.spinner.Add64(1)
+	}
+	return nil
+}
+
+func (w *setattrWalker) walk(ino uint64, parent uint64) error {
+	if err := w.apply(ino, parent, mds.FileType_DIRECTORY); err != nil {
+		return err
+	}
+	entries, err := rpc.ListDentry(w.cmd, w.fsid, ino, w.epoch)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var errMutex sync.Mutex
+	var walkErr error
+	for _, entry := range entries {
+		if entry.GetType() != mds.FileType_DIRECTORY {
+			if err := w.apply(entry.GetIno(), ino, entry.GetType()); err != nil {
+				wg.Wait()
+				return err
+			}
+			continue
+		}
+		select {
+		case w.concurrent <- struct{}{}:
+			wg.Add(1)
+			go func(child uint64) {
+				defer wg.Done()
+				defer func() { <-w.concurrent }()
+				if err := w.walk(child, ino); err != nil {
+					errMutex.Lock()
+					walkErr = err
+					errMutex.Unlock()
+				}
+			}(entry.GetIno())
+		default:
+			// all threads are busy, walk in current one
+			if err := w.walk(entry.GetIno(), ino); err != nil {
+				wg.Wait()
+				return err
+			}
+		}
+	}
+	wg.Wait()
+	return walkErr
+}
+
+// mode of inode keeps the file type bits
+func fileTypeMode(fileType mds.FileType) uint32 {
+	switch fileType {
+	case mds.FileType_DIRECTORY:
+		return syscall.S_IFDIR
+	case mds.FileType_SYM_LINK:
+		return syscall.S_IFLNK
+	default:
+		return syscall.S_IFREG
+	}
+}
@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_SLOWLOG_EXAMPLE = `Examples:
+   $ dingo fs slowlog --fsname dingofs1 --since 1h --threshold 500ms
+
+   # slowest directories of depth 3
+   $ dingo fs slowlog --fsname dingofs1 --depth 3 --top 10`
+
+	SLOWLOG_SOURCE_CLIENT = "client"
+)
+
+type slowlogOptions struct {
+	fsname    string
+	since     time.Time
+	threshold time.Duration
+	depth     int
+	top       int
+	format    string
+}
+
+// an operation slower than threshold, source is the mountpoint recording it
+type SlowOp struct {
+	Source  string        `json:"source"`
+	Time    time.Time     `json:"time"`
+	Op      string        `json:"op"`
+	Path    string        `json:"path"`
+	Latency time.Duration `json:"latency"`
+}
+
+// slow operations of an op under a path prefix, latencies are in milliseconds
+type SlowOpGroup struct {
+	Op         string  `json:"op"`
+	PathPrefix string  `json:"path_prefix"`
+	Count      int     `json:"count"`
+	Total      float64 `json:"total_ms"`
+	Avg        float64 `json:"avg_ms"`
+	P99        float64 `json:"p99_ms"`
+	Max        float64 `json:"max_ms"`
+	latencies  []time.Duration
+}
+
+type SlowlogReport struct {
+	FsName    string            `json:"fs_name"`
+	Since     time.Time         `json:"since"`
+	Threshold string            `json:"threshold"`
+	Records   int               `json:"records"`
+	Groups    []*SlowOpGroup    `json:"groups"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+// answer of slowlog by client admin socket
+type clientSlowlog struct {
+	Ops []struct {
+		TimeMs    int64  `json:"time_ms"`
+		Op        string `json:"op"`
+		Path      string `json:"path"`
+		LatencyUs int64  `json:"latency_us"`
+	} `json:"ops"`
+}
+
+func NewFsSlowlogCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options slowlogOptions
+
+	cmd := &cobra.Command{
+		Use:     "slowlog [OPTIONS]",
+		Short:   "Report slow operations recorded by clients, aggregated by operation and path prefix",
+		Args:    utils.NoArgs,
+		Example: FS_SLOWLOG_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			var err error
+			if options.fsname, err = rpc.GetFsName(cmd); err != nil {
+				return err
+			}
+
+			since, _ := cmd.Flags().GetString("since")
+			if options.since, err = component.ParseSince(since); err != nil {
+				return err
+			}
+			options.threshold, _ = cmd.Flags().GetDuration("threshold")
+			options.depth, _ = cmd.Flags().GetInt("depth")
+			options.top, _ = cmd.Flags().GetInt("top")
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runSlowlog(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().String("since", "1h", "Report operations since the time, a date (e.g. 2026-01-01) or a duration relative to now (e.g. 24h)")
+	cmd.Flags().Duration("threshold", 500*time.Millisecond, "Operations slower than it are reported")
+	cmd.Flags().Int("depth", 2, "Number of path components operations are aggregated by")
+	cmd.Flags().Int("top", 20, "Number of the slowest groups to show (0 means all)")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// mds records no slow operations which can be pulled, so they are pulled from
+// the mountpoints of this host, those failing are reported as errors
+func runSlowlog(cmd *cobra.Command, dingocli *cli.DingoCli, options slowlogOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	report := &SlowlogReport{
+		FsName:    options.fsname,
+		Since:     options.since,
+		Threshold: options.threshold.String(),
+		Groups:    []*SlowOpGroup{},
+	}
+	ops, errs := clientSlowOps(options)
+	report.Errors = errs
+	report.Records = len(ops)
+	report.Groups = groupSlowOps(ops, options.depth)
+	if options.top > 0 && options.top < len(report.Groups) {
+		report.Groups = report.Groups[:options.top]
+	}
+	outputResult.Result = report
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	for name, err := range report.Errors {
+		fmt.Fprintf(os.Stderr, "pull slow operations from %s failed: %s\n", name, err)
+	}
+	header := []string{common.ROW_OP, common.ROW_PATH_PREFIX, common.ROW_COUNT, common.ROW_TOTAL,
+		common.ROW_AVG_LATENCY, common.ROW_P99_LATENCY, common.ROW_MAX_LATENCY}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, group := range report.Groups {
+		rows = append(rows, []string{
+			group.Op,
+			group.PathPrefix,
+			fmt.Sprintf("%d", group.Count),
+			fmt.Sprintf("%.3f ms", group.Total),
+			fmt.Sprintf("%.3f ms", group.Avg),
+			fmt.Sprintf("%.3f ms", group.P99),
+			fmt.Sprintf("%.3f ms", group.Max),
+		})
+	}
+	// groups are sorted by time spent
+	table.AppendBulk(rows)
+	table.RenderWithNoData(fmt.Sprintf("no operation slower than %s since %s", report.Threshold, options.since.Format(time.DateTime)))
+	if len(report.Groups) > 0 {
+		fmt.Printf("%d operations slower than %s since %s\n", report.Records, report.Threshold, options.since.Format(time.DateTime))
+	}
+
+	return nil
+}
+
+// slow operations of mountpoints of the filesystem on this host, by client admin socket
+func clientSlowOps(options slowlogOptions) ([]*SlowOp, map[string]string) {
+	errs := map[string]string{}
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		errs[SLOWLOG_SOURCE_CLIENT] = err.Error()
+		return nil, errs
+	}
+	args := map[string]string{
+		"since_ms":     fmt.Sprintf("%d", options.since.UnixMilli()),
+		"threshold_us": fmt.Sprintf("%d", options.threshold.Microseconds()),
+	}
+	ops := []*SlowOp{}
+	for _, mountpoint := range mountpoints {
+		if utils.MountPointFsName(mountpoint) != options.fsname {
+			continue
+		}
+		source := "client " + mountpoint.MountPoint
+		admin, err := utils.DialAdmin(mountpoint.MountPoint)
+		if err != nil {
+			errs[source] = err.Error()
+			continue
+		}
+		result := &clientSlowlog{}
+		err = admin.Call(utils.ADMIN_COMMAND_SLOWLOG, args, result)
+		admin.Close()
+		if err != nil {
+			errs[source] = err.Error()
+			continue
+		}
+		for _, item := range result.Ops {
+			ops = append(ops, &SlowOp{
+				Source:  source,
+				Time:    time.UnixMilli(item.TimeMs),
+				Op:      item.Op,
+				Path:    item.Path,
+				Latency: time.Duration(item.LatencyUs) * time.Microsecond,
+			})
+		}
+	}
+	return ops, errs
+}
+
+// first depth components of the path, "-" for operations without path, e.g. statfs
+func slowOpPathPrefix(opPath string, depth int) string {
+	if opPath == "" {
+		return common.ROW_VALUE_NO_VALUE
+	}
+	components := strings.Split(strings.Trim(path.Clean("/"+opPath), "/"), "/")
+	if depth > 0 && len(components) > depth {
+		components = components[:depth]
+	}
+	return "/" + strings.Join(components, "/")
+}
+
+// groups are sorted by total latency, so the top offenders come first
+func groupSlowOps(ops []*SlowOp, depth int) []*SlowOpGroup {
+	groups := map[string]*SlowOpGroup{}
+	for _, op := range ops {
+		prefix := slowOpPathPrefix(op.Path, depth)
+		key := op.Op + " " + prefix
+		group, ok := groups[key]
+		if !ok {
+			group = &SlowOpGroup{Op: op.Op, PathPrefix: prefix}
+			groups[key] = group
+		}
+		group.latencies = append(group.latencies, op.Latency)
+	}
+
+	result := make([]*SlowOpGroup, 0, len(groups))
+	for _, group := range groups {
+		sort.Slice(group.latencies, func(i, j int) bool { return group.latencies[i] < group.latencies[j] })
+		var total time.Duration
+		for _, latency := range group.latencies {
+			total += latency
+		}
+		group.Count = len(group.latencies)
+		group.Total = milliseconds(total)
+		group.Avg = group.Total / float64(group.Count)
+		group.P99 = milliseconds(group.latencies[(group.Count*99-1)/100])
+		group.Max = milliseconds(group.latencies[group.Count-1])
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		return result[i].Op+result[i].PathPrefix < result[j].Op+result[j].PathPrefix
+	})
+	return result
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -17,6 +17,7 @@
 package fs
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -30,14 +31,20 @@ import (
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/output"
 	"github.com/dingodb/dingocli/internal/utils"
-	"github.com/mattn/go-isatty"
 
 	"github.com/spf13/cobra"
 )
 
 const (
 	STATS_MOUNTPOINT_EXAMPLE = `Examples:
-   $ dingo fs stats /mnt/dingofs`
+   $ dingo fs stats /mnt/dingofs
+
+   # export to csv every 5 seconds for an hour
+   $ dingo fs stats /mnt/dingofs --interval 5s --count 720 --format csv --output-file stats.csv`
+
+	STATS_FORMAT_PLAIN = "plain"
+	STATS_FORMAT_CSV   = "csv"
+	STATS_TIME_FORMAT  = "2006-01-02 15:04:05"
 )
 
 // colors
@@ -96,6 +103,7 @@ type statsWatcher struct {
 	sections   []*section
 	cpuUsage   float64
 	count      uint32
+	csv        bool
 }
 
 type statsOptions struct {
@@ -104,6 +112,7 @@ type statsOptions struct {
 	interval   time.Duration
 	count      uint32
 	verbose    bool
+	format     string
 }
 
 // set logout to stdout
@@ -135,11 +144,15 @@ func NewStatsCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.Flags().StringVar(&options.schema, "schema", "ufbor", `Schema string that controls the output sections (u: usage, f: fuse, b: blockcache, o: object, r:remotecache) (default "ufbor")"`)
 	cmd.Flags().Uint32VarP(&options.count, "count", "c", 0, "Max outout count(0 is unlimited)")
 	cmd.Flags().BoolVarP(&options.verbose, "verbose", "v", false, "Show more info")
+	cmd.Flags().StringVar(&options.format, "format", STATS_FORMAT_PLAIN, "Output format (plain|csv), csv has a row of raw values for every interval")
 
 	return cmd
 }
 
 func runStats(cmd *cobra.Command, dingocli *cli.DingoCli, options statsOptions) error {
+	if options.format != STATS_FORMAT_PLAIN && options.format != STATS_FORMAT_CSV {
+		return fmt.Errorf("invalid format %s, only plain and csv are supported", options.format)
+	}
 
 	realTimeStats(options)
 
@@ -187,10 +200,14 @@ func (w *statsWatcher) buildSchema(schema string, verbose bool) {
 			s.items = append(s.items, &item{"get", "dingofs_block_read_block_bps_total_count", metricByte | metricCounter})
 			if verbose {
 				s.items = append(s.items, &item{"ops", "dingofs_block_read_block", metricTime | metricHist})
+			} else {
+				s.items = append(s.items, &item{"ops", "dingofs_block_read_block_qps_total_count", metricCount | metricCounter})
 			}
 			s.items = append(s.items, &item{"put", "dingofs_block_write_block_bps_total_count", metricByte | metricCounter})
 			if verbose {
 				s.items = append(s.items, &item{"ops", "dingofs_block_write_block", metricTime | metricHist})
+			} else {
+				s.items = append(s.items, &item{"ops", "dingofs_block_write_block_qps_total_count", metricCount | metricCounter})
 			}
 		case 'r':
 			s.name = "remotecache"
@@ -328,19 +345,35 @@ func (w *statsWatcher) formatHits(v float64, dark bool) string {
 
 // read metric data from file
 func readStats(mp string) map[string]float64 {
+	metricDataMap, err := readStatsFile(mp)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	return metricDataMap
+}
+
+// metrics by admin socket of the client, or .stats file for clients without it
+func readStatsFile(mp string) (map[string]float64, error) {
+	admin, err := utils.DialAdmin(mp)
+	if err == nil {
+		defer admin.Close()
+		metricDataMap := make(map[string]float64)
+		if err := admin.Call(utils.ADMIN_COMMAND_STATS, nil, &metricDataMap); err != nil {
+			return nil, fmt.Errorf("get stats of mount point %s: %s", mp, err)
+		}
+		return metricDataMap, nil
+	} else if !errors.Is(err, utils.ErrAdminSocketNotFound) {
+		return nil, err
+	}
 
 	f, err := os.Open(filepath.Join(mp, ".stats"))
 	if err != nil {
-		log.Fatalf("open stats file under mount point %s: %s", mp, err)
+		return nil, fmt.Errorf("open stats file under mount point %s: %s", mp, err)
 	}
 	defer f.Close()
 	data, err := io.ReadAll(f)
 	if err != nil {
-		log.Fatalf("read stats file under mount point %s: %s", mp, err)
-	}
-
-	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("read stats file under mount point %s: %s", mp, err)
 	}
 
 	outstr := strings.ReplaceAll(string(data), "\r", "")
@@ -359,65 +392,87 @@ func readStats(mp string) map[string]float64 {
 			metricDataMap[fields[0]] = v
 		}
 	}
-	return metricDataMap
+	return metricDataMap, nil
+}
+
+// values of an item between two samples, hist item has count and average
+func (w *statsWatcher) diffValues(it *item, left, right map[string]float64, dark bool) []float64 {
+	switch it.typ & 0xF0 {
+	case metricGauge: // show current value
+		return []float64{right[it.name]}
+	case metricCounter:
+		v := (right[it.name] - left[it.name])
+		if !dark {
+			v /= float64(w.interval)
+		}
+		if it.typ&metricCPU != 0 {
+			v = right[it.name] //reset value to current for cpu
+			w.cpuUsage += v
+			if !dark {
+				v = w.cpuUsage
+				v /= float64(w.interval)
+				w.cpuUsage = 0.0
+			}
+		}
+		return []float64{v}
+	case metricHist: // metricTime
+		count := right[it.name+"_qps_total_count"] - left[it.name+"_qps_total_count"]
+		var avg float64
+		if count > 0.0 {
+			latency := right[it.name+"_lat_total_value"] - left[it.name+"_lat_total_value"]
+			if it.typ&metricTime != 0 {
+				latency /= 1000 //us -> ms
+			}
+			avg = latency / count
+		}
+		if !dark {
+			count /= float64(w.interval)
+		}
+		return []float64{count, avg}
+	case metricHit: // metricHits
+		hitCount := right[it.name+"_hit_count"] - left[it.name+"_hit_count"]
+		missCount := right[it.name+"_miss_count"] - left[it.name+"_miss_count"]
+		totalCount := hitCount + missCount
+		var avg float64
+		if totalCount > 0.0 {
+			avg = hitCount / totalCount
+		}
+		return []float64{avg}
+	}
+	return nil
 }
 
 func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
-	if !w.colorful && dark {
+	if !w.colorful && dark { // middle result is not shown, but cpu usage is still accumulated
+		for _, s := range w.sections {
+			for _, it := range s.items {
+				w.diffValues(it, left, right, dark)
+			}
+		}
 		return
 	}
 	values := make([]string, len(w.sections))
 	for i, s := range w.sections {
 		vals := make([]string, 0, len(s.items))
 		for _, it := range s.items {
+			v := w.diffValues(it, left, right, dark)
 			switch it.typ & 0xF0 {
-			case metricGauge: // show current value
-				vals = append(vals, w.formatU64(right[it.name], dark, true))
+			case metricGauge:
+				vals = append(vals, w.formatU64(v[0], dark, true))
 			case metricCounter:
-				v := (right[it.name] - left[it.name])
-				if !dark {
-					v /= float64(w.interval)
-				}
 				if it.typ&metricByte != 0 {
-					vals = append(vals, w.formatU64(v, dark, true))
+					vals = append(vals, w.formatU64(v[0], dark, true))
 				} else if it.typ&metricCPU != 0 {
-					v = right[it.name] //reset value to current for cpu
-					w.cpuUsage += v
-					if !dark {
-						v = w.cpuUsage
-						v /= float64(w.interval)
-						w.cpuUsage = 0.0
-					}
-					vals = append(vals, w.formatCPU(v, dark))
+					vals = append(vals, w.formatCPU(v[0], dark))
 				} else if it.typ&metricTime != 0 {
-					vals = append(vals, w.formatTime(v, dark))
+					vals = append(vals, w.formatTime(v[0], dark))
 				} else { // metricCount
-					vals = append(vals, w.formatU64(v, dark, false))
-				}
-			case metricHist: // metricTime
-				count := right[it.name+"_qps_total_count"] - left[it.name+"_qps_total_count"]
-				var avg float64
-				if count > 0.0 {
-					latency := right[it.name+"_lat_total_value"] - left[it.name+"_lat_total_value"]
-					if it.typ&metricTime != 0 {
-						latency /= 1000 //us -> ms
-					}
-					avg = latency / count
-				}
-				if !dark {
-					count /= float64(w.interval)
+					vals = append(vals, w.formatU64(v[0], dark, false))
 				}
-				vals = append(vals, w.formatU64(count, dark, false), w.formatTime(avg, dark))
-
-			case metricHit: // metricHits
-				hitCount := right[it.name+"_hit_count"] - left[it.name+"_hit_count"]
-				missCount := right[it.name+"_miss_count"] - left[it.name+"_miss_count"]
-				totalCount := hitCount + missCount
-				var avg float64
-				if totalCount > 0.0 {
-					avg = hitCount / totalCount
-				}
-				vals = append(vals, w.formatHits(avg, dark))
+			case metricHist:
+				vals = append(vals, w.formatU64(v[0], dark, false), w.formatTime(v[1], dark))
+			case metricHit:
+				vals = append(vals, w.formatHits(v[0], dark))
 			}
 		}
 		values[i] = strings.Join(vals, " ")
@@ -429,6 +484,50 @@ func (w *statsWatcher) printDiff(left, right map[string]float64, dark bool) {
 	}
 }
 
+// columns are named section.nick, e.g. fuse.read, nick repeated in the section is prefixed
+// by nick of the previous item, e.g. object.get_ops, cpu and hit rate are in percent,
+// latency in ms, others are per second or current value
+func (w *statsWatcher) csvHeader() string {
+	columns := []string{"time"}
+	for _, s := range w.sections {
+		nicks := map[string]int{}
+		for _, it := range s.items {
+			nicks[it.nick]++
+		}
+		for i, it := range s.items {
+			name := s.name + "." + it.nick
+			if nicks[it.nick] > 1 && i > 0 {
+				name = s.name + "." + s.items[i-1].nick + "_" + it.nick
+			}
+			columns = append(columns, name)
+			if it.typ&metricHist != 0 {
+				columns = append(columns, name+"_lat")
+			}
+		}
+	}
+	return strings.Join(columns, ",")
+}
+
+func (w *statsWatcher) printCSV(left, right map[string]float64) {
+	columns := []string{time.Now().Format(STATS_TIME_FORMAT)}
+	for _, s := range w.sections {
+		for _, it := range s.items {
+			v := w.diffValues(it, left, right, false)
+			switch {
+			case it.typ&metricHist != 0:
+				columns = append(columns, fmt.Sprintf("%.0f", v[0]), fmt.Sprintf("%.2f", v[1]))
+			case it.typ&(metricCPU|metricHit) != 0:
+				columns = append(columns, fmt.Sprintf("%.1f", v[0]*100.0))
+			case it.typ&metricTime != 0:
+				columns = append(columns, fmt.Sprintf("%.2f", v[0]))
+			default:
+				columns = append(columns, fmt.Sprintf("%.0f", v[0]))
+			}
+		}
+	}
+	fmt.Println(strings.Join(columns, ","))
+}
+
 // real time read metric data and show in client
 func realTimeStats(options statsOptions) {
 	inode, err := utils.GetFileInode(options.mountpoint)
@@ -439,12 +538,13 @@ func realTimeStats(options statsOptions) {
 		log.Fatalf("invalid dingofs mountpoint: %s", options.interval)
 	}
 	watcher := &statsWatcher{
-		colorful:   isatty.IsTerminal(os.Stdout.Fd()),
+		colorful:   output.ColorEnabled() && options.format != STATS_FORMAT_CSV,
 		duration:   options.interval,
 		mountPoint: options.mountpoint,
 		interval:   int64(options.interval) / 1000000000,
 		cpuUsage:   0.0,
 		count:      options.count,
+		csv:        options.format == STATS_FORMAT_CSV,
 	}
 	watcher.buildSchema(options.schema, options.verbose)
 	watcher.formatHeader()
@@ -456,12 +556,19 @@ func realTimeStats(options statsOptions) {
 	current = readStats(watcher.mountPoint)
 	start = current
 	last = current
+	if watcher.csv {
+		fmt.Println(watcher.csvHeader())
+	}
 	for {
-		if tick%(uint(watcher.interval)*30) == 0 {
+		if !watcher.csv && tick%(uint(watcher.interval)*30) == 0 {
 			fmt.Println(watcher.header)
 		}
 		if tick%uint(watcher.interval) == 0 {
-			watcher.printDiff(start, current, false)
+			if watcher.csv {
+				watcher.printCSV(start, current)
+			} else {
+				watcher.printDiff(start, current, false)
+			}
 			start = current
 		} else {
 			watcher.printDiff(last, current, true)
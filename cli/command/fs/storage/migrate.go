@@ -0,0 +1,367 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	STORAGE_MIGRATE_EXAMPLE = `Examples:
+   # copy objects while fs is mounted, objects already copied are skipped when rerun
+   $ dingo fs storage migrate --fsname dingofs1 --to-endpoint http://10.0.0.2:9000 --to-bucket dingofs1-new --no-switch
+
+   # copy remaining objects after all clients are umounted and switch storage of fs
+   $ dingo fs storage migrate --fsname dingofs1 --to-endpoint http://10.0.0.2:9000 --to-bucket dingofs1-new
+
+   # from s3 to rados
+   $ dingo fs storage migrate --fsname dingofs1 --to-rados-mon 10.0.0.3:3300 --to-rados-username admin --to-rados-key AQDg3Y2h --to-rados-pool pool1`
+
+	MIGRATE_FLAG_ENDPOINT       = "to-endpoint"
+	MIGRATE_FLAG_BUCKET         = "to-bucket"
+	MIGRATE_FLAG_AK             = "to-ak"
+	MIGRATE_FLAG_SK             = "to-sk"
+	MIGRATE_FLAG_RADOS_MON      = "to-rados-mon"
+	MIGRATE_FLAG_RADOS_USERNAME = "to-rados-username"
+	MIGRATE_FLAG_RADOS_KEY      = "to-rados-key"
+	MIGRATE_FLAG_RADOS_POOL     = "to-rados-pool"
+	MIGRATE_FLAG_RADOS_CLUSTER  = "to-rados-cluster"
+	MIGRATE_FLAG_NO_SWITCH      = "no-switch"
+
+	MIGRATE_DEFAULT_RADOS_CLUSTER = "ceph"
+)
+
+type migrateOptions struct {
+	fsid     uint32
+	fsname   string
+	target   *mds.FsExtra
+	noSwitch bool
+	threads  uint32
+	format   string
+}
+
+type MigrateResult struct {
+	FsId   uint32 `json:"fs_id"`
+	FsName string `json:"fs_name"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	// objects of source, objects already in target are skipped
+	Objects  int64 `json:"objects"`
+	Skipped  int64 `json:"skipped"`
+	Copied   int64 `json:"copied"`
+	Failed   int64 `json:"failed"`
+	Switched bool  `json:"switched"`
+}
+
+func NewStorageMigrateCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options migrateOptions
+
+	cmd := &cobra.Command{
+		Use:     "migrate [OPTIONS]",
+		Short:   "Copy objects of filesystem to new storage and switch to it",
+		Args:    utils.NoArgs,
+		Example: STORAGE_MIGRATE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			fsname, err := rpc.GetFsName(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsname = fsname
+
+			if options.target, err = getMigrateTarget(cmd); err != nil {
+				return err
+			}
+			options.noSwitch = utils.GetBoolFlag(cmd, MIGRATE_FLAG_NO_SWITCH)
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runMigrate(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	cmd.Flags().String(MIGRATE_FLAG_ENDPOINT, "", "Endpoint of target s3")
+	cmd.Flags().String(MIGRATE_FLAG_BUCKET, "", "Bucket of target s3")
+	cmd.Flags().String(MIGRATE_FLAG_AK, "", "Access key of target s3, same as source s3 if not set")
+	cmd.Flags().String(MIGRATE_FLAG_SK, "", "Secret key of target s3, same as source s3 if not set")
+	cmd.Flags().String(MIGRATE_FLAG_RADOS_MON, "", "Monitor host of target rados, e.g. 10.220.32.1:3300,10.220.32.2:3300")
+	cmd.Flags().String(MIGRATE_FLAG_RADOS_USERNAME, "", "User name of target rados")
+	cmd.Flags().String(MIGRATE_FLAG_RADOS_KEY, "", "User secret key of target rados")
+	cmd.Flags().String(MIGRATE_FLAG_RADOS_POOL, "", "Pool name of target rados")
+	cmd.Flags().String(MIGRATE_FLAG_RADOS_CLUSTER, MIGRATE_DEFAULT_RADOS_CLUSTER, "Cluster name of target rados")
+	cmd.Flags().Bool(MIGRATE_FLAG_NO_SWITCH, false, "Only copy objects, storage of filesystem is not changed")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// target is rados if pool is set, otherwise s3, keys of s3 are filled by source later if not set
+func getMigrateTarget(cmd *cobra.Command) (*mds.FsExtra, error) {
+	get := func(name string) string {
+		value, _ := cmd.Flags().GetString(name)
+		return strings.TrimSpace(value)
+	}
+
+	if pool := get(MIGRATE_FLAG_RADOS_POOL); pool != "" {
+		radosInfo := &mds.RadosInfo{
+			MonHost:     get(MIGRATE_FLAG_RADOS_MON),
+			UserName:    get(MIGRATE_FLAG_RADOS_USERNAME),
+			Key:         get(MIGRATE_FLAG_RADOS_KEY),
+			PoolName:    pool,
+			ClusterName: get(MIGRATE_FLAG_RADOS_CLUSTER),
+		}
+		if radosInfo.MonHost == "" || radosInfo.UserName == "" || radosInfo.Key == "" {
+			return nil, fmt.Errorf("--%s, --%s and --%s are required for rados",
+				MIGRATE_FLAG_RADOS_MON, MIGRATE_FLAG_RADOS_USERNAME, MIGRATE_FLAG_RADOS_KEY)
+		}
+		return &mds.FsExtra{RadosInfo: radosInfo}, nil
+	}
+
+	s3Info := &mds.S3Info{
+		Endpoint:   get(MIGRATE_FLAG_ENDPOINT),
+		Bucketname: get(MIGRATE_FLAG_BUCKET),
+		Ak:         get(MIGRATE_FLAG_AK),
+		Sk:         get(MIGRATE_FLAG_SK),
+	}
+	if s3Info.Endpoint == "" || s3Info.Bucketname == "" {
+		return nil, fmt.Errorf("--%s and --%s, or --%s for rados are required",
+			MIGRATE_FLAG_ENDPOINT, MIGRATE_FLAG_BUCKET, MIGRATE_FLAG_RADOS_POOL)
+	}
+	return &mds.FsExtra{S3Info: s3Info}, nil
+}
+
+// objects already in target are skipped, so migration is resumed by running again,
+// storage is only switched after all objects are copied and no client mounts the fs
+func runMigrate(cmd *cobra.Command, dingocli *cli.DingoCli, options migrateOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+	if err != nil {
+		return err
+	}
+	source, err := utils.NewObjectStore(fsInfo.GetExtra())
+	if err != nil {
+		return errno.ERR_MIGRATE_STORAGE_FAILED.E(err)
+	}
+	target := options.target
+	if s3Info := target.GetS3Info(); s3Info != nil && (s3Info.Ak == "" || s3Info.Sk == "") {
+		if fsInfo.GetExtra().GetS3Info() == nil {
+			return fmt.Errorf("--%s and --%s are required as source is not s3", MIGRATE_FLAG_AK, MIGRATE_FLAG_SK)
+		}
+		s3Info.Ak, s3Info.Sk = fsInfo.GetExtra().GetS3Info().GetAk(), fsInfo.GetExtra().GetS3Info().GetSk()
+	}
+	dest, err := utils.NewObjectStore(target)
+	if err != nil {
+		return errno.ERR_MIGRATE_STORAGE_FAILED.E(err)
+	}
+	if source.String() == dest.String() {
+		return fmt.Errorf("target storage %s is the same as source", dest)
+	}
+
+	result := &MigrateResult{FsId: options.fsid, FsName: options.fsname, From: source.String(), To: dest.String()}
+	outputResult.Result = result
+
+	if err := syncObjects(dingocli, source, dest, options.threads, result); err != nil {
+		outputResult.Error = errno.FromError(err)
+	}
+
+	// clients write to source storage until they are umounted and remounted
+	if !options.noSwitch && outputResult.Error.GetCode() == errno.ERR_OK.GetCode() {
+		outputResult.Error = switchStorage(cmd, dingocli, options, source, dest, result)
+		result.Switched = outputResult.Error.GetCode() == errno.ERR_OK.GetCode() && !dingocli.IsDryRun()
+	}
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	fmt.Printf("Migrate fs %s from %s to %s: %d objects, %d skipped, %d copied, %d failed\n",
+		options.fsname, result.From, result.To, result.Objects, result.Skipped, result.Copied, result.Failed)
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	if result.Switched {
+		fmt.Printf("Successfully switch storage of fs %s to %s, objects in %s can be deleted after check\n",
+			options.fsname, result.To, result.From)
+	}
+	return nil
+}
+
+// objects of source which are missing or incomplete in dest are copied, objects of
+// dest are listed first, incomplete objects are copied again if size is known
+func syncObjects(dingocli *cli.DingoCli, source, dest utils.ObjectStore, threads uint32, result *MigrateResult) error {
+	existing := map[string]int64{}
+	spinner := output.NewSpinner(fmt.Sprintf("Listing objects in %s", dest))
+	err := dest.List(utils.BLOCK_STORE_PREFIX, func(key string, size int64, mtime time.Time) error {
+		existing[key] = size
+		spinner.Add64(1)
+		return nil
+	})
+	spinner.Finish()
+	if err != nil {
+		return errno.ERR_MIGRATE_STORAGE_FAILED.E(err)
+	}
+
+	pending := []string{}
+	spinner = output.NewSpinner(fmt.Sprintf("Listing objects in %s", source))
+	err = source.List(utils.BLOCK_STORE_PREFIX, func(key string, size int64, mtime time.Time) error {
+		result.Objects++
+		spinner.Add64(1)
+		if copied, ok := existing[key]; ok && (copied < 0 || size < 0 || copied == size) {
+			result.Skipped++
+			return nil
+		}
+		pending = append(pending, key)
+		return nil
+	})
+	spinner.Finish()
+	if err != nil {
+		return errno.ERR_MIGRATE_STORAGE_FAILED.E(err)
+	}
+
+	action := cli.NewAction(cli.ACTION_OBJECT, "copy %d objects from %s to %s", len(pending), source, dest)
+	return dingocli.Perform(action, func() error {
+		result.Copied, result.Failed = copyObjects(source, dest, pending, threads)
+		if result.Failed > 0 {
+			return errno.ERR_MIGRATE_STORAGE_FAILED.F("copy %d objects failed, run again to retry", result.Failed)
+		}
+		return nil
+	})
+}
+
+func copyObjects(source, dest utils.ObjectStore, keys []string, threads uint32) (int64, int64) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	copied, failed := int64(0), int64(0)
+
+	progress := output.NewProgress(int64(len(keys)), fmt.Sprintf("Copying objects to %s", dest))
+	ch := make(chan string, threads)
+	for i := uint32(0); i < threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range ch {
+				data, err := source.Get(key)
+				if err == nil {
+					err = dest.Put(key, data)
+				}
+				mu.Lock()
+				if err != nil {
+					logger.Errorf("copy object %s failed: %v", key, err)
+					failed++
+				} else {
+					copied++
+				}
+				mu.Unlock()
+				progress.Add64(1)
+			}
+		}()
+	}
+	for _, key := range keys {
+		ch <- key
+	}
+	close(ch)
+	wg.Wait()
+	progress.Finish()
+
+	return copied, failed
+}
+
+// fs info is updated with the target storage, data in source is kept. fs info is
+// fetched again as clients may mount while copying, and objects written by clients
+// before they umount are copied by a final pass
+func switchStorage(cmd *cobra.Command, dingocli *cli.DingoCli, options migrateOptions,
+	source, dest utils.ObjectStore, result *MigrateResult) *errno.ErrorCode {
+	fsInfo, err := rpc.GetFsInfo(cmd, options.fsid, "")
+	if err != nil {
+		return errno.FromError(err)
+	}
+	if mountpoints := fsInfo.GetMountPoints(); len(mountpoints) > 0 {
+		clients := []string{}
+		for _, mountpoint := range mountpoints {
+			clients = append(clients, fmt.Sprintf("%s:%d:%s", mountpoint.GetIp(), mountpoint.GetPort(), mountpoint.GetPath()))
+		}
+		return errno.ERR_FILESYSTEM_IS_MOUNTED.F("fs %s is mounted by %d client(s): %s, please umount and run again to switch storage",
+			options.fsname, len(clients), strings.Join(clients, ", "))
+	}
+	if !utils.Confirm("Are you sure to switch storage of fs %s to %s?", options.fsname, dest) {
+		return errno.ERR_MIGRATE_STORAGE_FAILED.S("abort switching storage")
+	}
+
+	delta := &MigrateResult{}
+	err = syncObjects(dingocli, source, dest, options.threads, delta)
+	result.Copied += delta.Copied
+	result.Failed += delta.Failed
+	if err != nil {
+		return errno.FromError(err)
+	}
+
+	newInfo := proto.Clone(fsInfo).(*mds.FsInfo)
+	newInfo.Extra = options.target
+	newInfo.FsType = mds.FsType_S3
+	if options.target.GetRadosInfo() != nil {
+		newInfo.FsType = mds.FsType_RADOS
+	}
+	action := cli.NewAction(cli.ACTION_RPC, "UpdateFsInfo(fs=%s, storage=%s)", options.fsname, dest)
+	err = dingocli.Perform(action, func() error {
+		return rpc.UpdateFsInfo(cmd, options.fsname, newInfo)
+	})
+	if err != nil {
+		return errno.FromError(err)
+	}
+	return errno.ERR_OK
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	STORAGE_PROBE_EXAMPLE = `Examples:
+   # check s3 settings before creating filesystem
+   $ dingo fs storage probe --storagetype s3 --s3.ak AK --s3.sk SK --s3.endpoint http://localhost:9000 --s3.bucketname dingofs-bucket
+
+   # check rados settings, cluster health is also reported
+   $ dingo fs storage probe --storagetype rados --rados.username admin --rados.key AQDg3Y2h --rados.mon 10.220.32.1:3300 --rados.poolname pool1
+
+   # settings in config file
+   $ dingo fs storage probe --storagetype s3 --conf dingo.yaml --format json`
+)
+
+type probeOptions struct {
+	storageType string
+	ak          string
+	sk          string
+	endpoint    string
+	bucketname  string
+	radosInfo   *mds.RadosInfo
+	format      string
+}
+
+type ProbeResult struct {
+	StorageType string             `json:"storage_type"`
+	Storage     string             `json:"storage"`
+	Steps       []*utils.ProbeStep `json:"steps"`
+}
+
+func NewStorageProbeCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options probeOptions
+
+	cmd := &cobra.Command{
+		Use:     "probe [OPTIONS]",
+		Short:   "Check settings of storage by list/put/get/delete of a test object",
+		Args:    utils.NoArgs,
+		Example: STORAGE_PROBE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.storageType = strings.ToLower(utils.GetStringFlag(cmd, utils.DINGOFS_STORAGETYPE))
+			options.ak = utils.GetStringFlag(cmd, utils.DINGOFS_S3_AK)
+			options.sk = utils.GetStringFlag(cmd, utils.DINGOFS_S3_SK)
+			options.endpoint = utils.GetStringFlag(cmd, utils.DINGOFS_S3_ENDPOINT)
+			options.bucketname = utils.GetStringFlag(cmd, utils.DINGOFS_S3_BUCKETNAME)
+			options.radosInfo = &mds.RadosInfo{
+				UserName:    utils.GetStringFlag(cmd, utils.DINGOFS_RADOS_USERNAME),
+				Key:         utils.GetStringFlag(cmd, utils.DINGOFS_RADOS_KEY),
+				MonHost:     utils.GetStringFlag(cmd, utils.DINGOFS_RADOS_MON),
+				PoolName:    utils.GetStringFlag(cmd, utils.DINGOFS_RADOS_POOLNAME),
+				ClusterName: utils.GetStringFlag(cmd, utils.DINGOFS_RADOS_CLUSTERNAME),
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runProbe(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags, same as fs create
+	utils.AddStringFlag(cmd, utils.DINGOFS_STORAGETYPE, "Storage type, should be: s3, rados")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_AK, "S3 access key")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_SK, "S3 secret key")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_ENDPOINT, "S3 endpoint")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_BUCKETNAME, "S3 bucketname")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_RADOS_KEY, "Rados user secret key")
+	utils.AddStringFlag(cmd, utils.DINGOFS_RADOS_USERNAME, "Rados user name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_RADOS_MON, "Rados monitor host, should be like 10.220.32.1:3300,10.220.32.2:3300,10.220.32.3:3300")
+	utils.AddStringFlag(cmd, utils.DINGOFS_RADOS_POOLNAME, "Rados pool name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_RADOS_CLUSTERNAME, "Rados cluster name")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func newProbeStore(options probeOptions) (utils.ObjectStore, error) {
+	switch options.storageType {
+	case "s3":
+		if options.ak == "" || options.sk == "" || options.endpoint == "" || options.bucketname == "" {
+			return nil, fmt.Errorf("s3 info is incomplete, please check s3.ak, s3.sk, s3.endpoint, s3.bucketname")
+		}
+		return utils.NewS3Store(options.endpoint, options.bucketname, options.ak, options.sk), nil
+	case "rados":
+		info := options.radosInfo
+		if info.UserName == "" || info.Key == "" || info.MonHost == "" || info.PoolName == "" {
+			return nil, fmt.Errorf("rados info is incomplete, please check rados.username, rados.key, rados.mon, rados.poolname")
+		}
+		if info.ClusterName == "" {
+			info.ClusterName = MIGRATE_DEFAULT_RADOS_CLUSTER
+		}
+		return utils.NewRadosStore(info)
+	default:
+		return nil, fmt.Errorf("invalid storage type: %s", options.storageType)
+	}
+}
+
+// the test object is written outside of blocks, so probing a bucket used by a filesystem is safe
+func runProbe(cmd *cobra.Command, dingocli *cli.DingoCli, options probeOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	store, err := newProbeStore(options)
+	if err != nil {
+		return err
+	}
+	result := &ProbeResult{StorageType: options.storageType, Storage: store.String()}
+	if radosStore, ok := store.(*utils.RadosStore); ok {
+		result.Steps = utils.ProbeRadosStore(radosStore)
+	} else {
+		result.Steps = utils.ProbeObjectStore(store)
+	}
+	if utils.ProbeFailed(result.Steps) {
+		outputResult.Error = errno.ERR_STORAGE_PROBE_FAILED
+	}
+	outputResult.Result = result
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	fmt.Printf("storage: %s\n", result.Storage)
+	header := []string{common.ROW_STEP, common.ROW_STATUS, common.ROW_LATENCY, common.ROW_CAUSE, common.ROW_DETAIL}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	hints := []string{}
+	for _, step := range result.Steps {
+		latency := common.ROW_VALUE_NO_VALUE
+		if step.Status != utils.PROBE_STATUS_SKIPPED {
+			latency = step.Latency.Round(time.Microsecond).String()
+		}
+		rows = append(rows, []string{
+			step.Step,
+			step.Status,
+			latency,
+			utils.Ternary(step.Cause != "", step.Cause, common.ROW_VALUE_NO_VALUE),
+			utils.Ternary(step.Error != "", step.Error, utils.Ternary(step.Detail != "", step.Detail, common.ROW_VALUE_NO_VALUE)),
+		})
+		if step.Hint != "" {
+			hints = append(hints, fmt.Sprintf("%s: %s", step.Step, step.Hint))
+		}
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no probe steps")
+	for _, hint := range hints {
+		fmt.Println(hint)
+	}
+
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
@@ -32,6 +32,7 @@ func NewSubpathCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.AddCommand(
 		NewSubpathCreateCommand(dingocli),
 		NewSubpathDeleteCommand(dingocli),
+		NewSubpathListCommand(dingocli),
 	)
 
 	return cmd
@@ -155,6 +155,7 @@ func runCreate(cmd *cobra.Command, dingocli *cli.DingoCli, options createOptions
 		epoch:  epoch,
 	}
 
+	// uid and gid are set by mkdir, so no one can see the directory with other owner
 	exists, _ := checkPathIsExist(cmd, options, parentInodeId, epoch)
 	if !exists {
 		outputResult.Error, outputResult.Result = mkDir(cmd, inodeParam)
@@ -168,6 +169,10 @@ func runCreate(cmd *cobra.Command, dingocli *cli.DingoCli, options createOptions
 		return outputResult.Error
 	}
 
+	if exists {
+		fmt.Printf("Directory %s already exists, owner is not changed\n", options.path)
+		return nil
+	}
 	fmt.Printf("Successfully create directory: %s\n", options.path)
 
 	return nil
@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package subpath
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	SUBPATH_LIST_EXAMPLE = `Examples:
+   $ dingo fs subpath list --fsname dingofs1
+   $ dingo fs subpath list --fsname dingofs1 --path /teams`
+)
+
+type listOptions struct {
+	fsid   uint32
+	path   string
+	format string
+}
+
+type Subpath struct {
+	Path  string `json:"path"`
+	Ino   uint64 `json:"ino"`
+	Uid   uint32 `json:"uid"`
+	Gid   uint32 `json:"gid"`
+	Mode  uint32 `json:"mode"`
+	Nlink uint32 `json:"nlink"`
+}
+
+func NewSubpathListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [OPTIONS]",
+		Short:   "List sub directories with owner in filesystem",
+		Args:    utils.ExactArgs(0),
+		Example: SUBPATH_LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			utils.ReadCommandConfig(cmd)
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+
+			options.path = filepath.Clean(utils.GetStringFlag(cmd, "path"))
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runList(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddUint32Flag(cmd, utils.DINGOFS_FSID, "Filesystem id")
+	utils.AddStringFlag(cmd, utils.DINGOFS_FSNAME, "Filesystem name")
+	cmd.Flags().String("path", "/", "Parent directory of sub directories in filesystem")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	subpaths, err := listSubpaths(cmd, options)
+	if err != nil {
+		outputResult.Error = errno.FromError(err)
+	}
+	outputResult.Result = subpaths
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	// set table header
+	header := []string{common.ROW_PATH, common.ROW_INODE_ID, common.ROW_OWNER, common.ROW_MODE, common.ROW_NLINK}
+	table.SetHeader(header)
+	// fill table
+	rows := make([]map[string]string, 0)
+	for _, subpath := range subpaths {
+		row := make(map[string]string)
+		row[common.ROW_PATH] = subpath.Path
+		row[common.ROW_INODE_ID] = fmt.Sprintf("%d", subpath.Ino)
+		row[common.ROW_OWNER] = fmt.Sprintf("%d:%d", subpath.Uid, subpath.Gid)
+		row[common.ROW_MODE] = fmt.Sprintf("%04o", subpath.Mode&0o7777)
+		row[common.ROW_NLINK] = fmt.Sprintf("%d", subpath.Nlink)
+		rows = append(rows, row)
+	}
+
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_PATH})
+	table.AppendBulk(list)
+	table.RenderWithNoData(fmt.Sprintf("no sub directory in %s", options.path))
+
+	return nil
+}
+
+// directories directly under the path
+func listSubpaths(cmd *cobra.Command, options listOptions) ([]*Subpath, error) {
+	// get epoch id
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return nil, err
+	}
+	// create router
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return nil, err
+	}
+	parentInodeId, err := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := rpc.ListDentry(cmd, options.fsid, parentInodeId, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	subpaths := []*Subpath{}
+	for _, entry := range entries {
+		if entry.GetType() != mds.FileType_DIRECTORY {
+			continue
+		}
+		inode, err := rpc.GetInode(cmd, options.fsid, entry.GetIno(), parentInodeId, epoch)
+		if err != nil {
+			return nil, err
+		}
+		subpaths = append(subpaths, &Subpath{
+			Path:  path.Join(options.path, entry.GetName()),
+			Ino:   entry.GetIno(),
+			Uid:   inode.GetUid(),
+			Gid:   inode.GetGid(),
+			Mode:  inode.GetMode(),
+			Nlink: inode.GetNlink(),
+		})
+	}
+	return subpaths, nil
+}
@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_SUMMARY_EXAMPLE = `Examples:
+   $ dingo fs summary --fsname dingofs1
+
+   $ dingo fs summary --fsname dingofs1 --path /dir1 --threads 16`
+)
+
+// upper bounds of file size buckets, empty files have their own bucket
+// and the last bucket has no upper bound
+var summarySizeBuckets = []uint64{
+	4 * humanize.KiByte,
+	64 * humanize.KiByte,
+	humanize.MiByte,
+	16 * humanize.MiByte,
+	128 * humanize.MiByte,
+	humanize.GiByte,
+	16 * humanize.GiByte,
+}
+
+type summaryOptions struct {
+	fsid    uint32
+	path    string
+	threads uint32
+	format  string
+}
+
+type SizeBucket struct {
+	Range  string `json:"range"`
+	Files  uint64 `json:"files"`
+	Length uint64 `json:"length"`
+}
+
+// dirs counts the directory itself, a hard link is counted once
+type FsSummary struct {
+	Path         string        `json:"path"`
+	Files        uint64        `json:"files"`
+	Dirs         uint64        `json:"dirs"`
+	Symlinks     uint64        `json:"symlinks"`
+	Length       uint64        `json:"length"`
+	Distribution []*SizeBucket `json:"distribution"`
+}
+
+// the tree is walked through mds directly instead of fuse, directories are walked concurrently
+type summaryWalker struct {
+	cmd        *cobra.Command
+	fsid       uint32
+	epoch      uint64
+	concurrent chan struct{}
+	hardlinks  sync.Map
+	spinner    *output.Progress
+
+	mutex   sync.Mutex
+	summary *FsSummary
+}
+
+func NewFsSummaryCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options summaryOptions
+
+	cmd := &cobra.Command{
+		Use:     "summary [OPTIONS]",
+		Short:   "Show files, directories, symlinks, size and size distribution of a directory tree",
+		Args:    utils.NoArgs,
+		Example: FS_SUMMARY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.path = path.Clean("/" + utils.GetStringFlag(cmd, utils.DINGOFS_PATH))
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runSummary(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_PATH, "Full path of the directory within the volume")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runSummary(cmd *cobra.Command, dingocli *cli.DingoCli, options summaryOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	// epoch + router
+	epoch, epochErr := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if epochErr != nil {
+		return epochErr
+	}
+	if routerErr := rpc.InitFsMDSRouter(cmd, options.fsid); routerErr != nil {
+		return routerErr
+	}
+	dirInodeId, inodeErr := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if inodeErr != nil {
+		return inodeErr
+	}
+
+	walker := &summaryWalker{
+		cmd:        cmd,
+		fsid:       options.fsid,
+		epoch:      epoch,
+		concurrent: make(chan struct{}, options.threads),
+		summary:    newFsSummary(options.path),
+	}
+	if options.format != "json" {
+		walker.spinner = output.NewSpinner(fmt.Sprintf("Scanning %s", options.path))
+	}
+	err := walker.walk(dirInodeId)
+	if walker.spinner != nil {
+		walker.spinner.Finish()
+	}
+	if err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	}
+	summary := walker.summary
+	outputResult.Result = summary
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	header := []string{common.ROW_RANGE, common.ROW_FILES, common.ROW_FILES_PERCENT, common.ROW_SIZE, common.ROW_SIZE_PERCENT}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, bucket := range summary.Distribution {
+		rows = append(rows, []string{
+			bucket.Range,
+			humanize.Comma(int64(bucket.Files)),
+			fmt.Sprintf("%.1f", percent(bucket.Files, summary.Files)),
+			humanize.IBytes(bucket.Length),
+			fmt.Sprintf("%.1f", percent(bucket.Length, summary.Length)),
+		})
+	}
+	// buckets are in order of size
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no file found")
+	fmt.Printf("Summary of %s: %s files, %s directories, %s symlinks, %s (%d bytes)\n", summary.Path,
+		humanize.Comma(int64(summary.Files)), humanize.Comma(int64(summary.Dirs)), humanize.Comma(int64(summary.Symlinks)),
+		humanize.IBytes(summary.Length), summary.Length)
+
+	return nil
+}
+
+func newFsSummary(dirPath string) *FsSummary {
+	summary := &FsSummary{Path: dirPath, Dirs: 1, Distribution: []*SizeBucket{{Range: "0"}}}
+	lower := humanize.IBytes(1)
+	for _, upper := range summarySizeBuckets {
+		summary.Distribution = append(summary.Distribution, &SizeBucket{Range: fmt.Sprintf("%s-%s", lower, humanize.IBytes(upper))})
+		lower = humanize.IBytes(upper)
+	}
+	summary.Distribution = append(summary.Distribution, &SizeBucket{Range: ">=" + lower})
+	return summary
+}
+
+func (w *summaryWalker) walk(ino uint64) error {
+	entries, err := rpc.ListDentry(w.cmd, w.fsid, ino, w.epoch)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	var errMutex sync.Mutex
+	var walkErr error
+	for _, entry := range entries {
+		if w.spinner != nil {
+			w.spinner.Add64(1)
+		}
+		switch entry.GetType() {
+		case mds.FileType_DIRECTORY:
+			w.update(func(s *FsSummary) { s.Dirs++ })
+			select {
+			case w.concurrent <- struct{}{}:
+				wg.Add(1)
+				go func(ino uint64) {
+					defer wg.Done()
+					defer func() { <-w.concurrent }()
+					if err := w.walk(ino); err != nil {
+						errMutex.Lock()
+						walkErr = err
+						errMutex.Unlock()
+					}
+				}(entry.GetIno())
+			default:
+				// all threads are busy, walk in current one
+				if err := w.walk(entry.GetIno()); err != nil {
+					wg.Wait()
+					return err
+				}
+			}
+		case mds.FileType_SYM_LINK:
+			w.update(func(s *FsSummary) { s.Symlinks++ })
+		default:
+			inode, err := rpc.GetInode(w.cmd, w.fsid, entry.GetIno(), entry.GetParent(), w.epoch)
+			if err != nil {
+				wg.Wait()
+				return err
+			}
+			if inode.GetNlink() >= 2 {
+				if _, loaded := w.hardlinks.LoadOrStore(inode.GetIno(), struct{}{}); loaded {
+					continue
+				}
+			}
+			w.update(func(s *FsSummary) { s.addFile(inode.GetLength()) })
+		}
+	}
+	wg.Wait()
+	return walkErr
+}
+
+func (w *summaryWalker) update(fn func(s *FsSummary)) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	fn(w.summary)
+}
+
+func (s *FsSummary) addFile(length uint64) {
+	s.Files++
+	s.Length += length
+	index := 0
+	if length > 0 {
+		index = len(summarySizeBuckets) + 1
+		for i, upper := range summarySizeBuckets {
+			if length < upper {
+				index = i + 1
+				break
+			}
+		}
+	}
+	s.Distribution[index].Files++
+	s.Distribution[index].Length += length
+}
+
+func percent(value, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(value) / float64(total) * 100
+}
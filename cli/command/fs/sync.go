@@ -0,0 +1,468 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_SYNC_EXAMPLE = `Examples:
+   # dingofs mountpoint to s3
+   $ dingo fs sync /mnt/dingofs/dir1 s3://bucket1/backup/dir1 --s3.endpoint http://10.0.0.1:9000 --s3.ak AK --s3.sk SK
+
+   # local to dingofs, only parquet files, 8 threads, 100MiB/s at most
+   $ dingo fs sync /data/dir1 /mnt/dingofs/dir1 --include '*.parquet' --threads 8 --bwlimit 100MiB
+
+   # compare md5 of files with the same size instead of mtime
+   $ dingo fs sync s3://bucket1/dir1 /mnt/dingofs/dir1 --checksum --s3.endpoint http://10.0.0.1:9000`
+
+	SYNC_S3_SCHEME = "s3://"
+	// files are written to a temporary file and renamed, so an interrupted sync leaves no partial file
+	SYNC_TMP_SUFFIX = ".dingosync.tmp"
+	// max errors kept in result
+	SYNC_MAX_ERRORS = 100
+)
+
+type syncOptions struct {
+	src      string
+	dst      string
+	includes []string
+	excludes []string
+	checksum bool
+	threads  uint32
+	bwlimit  uint64
+	format   string
+}
+
+// dingofs is accessed by its mountpoint, so it is a local endpoint
+type syncEndpoint interface {
+	String() string
+	// call fn for every file, key is the relative path separated by '/'
+	List(fn func(key string, size int64, mtime time.Time) error) error
+	Read(key string) ([]byte, error)
+	Write(key string, data []byte, mtime time.Time) error
+	// md5 in hex, empty if unknown
+	Checksum(key string) (string, error)
+}
+
+type syncFile struct {
+	key   string
+	size  int64
+	mtime time.Time
+}
+
+type SyncResult struct {
+	Src     string   `json:"src"`
+	Dst     string   `json:"dst"`
+	Copied  int64    `json:"copied"`
+	Skipped int64    `json:"skipped"`
+	Failed  int64    `json:"failed"`
+	Bytes   int64    `json:"bytes"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+type localEndpoint struct {
+	root string
+}
+
+type s3Endpoint struct {
+	store  *utils.S3Store
+	prefix string
+}
+
+// shared by all threads, limits bytes per second
+type bandwidthLimiter struct {
+	mutex sync.Mutex
+	rate  float64
+	next  time.Time
+}
+
+func NewFsSyncCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options syncOptions
+
+	cmd := &cobra.Command{
+		Use:     "sync SRC DST [OPTIONS]",
+		Short:   "Sync files between dingofs, local directory and s3",
+		Args:    utils.ExactArgs(2),
+		Example: FS_SYNC_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.src, options.dst = args[0], args[1]
+			options.includes, _ = cmd.Flags().GetStringSlice("include")
+			options.excludes, _ = cmd.Flags().GetStringSlice("exclude")
+			for _, pattern := range append(options.includes, options.excludes...) {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return fmt.Errorf("invalid pattern '%s': %v", pattern, err)
+				}
+			}
+			options.checksum, _ = cmd.Flags().GetBool("checksum")
+			options.threads = max(utils.GetUint32Flag(cmd, utils.DINGOFS_THREADS), 1)
+			bwlimit, _ := cmd.Flags().GetString("bwlimit")
+			var err error
+			if options.bwlimit, err = utils.ParseSize(bwlimit); err != nil {
+				return fmt.Errorf("invalid --bwlimit: %v", err)
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runSync(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().StringSlice("include", nil, "Only sync files matching these patterns, e.g. '*.csv','data/*'")
+	cmd.Flags().StringSlice("exclude", nil, "Do not sync files matching these patterns")
+	cmd.Flags().Bool("checksum", false, "Compare md5 of files with the same size instead of mtime")
+	cmd.Flags().String("bwlimit", "0", "Bandwidth limit per second, e.g. 100MiB, 0 is unlimited")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_THREADS, "Number of threads")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_ENDPOINT, "Endpoint of s3, for s3://bucket/prefix")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_AK, "Access key of s3")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_SK, "Secret key of s3")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+// files of dst with the same size and not older than src (or the same md5 if --checksum) are skipped,
+// so an interrupted sync is resumed by running it again
+func runSync(cmd *cobra.Command, dingocli *cli.DingoCli, options syncOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	src, err := newSyncEndpoint(cmd, options.src, true)
+	if err != nil {
+		return err
+	}
+	dst, err := newSyncEndpoint(cmd, options.dst, false)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]*syncFile{}
+	err = dst.List(func(key string, size int64, mtime time.Time) error {
+		existing[key] = &syncFile{key: key, size: size, mtime: mtime}
+		return nil
+	})
+	if err != nil {
+		return errno.ERR_FS_SYNC_FAILED.E(fmt.Errorf("list %s: %v", dst, err))
+	}
+	files := []*syncFile{}
+	result := &SyncResult{Src: src.String(), Dst: dst.String()}
+	totalBytes := int64(0)
+	err = src.List(func(key string, size int64, mtime time.Time) error {
+		if !matchSyncPatterns(key, options.includes, options.excludes) {
+			return nil
+		}
+		// checksum of files with the same size is compared by workers
+		if old, ok := existing[key]; ok && old.size == size && !options.checksum && !old.mtime.Before(mtime) {
+			result.Skipped++
+			return nil
+		}
+		files = append(files, &syncFile{key: key, size: size, mtime: mtime})
+		totalBytes += size
+		return nil
+	})
+	if err != nil {
+		return errno.ERR_FS_SYNC_FAILED.E(fmt.Errorf("list %s: %v", src, err))
+	}
+
+	var progress *output.Progress
+	if options.format != utils.FORMAT_JSON {
+		progress = output.NewProgress(totalBytes, fmt.Sprintf("Syncing %d files", len(files)), output.WithBytes())
+	}
+	var limiter *bandwidthLimiter
+	if options.bwlimit > 0 {
+		limiter = &bandwidthLimiter{rate: float64(options.bwlimit)}
+	}
+
+	var copied, skipped, failed, bytes atomic.Int64
+	var errMutex sync.Mutex
+	jobs := make(chan *syncFile)
+	var wg sync.WaitGroup
+	for i := uint32(0); i < options.threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range jobs {
+				done, err := syncOneFile(dingocli, src, dst, file, existing[file.key], options.checksum, limiter)
+				if err != nil {
+					failed.Add(1)
+					errMutex.Lock()
+					if len(result.Errors) < SYNC_MAX_ERRORS {
+						result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", file.key, err))
+					}
+					errMutex.Unlock()
+				} else if done {
+					copied.Add(1)
+					bytes.Add(file.size)
+				} else {
+					skipped.Add(1)
+				}
+				if progress != nil {
+					progress.Add64(file.size)
+				}
+			}
+		}()
+	}
+	for _, file := range files {
+		jobs <- file
+	}
+	close(jobs)
+	wg.Wait()
+	if progress != nil {
+		progress.Finish()
+	}
+
+	result.Copied = copied.Load()
+	result.Skipped += skipped.Load()
+	result.Failed = failed.Load()
+	result.Bytes = bytes.Load()
+	outputResult.Result = result
+	if result.Failed > 0 {
+		outputResult.Error = errno.ERR_FS_SYNC_FAILED.F("%d files failed", result.Failed)
+	}
+
+	// print result
+	if options.format == utils.FORMAT_JSON {
+		return output.OutputJson(outputResult)
+	}
+	for _, e := range result.Errors {
+		fmt.Fprintln(os.Stderr, e)
+	}
+	fmt.Printf("Synced %s to %s: %d copied (%s), %d skipped, %d failed\n", result.Src, result.Dst,
+		result.Copied, humanize.IBytes(uint64(result.Bytes)), result.Skipped, result.Failed)
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
+
+// returns false if the file is skipped because checksums are the same
+func syncOneFile(dingocli *cli.DingoCli, src, dst syncEndpoint, file, old *syncFile, checksum bool, limiter *bandwidthLimiter) (bool, error) {
+	if checksum && old != nil && old.size == file.size {
+		srcSum, err := src.Checksum(file.key)
+		if err != nil {
+			return false, err
+		}
+		dstSum, err := dst.Checksum(file.key)
+		if err != nil {
+			return false, err
+		}
+		if srcSum != "" && srcSum == dstSum {
+			return false, nil
+		}
+	}
+
+	action := cli.NewAction(cli.ACTION_FILE, "copy(%s/%s -> %s/%s)", src, file.key, dst, file.key)
+	err := dingocli.Perform(action, func() error {
+		data, err := src.Read(file.key)
+		if err != nil {
+			return err
+		}
+		limiter.wait(len(data))
+		return dst.Write(file.key, data, file.mtime)
+	})
+	return err == nil, err
+}
+
+// a pattern containing '/' matches the whole key, otherwise the base name
+func matchSyncPatterns(key string, includes, excludes []string) bool {
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			name := path.Base(key)
+			if strings.Contains(pattern, "/") {
+				name = key
+			}
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(includes) > 0 && !match(includes) {
+		return false
+	}
+	return !match(excludes)
+}
+
+func newSyncEndpoint(cmd *cobra.Command, uri string, isSrc bool) (syncEndpoint, error) {
+	if rest, ok := strings.CutPrefix(uri, SYNC_S3_SCHEME); ok {
+		bucket, prefix, _ := strings.Cut(rest, "/")
+		if bucket == "" {
+			return nil, fmt.Errorf("invalid s3 address '%s', should be s3://bucket/prefix", uri)
+		}
+		endpoint := utils.GetStringFlag(cmd, utils.DINGOFS_S3_ENDPOINT)
+		if endpoint == "" {
+			return nil, fmt.Errorf("--%s is required for %s", utils.DINGOFS_S3_ENDPOINT, uri)
+		}
+		prefix = strings.Trim(prefix, "/")
+		if prefix != "" {
+			prefix += "/"
+		}
+		store := utils.NewS3Store(endpoint, bucket, utils.GetStringFlag(cmd, utils.DINGOFS_S3_AK), utils.GetStringFlag(cmd, utils.DINGOFS_S3_SK))
+		return &s3Endpoint{store: store, prefix: prefix}, nil
+	}
+
+	root, err := filepath.Abs(uri)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(root)
+	if err == nil && !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	} else if err != nil && (isSrc || !os.IsNotExist(err)) {
+		return nil, err
+	}
+	return &localEndpoint{root: root}, nil
+}
+
+func (e *localEndpoint) String() string {
+	return e.root
+}
+
+func (e *localEndpoint) List(fn func(key string, size int64, mtime time.Time) error) error {
+	if _, err := os.Stat(e.root); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.WalkDir(e.root, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() || strings.HasSuffix(name, SYNC_TMP_SUFFIX) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		key, err := filepath.Rel(e.root, name)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(key), info.Size(), info.ModTime())
+	})
+}
+
+func (e *localEndpoint) Read(key string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(e.root, filepath.FromSlash(key)))
+}
+
+func (e *localEndpoint) Write(key string, data []byte, mtime time.Time) error {
+	name := filepath.Join(e.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	tmp := name + SYNC_TMP_SUFFIX
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if !mtime.IsZero() {
+		os.Chtimes(tmp, mtime, mtime)
+	}
+	return os.Rename(tmp, name)
+}
+
+func (e *localEndpoint) Checksum(key string) (string, error) {
+	f, err := os.Open(filepath.Join(e.root, filepath.FromSlash(key)))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := md5.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func (e *s3Endpoint) String() string {
+	return strings.TrimSuffix(e.store.String()+"/"+e.prefix, "/")
+}
+
+// directory markers are ignored
+func (e *s3Endpoint) List(fn func(key string, size int64, mtime time.Time) error) error {
+	return e.store.List(e.prefix, func(key string, size int64, mtime time.Time) error {
+		if strings.HasSuffix(key, "/") {
+			return nil
+		}
+		return fn(strings.TrimPrefix(key, e.prefix), size, mtime)
+	})
+}
+
+func (e *s3Endpoint) Read(key string) ([]byte, error) {
+	return e.store.Get(e.prefix + key)
+}
+
+// mtime of object is the time it is uploaded, which is not older than the source
+func (e *s3Endpoint) Write(key string, data []byte, mtime time.Time) error {
+	return e.store.Put(e.prefix+key, data)
+}
+
+// etag of multipart uploaded object is not md5
+func (e *s3Endpoint) Checksum(key string) (string, error) {
+	_, etag, err := e.store.Head(e.prefix + key)
+	if err != nil || strings.Contains(etag, "-") {
+		return "", err
+	}
+	return strings.ToLower(etag), nil
+}
+
+// nil limiter is unlimited
+func (l *bandwidthLimiter) wait(n int) {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(n) / l.rate * float64(time.Second)))
+	l.mutex.Unlock()
+	time.Sleep(delay)
+}
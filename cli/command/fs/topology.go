@@ -0,0 +1,380 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/client"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_TOPOLOGY_EXAMPLE = `Examples:
+   $ dingo fs topology
+
+   $ dingo fs topology --format table
+
+   # render by graphviz
+   $ dingo fs topology --format dot | dot -Tpng -o topology.png`
+
+	TOPOLOGY_FORMAT_DOT = "dot"
+
+	TOPOLOGY_TYPE_MDS          = "mds"
+	TOPOLOGY_TYPE_CACHE_GROUP  = "cachegroup"
+	TOPOLOGY_TYPE_CACHE_MEMBER = "cachemember"
+	TOPOLOGY_TYPE_FS           = "fs"
+	TOPOLOGY_TYPE_CLIENT       = "client"
+
+	// members which do not belong to any group
+	TOPOLOGY_NO_GROUP = "<no group>"
+)
+
+type topologyOptions struct {
+	format string
+}
+
+type TopologyMds struct {
+	Id     uint64 `json:"id"`
+	Addr   string `json:"addr"`
+	State  string `json:"state"`
+	Online bool   `json:"online"`
+}
+
+type TopologyCacheMember struct {
+	MemberId string `json:"member_id"`
+	Addr     string `json:"addr"`
+	State    string `json:"state"`
+	Weight   uint32 `json:"weight"`
+}
+
+type TopologyCacheGroup struct {
+	Name    string                 `json:"name"`
+	Members []*TopologyCacheMember `json:"members"`
+}
+
+type TopologyFs struct {
+	FsId    uint32             `json:"fs_id"`
+	FsName  string             `json:"fs_name"`
+	FsType  string             `json:"fs_type"`
+	Storage string             `json:"storage"`
+	Clients []*client.FsClient `json:"clients"`
+}
+
+type Topology struct {
+	Mdses       []*TopologyMds        `json:"mdses"`
+	CacheGroups []*TopologyCacheGroup `json:"cache_groups"`
+	Filesystems []*TopologyFs         `json:"filesystems"`
+}
+
+// node of tree and dot output, type and addr are only for table output
+type topologyNode struct {
+	nodeType string
+	name     string
+	addr     string
+	state    string
+	children []*topologyNode
+}
+
+func NewFsTopologyCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options topologyOptions
+
+	cmd := &cobra.Command{
+		Use:     "topology [OPTIONS]",
+		Short:   "Show mds, cache groups, storages and clients of the cluster",
+		Args:    utils.NoArgs,
+		Example: FS_TOPOLOGY_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			return runTopology(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags, plain format is a tree, dot format is for graphviz
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddFormatFlag(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runTopology(cmd *cobra.Command, dingocli *cli.DingoCli, options topologyOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	topology, err := getTopology(cmd)
+	if err != nil {
+		outputResult.Error = errno.FromError(err)
+	}
+	outputResult.Result = topology
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	root := newTopologyTree(topology)
+	switch options.format {
+	case output.FORMAT_PLAIN:
+		fmt.Print(renderTopologyTree(root))
+	case TOPOLOGY_FORMAT_DOT:
+		fmt.Print(renderTopologyDot(root))
+	default:
+		renderTopologyTable(root)
+	}
+
+	return nil
+}
+
+func getTopology(cmd *cobra.Command) (*Topology, error) {
+	topology := &Topology{}
+
+	mdses, err := rpc.GetMDSList(cmd)
+	if err != nil {
+		return nil, err
+	}
+	for _, mdsInfo := range mdses {
+		topology.Mdses = append(topology.Mdses, &TopologyMds{
+			Id:     mdsInfo.GetId(),
+			Addr:   fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort()),
+			State:  mdsInfo.GetState().String(),
+			Online: mdsInfo.GetIsOnline(),
+		})
+	}
+
+	groupNames, err := rpc.ListCacheGroups(cmd)
+	if err != nil {
+		return nil, err
+	}
+	members, err := rpc.ListCacheMembers(cmd)
+	if err != nil {
+		return nil, err
+	}
+	groups := map[string]*TopologyCacheGroup{}
+	for _, name := range groupNames {
+		groups[name] = &TopologyCacheGroup{Name: name}
+		topology.CacheGroups = append(topology.CacheGroups, groups[name])
+	}
+	for _, member := range members {
+		name := member.GetGroupName()
+		if len(name) == 0 {
+			name = TOPOLOGY_NO_GROUP
+		}
+		group, ok := groups[name]
+		if !ok {
+			group = &TopologyCacheGroup{Name: name}
+			groups[name] = group
+			topology.CacheGroups = append(topology.CacheGroups, group)
+		}
+		group.Members = append(group.Members, &TopologyCacheMember{
+			MemberId: member.GetMemberId(),
+			Addr:     fmt.Sprintf("%s:%d", member.GetIp(), member.GetPort()),
+			State:    utils.TranslateCacheGroupMemberState(member.GetState()),
+			Weight:   member.GetWeight(),
+		})
+	}
+
+	fsInfos, err := rpc.ListFsInfo(cmd)
+	if err != nil {
+		return nil, err
+	}
+	clients, err := client.ListFsClients(cmd, fsInfos)
+	if err != nil {
+		return nil, err
+	}
+	filesystems := map[uint32]*TopologyFs{}
+	for _, fsInfo := range fsInfos {
+		fs := &TopologyFs{
+			FsId:    fsInfo.GetFsId(),
+			FsName:  fsInfo.GetFsName(),
+			FsType:  fsInfo.GetFsType().String(),
+			Storage: strings.ReplaceAll(utils.ConvertFsExtraToString(fsInfo.GetExtra()), "\n", ", "),
+			Clients: []*client.FsClient{},
+		}
+		filesystems[fs.FsId] = fs
+		topology.Filesystems = append(topology.Filesystems, fs)
+	}
+	for _, c := range clients {
+		if fs, ok := filesystems[c.FsId]; ok {
+			fs.Clients = append(fs.Clients, c)
+		}
+	}
+
+	sort.Slice(topology.Mdses, func(i, j int) bool { return topology.Mdses[i].Id < topology.Mdses[j].Id })
+	sort.Slice(topology.CacheGroups, func(i, j int) bool { return topology.CacheGroups[i].Name < topology.CacheGroups[j].Name })
+	sort.Slice(topology.Filesystems, func(i, j int) bool { return topology.Filesystems[i].FsId < topology.Filesystems[j].FsId })
+	return topology, nil
+}
+
+func newTopologyTree(topology *Topology) *topologyNode {
+	mdsNode := &topologyNode{name: "mds"}
+	for _, mds := range topology.Mdses {
+		state := common.ROW_VALUE_OFFLINE
+		if mds.Online {
+			state = common.ROW_VALUE_ONLINE
+		}
+		mdsNode.children = append(mdsNode.children, &topologyNode{
+			nodeType: TOPOLOGY_TYPE_MDS,
+			name:     fmt.Sprintf("%d", mds.Id),
+			addr:     mds.Addr,
+			state:    fmt.Sprintf("%s, %s", mds.State, state),
+		})
+	}
+
+	cacheNode := &topologyNode{name: "cache groups"}
+	for _, group := range topology.CacheGroups {
+		groupNode := &topologyNode{nodeType: TOPOLOGY_TYPE_CACHE_GROUP, name: group.Name}
+		for _, member := range group.Members {
+			groupNode.children = append(groupNode.children, &topologyNode{
+				nodeType: TOPOLOGY_TYPE_CACHE_MEMBER,
+				name:     member.MemberId,
+				addr:     member.Addr,
+				state:    fmt.Sprintf("%s, weight %d", member.State, member.Weight),
+			})
+		}
+		cacheNode.children = append(cacheNode.children, groupNode)
+	}
+
+	fsNode := &topologyNode{name: "filesystems"}
+	for _, fs := range topology.Filesystems {
+		node := &topologyNode{
+			nodeType: TOPOLOGY_TYPE_FS,
+			name:     fmt.Sprintf("%s(%d)", fs.FsName, fs.FsId),
+			addr:     fs.Storage,
+			state:    fs.FsType,
+		}
+		for _, c := range fs.Clients {
+			state := common.ROW_VALUE_NO_VALUE
+			if !c.LastHeartbeat.IsZero() {
+				state = "last online " + c.LastHeartbeat.Format("2006-01-02 15:04:05")
+			}
+			node.children = append(node.children, &topologyNode{
+				nodeType: TOPOLOGY_TYPE_CLIENT,
+				name:     c.ClientId,
+				addr:     c.MountPoint,
+				state:    fmt.Sprintf("%s, %s", c.Version, state),
+			})
+		}
+		fsNode.children = append(fsNode.children, node)
+	}
+
+	return &topologyNode{name: "cluster", children: []*topologyNode{mdsNode, cacheNode, fsNode}}
+}
+
+func (node *topologyNode) label() string {
+	items := []string{node.name}
+	if len(node.addr) > 0 {
+		items = append(items, node.addr)
+	}
+	if len(node.state) > 0 {
+		items = append(items, "("+node.state+")")
+	}
+	return strings.Join(items, " ")
+}
+
+// e.g.
+// cluster
+// ├── mds
+// │   └── 1 10.0.0.1:7400 (NORMAL, online)
+// └── filesystems
+func renderTopologyTree(root *topologyNode) string {
+	var sb strings.Builder
+	sb.WriteString(root.label() + "\n")
+	var walk func(node *topologyNode, prefix string)
+	walk = func(node *topologyNode, prefix string) {
+		for i, child := range node.children {
+			branch, indent := "├── ", "│   "
+			if i == len(node.children)-1 {
+				branch, indent = "└── ", "    "
+			}
+			sb.WriteString(prefix + branch + child.label() + "\n")
+			walk(child, prefix+indent)
+		}
+	}
+	walk(root, "")
+	return sb.String()
+}
+
+func renderTopologyDot(root *topologyNode) string {
+	var sb strings.Builder
+	sb.WriteString("digraph topology {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+	id := 0
+	var walk func(node *topologyNode) string
+	walk = func(node *topologyNode) string {
+		name := fmt.Sprintf("n%d", id)
+		id++
+		fmt.Fprintf(&sb, "  %s [label=%q];\n", name, node.label())
+		for _, child := range node.children {
+			fmt.Fprintf(&sb, "  %s -> %s;\n", name, walk(child))
+		}
+		return name
+	}
+	walk(root)
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// one row for every mds, cache group, cache member, fs and client
+func renderTopologyTable(root *topologyNode) {
+	header := []string{common.ROW_TYPE, common.ROW_NAME, common.ROW_ADDR, common.ROW_STATE, common.ROW_PARENT}
+	table.SetHeader(header)
+	rows := [][]string{}
+	var walk func(node *topologyNode, parent string)
+	walk = func(node *topologyNode, parent string) {
+		for _, child := range node.children {
+			if len(child.nodeType) == 0 {
+				walk(child, parent)
+				continue
+			}
+			rows = append(rows, []string{child.nodeType, child.name, child.addr, child.state, parent})
+			walk(child, child.name)
+		}
+	}
+	walk(root, common.ROW_VALUE_NO_VALUE)
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no mds in cluster")
+}
@@ -0,0 +1,303 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/dirstats"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_TREE_EXAMPLE = `Examples:
+   $ dingo fs tree --fsname dingofs1 --path /datasets --depth 3
+
+   # directories only, with size and file count of each directory
+   $ dingo fs tree --fsname dingofs1 --dirs-only --summary --entries 0`
+)
+
+const (
+	TREE_TYPE_DIRECTORY = "directory"
+	TREE_TYPE_FILE      = "file"
+	TREE_TYPE_SYMLINK   = "symlink"
+)
+
+type treeOptions struct {
+	fsid     uint32
+	path     string
+	depth    uint32
+	entries  uint32
+	dirsOnly bool
+	summary  bool
+	useFast  bool
+	format   string
+}
+
+// files, dirs and length are totals of the subtree and only set with --summary,
+// more is the number of entries not shown because of --entries
+type TreeNode struct {
+	Name     string      `json:"name"`
+	Ino      uint64      `json:"ino"`
+	Type     string      `json:"type"`
+	Length   uint64      `json:"length"`
+	Files    uint64      `json:"files,omitempty"`
+	Dirs     uint64      `json:"dirs,omitempty"`
+	More     int         `json:"more,omitempty"`
+	Children []*TreeNode `json:"children,omitempty"`
+}
+
+type treeWalker struct {
+	cmd     *cobra.Command
+	epoch   uint64
+	options treeOptions
+	totals  map[uint64]*rpc.DirTreeNode // totals of directories within depth, by inode
+	dirs    uint64
+	files   uint64
+}
+
+func NewFsTreeCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options treeOptions
+
+	cmd := &cobra.Command{
+		Use:     "tree [OPTIONS]",
+		Short:   "Show directory hierarchy of filesystem up to a depth",
+		Args:    utils.NoArgs,
+		Example: FS_TREE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			fsid, err := rpc.GetFsId(cmd)
+			if err != nil {
+				return err
+			}
+			options.fsid = fsid
+			options.path = path.Clean("/" + utils.GetStringFlag(cmd, utils.DINGOFS_PATH))
+			options.depth = utils.GetUint32Flag(cmd, utils.DINGOFS_DEPTH)
+			options.entries = utils.GetUint32Flag(cmd, utils.DINGOFS_ENTRIES)
+			options.dirsOnly, _ = cmd.Flags().GetBool("dirs-only")
+			options.summary, _ = cmd.Flags().GetBool("summary")
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+			if options.summary {
+				if options.useFast, err = dirstats.UseFastPath(cmd, fsid, utils.GetBoolFlag(cmd, utils.DINGOFS_STRICT)); err != nil {
+					return err
+				}
+			}
+
+			return runTree(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().Uint32("fsid", 0, "Filesystem id")
+	cmd.Flags().String("fsname", "", "Filesystem name")
+	utils.AddStringFlag(cmd, utils.DINGOFS_PATH, "Full path of the directory within the volume")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_DEPTH, "Levels of directories to show")
+	utils.AddUint32Flag(cmd, utils.DINGOFS_ENTRIES, "Entries to show of each directory, 0 is all")
+	cmd.Flags().Bool("dirs-only", false, "Only show directories")
+	cmd.Flags().Bool("summary", false, "Show size and file count of directories from dir stats")
+	utils.AddBoolFlag(cmd, utils.DINGOFS_STRICT, "Count by dentry scan instead of maintained counters with --summary")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func runTree(cmd *cobra.Command, dingocli *cli.DingoCli, options treeOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	epoch, err := rpc.GetFsEpochByFsId(cmd, options.fsid)
+	if err != nil {
+		return err
+	}
+	if err := rpc.InitFsMDSRouter(cmd, options.fsid); err != nil {
+		return err
+	}
+	dirInodeId, err := rpc.GetDirPathInodeId(cmd, options.fsid, options.path, epoch)
+	if err != nil {
+		return err
+	}
+
+	walker := &treeWalker{cmd: cmd, epoch: epoch, options: options, totals: map[uint64]*rpc.DirTreeNode{}}
+	if options.summary {
+		// totals of all shown directories are got by one walk
+		total, err := rpc.WalkDirTree(cmd, options.fsid, dirInodeId, options.path, options.useFast, int(options.depth), epoch)
+		if err != nil {
+			return err
+		}
+		walker.addTotals(total)
+	}
+	root := &TreeNode{Name: options.path, Ino: dirInodeId, Type: TREE_TYPE_DIRECTORY}
+	if err := walker.walk(root, 0); err != nil {
+		outputResult.Error = errno.ERR_RPC_FAILED.S(err.Error())
+	}
+	outputResult.Result = root
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	fmt.Print(renderDirTree(root, options.summary))
+	if options.dirsOnly {
+		fmt.Printf("\n%d directories\n", walker.dirs)
+	} else {
+		fmt.Printf("\n%d directories, %d files\n", walker.dirs, walker.files)
+	}
+	return nil
+}
+
+// entries are in order of name, totals of directories are got only with --summary
+func (w *treeWalker) walk(node *TreeNode, depth uint32) error {
+	options := w.options
+	if total, ok := w.totals[node.Ino]; ok {
+		node.Files, node.Dirs, node.Length = total.Files, total.Dirs, total.Length
+	}
+	if depth >= options.depth {
+		return nil
+	}
+
+	entries, err := rpc.ListDentry(w.cmd, options.fsid, node.Ino, w.epoch)
+	if err != nil {
+		return err
+	}
+	if options.dirsOnly {
+		dirs := entries[:0]
+		for _, entry := range entries {
+			if entry.GetType() == mds.FileType_DIRECTORY {
+				dirs = append(dirs, entry)
+			}
+		}
+		entries = dirs
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].GetName() < entries[j].GetName()
+	})
+	if options.entries > 0 && len(entries) > int(options.entries) {
+		node.More = len(entries) - int(options.entries)
+		entries = entries[:options.entries]
+	}
+
+	for _, entry := range entries {
+		child := &TreeNode{Name: entry.GetName(), Ino: entry.GetIno(), Type: treeTypeName(entry.GetType())}
+		node.Children = append(node.Children, child)
+		if entry.GetType() == mds.FileType_DIRECTORY {
+			w.dirs++
+			if err := w.walk(child, depth+1); err != nil {
+				return err
+			}
+			continue
+		}
+		w.files++
+		if options.summary {
+			inode, err := rpc.GetInode(w.cmd, options.fsid, entry.GetIno(), entry.GetParent(), w.epoch)
+			if err != nil {
+				return err
+			}
+			child.Length = inode.GetLength()
+		}
+	}
+	return nil
+}
+
+func (w *treeWalker) addTotals(node *rpc.DirTreeNode) {
+	w.totals[node.Ino] = node
+	for _, child := range node.Children {
+		w.addTotals(child)
+	}
+}
+
+func treeTypeName(fileType mds.FileType) string {
+	switch fileType {
+	case mds.FileType_DIRECTORY:
+		return TREE_TYPE_DIRECTORY
+	case mds.FileType_SYM_LINK:
+		return TREE_TYPE_SYMLINK
+	default:
+		return TREE_TYPE_FILE
+	}
+}
+
+func (node *TreeNode) label(summary bool) string {
+	name := node.Name
+	if node.Type == TREE_TYPE_DIRECTORY && name != "/" {
+		name += "/"
+	}
+	if !summary {
+		return name
+	}
+	if node.Type != TREE_TYPE_DIRECTORY {
+		return fmt.Sprintf("%s [%s]", name, humanize.IBytes(node.Length))
+	}
+	return fmt.Sprintf("%s [%s, %s files, %s dirs]", name, humanize.IBytes(node.Length),
+		humanize.Comma(int64(node.Files)), humanize.Comma(int64(node.Dirs)))
+}
+
+// e.g.
+// /datasets/
+// ├── imagenet/ [140 GiB, 1,281,167 files, 1,001 dirs]
+// │   └── ... 1000 more entries
+// └── README.md [4.0 KiB]
+func renderDirTree(root *TreeNode, summary bool) string {
+	var sb strings.Builder
+	sb.WriteString(root.label(summary) + "\n")
+	var walk func(node *TreeNode, prefix string)
+	walk = func(node *TreeNode, prefix string) {
+		for i, child := range node.Children {
+			branch, indent := "├── ", "│   "
+			if i == len(node.Children)-1 && node.More == 0 {
+				branch, indent = "└── ", "    "
+			}
+			sb.WriteString(prefix + branch + child.label(summary) + "\n")
+			walk(child, prefix+indent)
+		}
+		if node.More > 0 {
+			sb.WriteString(fmt.Sprintf("%s└── ... %d more entries\n", prefix, node.More))
+		}
+	}
+	walk(root, "")
+	return sb.String()
+}
@@ -20,35 +20,70 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/table"
 	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 const (
 	FS_UMOUNT_EXAMPLE = `Examples:
-   $ dingo fs umount /mnt/dingofs`
+   $ dingo fs umount /mnt/dingofs
+
+   $ dingo fs umount --fsname dingofs1
+
+   $ dingo fs umount --all --lazy
+
+   $ dingo fs umount /mnt/dingofs --force --kill`
 )
 
 type umountOptions struct {
 	mountpoint string
+	fsname     string
+	all        bool
 	lazy       bool
+	force      bool
+	kill       bool
+	timeout    time.Duration
 }
 
+// interval of checking whether client process exits
+const UMOUNT_CLIENT_EXIT_CHECK_INTERVAL = 100 * time.Millisecond
+
 func NewFsUmountCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options umountOptions
 
 	cmd := &cobra.Command{
-		Use:     "umount MOUNTPOINT [OPTIONS]",
-		Short:   "Umount filesystem",
-		Args:    utils.ExactArgs(1),
-		Example: FS_UMOUNT_EXAMPLE,
+		Use:               "umount [MOUNTPOINT] [OPTIONS]",
+		Short:             "Umount filesystem",
+		Args:              utils.RequiresMaxArgs(1),
+		Example:           FS_UMOUNT_EXAMPLE,
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			options.mountpoint = args[0]
+			selected := 0
+			for _, ok := range []bool{len(args) > 0, options.all, options.fsname != ""} {
+				if ok {
+					selected++
+				}
+			}
+			if selected != 1 {
+				return fmt.Errorf("one of MOUNTPOINT, --all and --fsname should be specified")
+			}
+			if options.kill && !options.force {
+				return fmt.Errorf("--kill only works with --force")
+			}
 
-			return runUmuont(cmd, dingocli, options)
+			if len(args) > 0 {
+				options.mountpoint = args[0]
+				return runUmuont(cmd, dingocli, options)
+			}
+			return runUmountAll(cmd, dingocli, options)
 		},
 		SilenceUsage:          false,
 		DisableFlagsInUseLine: true,
@@ -58,52 +93,199 @@ func NewFsUmountCommand(dingocli *cli.DingoCli) *cobra.Command {
 
 	// add flags
 	cmd.Flags().BoolVarP(&options.lazy, "lazy", "l", false, "Lazy umount")
+	cmd.Flags().BoolVar(&options.all, "all", false, "Umount all dingofs mountpoints on this host")
+	cmd.Flags().StringVar(&options.fsname, "fsname", "", "Umount all mountpoints of the filesystem on this host")
+	cmd.Flags().BoolVarP(&options.force, "force", "f", false, "Terminate client process and lazy umount, for hung client")
+	cmd.Flags().BoolVar(&options.kill, "kill", false, "Kill client process if it does not exit in timeout, only for --force")
+	cmd.Flags().DurationVar(&options.timeout, "timeout", 10*time.Second, "Time to wait for client process exiting, only for --force")
 
 	return cmd
 }
 
 func runUmuont(cmd *cobra.Command, dingocli *cli.DingoCli, options umountOptions) error {
-	flags := 0
+	// stat of a hung mountpoint never returns
+	if !options.force {
+		if _, err := os.Stat(options.mountpoint); os.IsNotExist(err) {
+			return fmt.Errorf("mountpoint does not exist: %s", options.mountpoint)
+		}
+	}
 
-	if options.lazy {
-		flags = syscall.MNT_DETACH
+	if err := umountWithOptions(dingocli, options.mountpoint, options); err != nil {
+		return err
 	}
 
-	if _, err := os.Stat(options.mountpoint); os.IsNotExist(err) {
-		return fmt.Errorf("mountpoint does not exist: %s", options.mountpoint)
+	if dingocli.IsDryRun() {
+		return nil
 	}
+	fmt.Printf("Successfully unmounted %s\n", options.mountpoint)
 
-	err := syscall.Unmount(options.mountpoint, flags)
+	return nil
+}
+
+// umount every dingofs mountpoint on this host, or mountpoints of fsname,
+// a failed mountpoint does not stop others
+func runUmountAll(cmd *cobra.Command, dingocli *cli.DingoCli, options umountOptions) error {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	}
+
+	rows := make([]map[string]string, 0)
+	failed := 0
+	for _, mountpoint := range mountpoints {
+		fsname := utils.MountPointFsName(mountpoint)
+		if options.fsname != "" && fsname != options.fsname {
+			continue
+		}
+		row := map[string]string{
+			common.ROW_MOUNTPOINT: mountpoint.MountPoint,
+			common.ROW_FS_NAME:    fsname,
+			common.ROW_RESULT:     common.ROW_VALUE_SUCCESS,
+			common.ROW_REASON:     "",
+		}
+		if err := umountWithOptions(dingocli, mountpoint.MountPoint, options); err != nil {
+			row[common.ROW_RESULT] = common.ROW_VALUE_FAILED
+			row[common.ROW_REASON] = err.Error()
+			failed++
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		if options.fsname != "" {
+			return fmt.Errorf("no mountpoint of fs %s on this host", options.fsname)
+		}
+		return fmt.Errorf("no dingofs mountpoint on this host")
+	}
+	if dingocli.IsDryRun() {
+		return nil
+	}
+
+	header := []string{common.ROW_MOUNTPOINT, common.ROW_FS_NAME, common.ROW_RESULT, common.ROW_REASON}
+	table.SetHeader(header)
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_MOUNTPOINT})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no mountpoint unmounted")
+
+	if failed > 0 {
+		return errno.ERR_UMOUNT_FILESYSTEM_FAILED.F("%d of %d mountpoints failed", failed, len(rows))
+	}
+	return nil
+}
+
+func umountWithOptions(dingocli *cli.DingoCli, mountpoint string, options umountOptions) error {
+	if options.force {
+		return forceUmountMountPoint(dingocli, mountpoint, options)
+	}
+	return umountMountPoint(dingocli, mountpoint, options.lazy)
+}
+
+// a hung client keeps the mountpoint busy forever, so the client process owning the
+// mountpoint is terminated (and killed if --kill) before lazy detaching the mountpoint
+func forceUmountMountPoint(dingocli *cli.DingoCli, mountpoint string, options umountOptions) error {
+	if absPath, err := filepath.Abs(mountpoint); err == nil {
+		mountpoint = absPath
+	}
+	pid, err := utils.FindMountPointClientPid(mountpoint)
+	if err != nil {
+		// client may be gone already, e.g. crashed, detach the mountpoint only
+		return umountMountPoint(dingocli, mountpoint, true)
+	}
+
+	action := cli.NewAction(cli.ACTION_SYSCALL, "kill(pid=%d, SIGTERM)", pid)
+	err = dingocli.Perform(action, func() error {
+		return syscall.Kill(pid, syscall.SIGTERM)
+	})
+	if err != nil && err != syscall.ESRCH {
+		return fmt.Errorf("terminate client process %d failed: %v", pid, err)
+	}
+	if !dingocli.IsDryRun() && !waitProcessExit(pid, options.timeout) {
+		if !options.kill {
+			return fmt.Errorf("client process %d does not exit in %s, try with --kill", pid, options.timeout)
+		}
+		action = cli.NewAction(cli.ACTION_SYSCALL, "kill(pid=%d, SIGKILL)", pid)
+		err = dingocli.Perform(action, func() error {
+			return syscall.Kill(pid, syscall.SIGKILL)
+		})
+		if err != nil && err != syscall.ESRCH {
+			return fmt.Errorf("kill client process %d failed: %v", pid, err)
+		}
+		if !waitProcessExit(pid, options.timeout) {
+			return fmt.Errorf("client process %d does not exit in %s after killed", pid, options.timeout)
+		}
+	}
+
+	// client may umount the mountpoint by itself when terminated
+	if !dingocli.IsDryRun() && !isDingoFSMounted(mountpoint) {
+		return nil
+	}
+	return umountMountPoint(dingocli, mountpoint, true)
+}
+
+func isDingoFSMounted(mountpoint string) bool {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return true
+	}
+	for _, mp := range mountpoints {
+		if mp.MountPoint == mountpoint {
+			return true
+		}
+	}
+	return false
+}
+
+// true if process exits in timeout
+func waitProcessExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if err := syscall.Kill(pid, 0); err == syscall.ESRCH {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(UMOUNT_CLIENT_EXIT_CHECK_INTERVAL)
+	}
+}
+
+func umountMountPoint(dingocli *cli.DingoCli, mountpoint string, lazy bool) error {
+	flags := 0
+
+	if lazy {
+		flags = syscall.MNT_DETACH
+	}
+
+	action := cli.NewAction(cli.ACTION_SYSCALL, "umount(%s, flags=%d)", mountpoint, flags)
+	err := dingocli.Perform(action, func() error {
+		return syscall.Unmount(mountpoint, flags)
+	})
 	if err != nil {
 		switch {
 		case err == syscall.EINVAL:
-			return fmt.Errorf("invalid mountpoint: %s", options.mountpoint)
+			return fmt.Errorf("invalid mountpoint: %s", mountpoint)
 		case err == syscall.EPERM:
 			// use fusermount3  to umount
-			umountErr := runFuseumount(options)
+			umountErr := runFuseumount(mountpoint, lazy)
 			if umountErr != nil {
 				return fmt.Errorf("error unmounting: %v", umountErr)
 			}
 		case err == syscall.EBUSY:
-			return fmt.Errorf("mountpoint %s is busy, try umount with lazy option", options.mountpoint)
+			return fmt.Errorf("mountpoint %s is busy, try umount with lazy option", mountpoint)
 		case err == syscall.ENOENT:
-			return fmt.Errorf("mountpoint %s does not exist", options.mountpoint)
+			return fmt.Errorf("mountpoint %s does not exist", mountpoint)
 		default:
 			return fmt.Errorf("system error: %v", err)
 		}
 	}
-
-	fmt.Printf("Successfully unmounted %s\n", options.mountpoint)
-
 	return nil
 }
 
-func runFuseumount(options umountOptions) error {
+func runFuseumount(mountpoint string, lazy bool) error {
 
 	var oscmd *exec.Cmd
 
-	args := []string{"-u", options.mountpoint}
-	if options.lazy {
+	args := []string{"-u", mountpoint}
+	if lazy {
 		args = append(args, "-z")
 	}
 	oscmd = exec.Command("fusermount3", args...)
@@ -0,0 +1,302 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fs
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/client"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FS_VERSIONS_EXAMPLE = `Examples:
+   $ dingo fs versions
+
+   # check all of them are upgraded to v4.1.0
+   $ dingo fs versions --expect v4.1.0`
+
+	VERSION_KIND_MDS        = "mds"
+	VERSION_KIND_CACHEGROUP = "cachegroup"
+	VERSION_KIND_CLIENT     = "client"
+
+	VERSION_STATUS_OK       = "ok"
+	VERSION_STATUS_MISMATCH = "mismatch"
+	VERSION_STATUS_UNKNOWN  = "unknown"
+
+	// where the expected version comes from
+	EXPECTED_BY_FLAG      = "flag"
+	EXPECTED_BY_INSTALLED = "installed"
+	EXPECTED_BY_MAJORITY  = "majority"
+)
+
+// locally installed component of each kind, whose active version is expected
+var versionKindComponents = map[string]string{
+	VERSION_KIND_MDS:        component.DINGO_MDS,
+	VERSION_KIND_CACHEGROUP: component.DINGO_DACHE,
+	VERSION_KIND_CLIENT:     component.DINGO_CLIENT,
+}
+
+type versionsOptions struct {
+	expect string
+	format string
+}
+
+type VersionItem struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Expected   string `json:"expected"`
+	ExpectedBy string `json:"expected_by"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+func NewFsVersionsCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options versionsOptions
+
+	cmd := &cobra.Command{
+		Use:     "versions [OPTIONS]",
+		Short:   "Show versions of mds, cache members and clients, and check them against installed components",
+		Args:    utils.NoArgs,
+		Example: FS_VERSIONS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.expect, _ = cmd.Flags().GetString("expect")
+			if options.expect != "" {
+				version, ok := rpc.ParseVersion(options.expect)
+				if !ok {
+					return fmt.Errorf("invalid version %s, e.g. v4.1.0", options.expect)
+				}
+				options.expect = rpc.FormatVersion(version)
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runVersions(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().String("expect", "", "Version all of them should be, active version of installed components or the version most of them are if not set")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+// exits with error if any version mismatches, so half-upgraded clusters can be caught by scripts
+func runVersions(cmd *cobra.Command, dingocli *cli.DingoCli, options versionsOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	items := []*VersionItem{}
+	mdsItems, err := mdsVersions(cmd)
+	if err != nil {
+		return err
+	}
+	items = append(items, mdsItems...)
+	items = append(items, cacheMemberVersions(cmd)...)
+	items = append(items, clientVersions(cmd)...)
+
+	byKind := map[string][]*VersionItem{}
+	for _, item := range items {
+		byKind[item.Kind] = append(byKind[item.Kind], item)
+	}
+	mismatches := 0
+	for kind, kindItems := range byKind {
+		expected, expectedBy := expectedVersion(kind, kindItems, options.expect)
+		for _, item := range kindItems {
+			item.Expected, item.ExpectedBy = expected, expectedBy
+			if item.Status == VERSION_STATUS_UNKNOWN || expected == "" {
+				continue
+			}
+			if !sameVersion(item.Version, expected) {
+				item.Status = VERSION_STATUS_MISMATCH
+				mismatches++
+			}
+		}
+	}
+	if mismatches > 0 {
+		outputResult.Error = errno.ERR_VERSION_MISMATCH
+	}
+	outputResult.Result = items
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_TYPE, common.ROW_NAME, common.ROW_VERSION, common.ROW_EXPECTED, common.ROW_STATUS, common.ROW_REASON}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, item := range items {
+		row := make(map[string]string)
+		row[common.ROW_TYPE] = item.Kind
+		row[common.ROW_NAME] = item.Name
+		row[common.ROW_VERSION] = utils.Ternary(item.Version != "", item.Version, common.ROW_VALUE_UNKNOWN)
+		row[common.ROW_EXPECTED] = utils.Ternary(item.Expected != "", fmt.Sprintf("%s (%s)", item.Expected, item.ExpectedBy), common.ROW_VALUE_NO_VALUE)
+		row[common.ROW_STATUS] = item.Status
+		row[common.ROW_REASON] = utils.Ternary(item.Error != "", item.Error, common.ROW_VALUE_NO_VALUE)
+		rows = append(rows, row)
+	}
+	table.AppendBulk(table.ListMap2ListSortByKeys(rows, header, []string{}))
+	table.RenderWithNoData("no mds, cache member or client found")
+
+	if mismatches > 0 {
+		fmt.Fprintf(os.Stderr, "%d of %d versions mismatch, the cluster may be partially upgraded\n", mismatches, len(items))
+		return outputResult.Error
+	}
+	return nil
+}
+
+// version of every mds is read from its /version page
+func mdsVersions(cmd *cobra.Command) ([]*VersionItem, error) {
+	mdsInfos, err := rpc.GetMDSList(cmd)
+	if err != nil {
+		return nil, err
+	}
+	addrs := []string{}
+	for _, mdsInfo := range mdsInfos {
+		addrs = append(addrs, fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort()))
+	}
+	sort.Strings(addrs)
+	items, _ := rpc.Batch(cmd, addrs, func(addr string) (*VersionItem, error) {
+		return serverVersion(VERSION_KIND_MDS, addr, addr), nil
+	})
+	return items, nil
+}
+
+// cache members serve /version like mds, they are skipped if cache groups can not be listed
+func cacheMemberVersions(cmd *cobra.Command) []*VersionItem {
+	members, err := rpc.ListCacheMembers(cmd)
+	if err != nil {
+		logger.Warnf("list cache members failed: %v", err)
+		return nil
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].GetGroupName() != members[j].GetGroupName() {
+			return members[i].GetGroupName() < members[j].GetGroupName()
+		}
+		return members[i].GetIp() < members[j].GetIp()
+	})
+	items, _ := rpc.Batch(cmd, members, func(member *mds.CacheGroupMember) (*VersionItem, error) {
+		addr := fmt.Sprintf("%s:%d", member.GetIp(), member.GetPort())
+		return serverVersion(VERSION_KIND_CACHEGROUP, member.GetGroupName()+"/"+addr, addr), nil
+	})
+	return items
+}
+
+// version of clients is reported by heartbeat, clients without heartbeat are unknown
+func clientVersions(cmd *cobra.Command) []*VersionItem {
+	fsInfos, err := rpc.ListFsInfo(cmd)
+	if err == nil {
+		var clients []*client.FsClient
+		if clients, err = client.ListFsClients(cmd, fsInfos); err == nil {
+			items := []*VersionItem{}
+			for _, fsClient := range clients {
+				item := &VersionItem{Kind: VERSION_KIND_CLIENT, Name: fsClient.MountPoint, Version: fsClient.Version, Status: VERSION_STATUS_OK}
+				if _, ok := rpc.ParseVersion(fsClient.Version); !ok {
+					item.Version, item.Status, item.Error = "", VERSION_STATUS_UNKNOWN, "no heartbeat"
+				}
+				items = append(items, item)
+			}
+			sort.Slice(items, func(i, j int) bool { return items[i].Name < items[j].Name })
+			return items
+		}
+	}
+	logger.Warnf("list clients failed: %v", err)
+	return nil
+}
+
+func serverVersion(kind, name, addr string) *VersionItem {
+	item := &VersionItem{Kind: kind, Name: name, Status: VERSION_STATUS_OK}
+	version, err := rpc.GetServerVersion(addr)
+	if err != nil {
+		item.Status, item.Error = VERSION_STATUS_UNKNOWN, err.Error()
+	} else if _, ok := rpc.ParseVersion(version); !ok {
+		item.Status, item.Error = VERSION_STATUS_UNKNOWN, fmt.Sprintf("no version in '%s'", version)
+	} else {
+		item.Version = version
+	}
+	return item
+}
+
+// expected version of a kind: --expect, active version of the installed component,
+// or the version most of the kind are, components built from branches have no version
+func expectedVersion(kind string, items []*VersionItem, expect string) (string, string) {
+	if expect != "" {
+		return expect, EXPECTED_BY_FLAG
+	}
+	if componentManager, err := component.NewComponentManager(); err == nil {
+		if active, err := componentManager.GetActiveComponent(versionKindComponents[kind]); err == nil {
+			if version, ok := rpc.ParseVersion(active.Version); ok {
+				return rpc.FormatVersion(version), EXPECTED_BY_INSTALLED
+			}
+		}
+	}
+
+	counts := map[string]int{}
+	majority := ""
+	for _, item := range items {
+		version, ok := rpc.ParseVersion(item.Version)
+		if !ok {
+			continue
+		}
+		formatted := rpc.FormatVersion(version)
+		counts[formatted]++
+		if counts[formatted] > counts[majority] || (counts[formatted] == counts[majority] && formatted > majority) {
+			majority = formatted
+		}
+	}
+	return majority, EXPECTED_BY_MAJORITY
+}
+
+// versions are compared by major, minor and patch, commits are ignored
+func sameVersion(a, b string) bool {
+	va, _ := rpc.ParseVersion(a)
+	vb, _ := rpc.ParseVersion(b)
+	return rpc.CompareVersion(va, vb) == 0
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/internal/output"
 	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
 	"golang.org/x/sys/unix"
 
 	"github.com/spf13/cobra"
@@ -40,7 +41,13 @@ const (
    $ dingo fs warmup add /mnt/bigfile.bin
 
    # warmup all files in directory dir1
-   $ dingo fs warmup add /mnt/dir1`
+   $ dingo fs warmup add /mnt/dir1
+
+   # warmup parquet files modified in 7 days, --dry-run shows matched files only
+   $ dingo fs warmup add /mnt/dir1 --include '*.parquet' --newer-than 7d --dry-run
+
+   # limit bandwidth and concurrency of warmup
+   $ dingo fs warmup add /mnt/dir1 --rate-limit 200MiB/s --concurrency 8`
 )
 
 type addOptions struct {
@@ -48,16 +55,19 @@ type addOptions struct {
 	daemon   bool
 	single   bool
 	filelist string
+	limits   warmupLimits
+	filter   *warmupFilter
 }
 
 func NewWarmupAddCommand(dingocli *cli.DingoCli) *cobra.Command {
 	var options addOptions
 
 	cmd := &cobra.Command{
-		Use:     "add [OPTIONS]",
-		Short:   "Tell client to warmup files(directories) to local cache",
-		Args:    utils.RequiresMaxArgs(1),
-		Example: WARMUP_ADD_EXAMPLE,
+		Use:               "add [OPTIONS]",
+		Short:             "Tell client to warmup files(directories) to local cache",
+		Args:              utils.RequiresMaxArgs(1),
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		Example:           WARMUP_ADD_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			if options.filelist == "" && len(args) == 0 {
@@ -71,6 +81,18 @@ func NewWarmupAddCommand(dingocli *cli.DingoCli) *cobra.Command {
 				options.single = true
 			}
 
+			limits, err := getWarmupLimits(cmd)
+			if err != nil {
+				return err
+			}
+			options.limits = limits
+			if options.filter, err = getWarmupFilter(cmd); err != nil {
+				return err
+			}
+			if options.filter.enabled && options.filelist != "" {
+				return fmt.Errorf("filters can not be used with --filelist")
+			}
+
 			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
 
 			return runAdd(cmd, dingocli, options)
@@ -84,6 +106,8 @@ func NewWarmupAddCommand(dingocli *cli.DingoCli) *cobra.Command {
 	// add flags
 	cmd.Flags().StringVar(&options.filelist, "filelist", "", `Full path of file, save the files(dir) to warmup, and should be in dingofs"`)
 	cmd.Flags().BoolVarP(&options.daemon, "daemon", "d", false, "Run in background")
+	addWarmupLimitFlags(cmd)
+	addWarmupFilterFlags(cmd)
 
 	return cmd
 }
@@ -115,20 +139,28 @@ func runAdd(cmd *cobra.Command, dingocli *cli.DingoCli, options addOptions) erro
 	}
 
 	// check file is in dingofs
-	var isInDingofs bool = false
+	mountpointPaths := make([]string, 0, len(mountpoints))
 	for _, mountpoint := range mountpoints {
-		if strings.HasPrefix(options.filepath, mountpoint.MountPoint) {
-			isInDingofs = true
-			break
-		}
+		mountpointPaths = append(mountpointPaths, mountpoint.MountPoint)
 	}
-	if !isInDingofs {
+	mountpoint := findWarmupMountPoint(options.filepath, mountpointPaths)
+	if mountpoint == "" {
 		return fmt.Errorf("[%s] is not saved in dingofs", options.filepath)
 	}
 
 	// warmup file
 	var inodesStr string
-	if options.single {
+	if options.filter.enabled {
+		inodes, bytes, err := collectWarmupFiles(options.filepath, options.filter)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d files (%s) matched in [%s]\n", len(inodes), humanize.IBytes(bytes), options.filepath)
+		if len(inodes) == 0 {
+			return nil
+		}
+		inodesStr = strings.Join(inodes, ",")
+	} else if options.single {
 		inodeId, err := utils.GetFileInode(options.filepath)
 		if err != nil {
 			return err
@@ -142,12 +174,28 @@ func runAdd(cmd *cobra.Command, dingocli *cli.DingoCli, options addOptions) erro
 		inodesStr = inodes
 	}
 
-	err = unix.Setxattr(options.filepath, DINGOFS_WARMUP_OP_XATTR, []byte(inodesStr), 0)
-	if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
-		return fmt.Errorf("filesystem does not support extended attributes")
-	} else if err != nil {
-		return fmt.Errorf("%s: %v", DINGOFS_WARMUP_OP_XATTR, err)
+	action := cli.NewAction(cli.ACTION_SYSCALL, "setxattr(%s, %s)", options.filepath, DINGOFS_WARMUP_OP_XATTR)
+	err = dingocli.Perform(action, func() error {
+		// limits are set before task is started
+		if options.limits.setRateLimit || options.limits.setConcurrency {
+			if err := setWarmupLimits(options.filepath, options.limits); err != nil {
+				return err
+			}
+		}
+
+		err := unix.Setxattr(options.filepath, DINGOFS_WARMUP_OP_XATTR, []byte(inodesStr), 0)
+		if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
+			return fmt.Errorf("filesystem does not support extended attributes")
+		} else if err != nil {
+			return fmt.Errorf("%s: %v", DINGOFS_WARMUP_OP_XATTR, err)
+		}
+		return nil
+	})
+	if err != nil || dingocli.IsDryRun() {
+		return err
 	}
+	recordWarmupTask(dingocli, options.filepath, mountpoint)
+
 	if !options.daemon {
 		time.Sleep(1 * time.Second) //wait for 1s
 		options := queryOptions{
@@ -24,6 +24,8 @@ import (
 
 const (
 	DINGOFS_WARMUP_OP_XATTR = "dingofs.warmup.op"
+	// limits of warmup task of the path, e.g. rate_limit=209715200,concurrency=8
+	DINGOFS_WARMUP_LIMIT_XATTR = "dingofs.warmup.limit"
 )
 
 func NewWarmupCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -36,6 +38,9 @@ func NewWarmupCommand(dingocli *cli.DingoCli) *cobra.Command {
 	cmd.AddCommand(
 		NewWarmupAddCommand(dingocli),
 		NewWarmupQueryCommand(dingocli),
+		NewWarmupListCommand(dingocli),
+		NewWarmupStatusCommand(dingocli),
+		NewWarmupLimitCommand(dingocli),
 	)
 
 	return cmd
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	WARMUP_FLAG_INCLUDE    = "include"
+	WARMUP_FLAG_EXCLUDE    = "exclude"
+	WARMUP_FLAG_MIN_SIZE   = "min-size"
+	WARMUP_FLAG_MAX_SIZE   = "max-size"
+	WARMUP_FLAG_NEWER_THAN = "newer-than"
+)
+
+// predicates of files to warmup in a directory tree, patterns match the file name
+type warmupFilter struct {
+	includes  []string
+	excludes  []string
+	minSize   uint64
+	maxSize   uint64 // 0 is unlimited
+	newerThan time.Time
+	enabled   bool
+}
+
+func addWarmupFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(WARMUP_FLAG_INCLUDE, nil, "Only warmup files whose name matches the pattern, e.g. '*.parquet'")
+	cmd.Flags().StringSlice(WARMUP_FLAG_EXCLUDE, nil, "Skip files whose name matches the pattern")
+	cmd.Flags().String(WARMUP_FLAG_MIN_SIZE, "", "Only warmup files not smaller than the size, e.g. 1MiB")
+	cmd.Flags().String(WARMUP_FLAG_MAX_SIZE, "", "Only warmup files not larger than the size, e.g. 10GiB")
+	cmd.Flags().String(WARMUP_FLAG_NEWER_THAN, "", "Only warmup files modified within the duration, e.g. 7d")
+}
+
+func getWarmupFilter(cmd *cobra.Command) (*warmupFilter, error) {
+	filter := &warmupFilter{}
+	for _, name := range []string{WARMUP_FLAG_INCLUDE, WARMUP_FLAG_EXCLUDE, WARMUP_FLAG_MIN_SIZE, WARMUP_FLAG_MAX_SIZE, WARMUP_FLAG_NEWER_THAN} {
+		filter.enabled = filter.enabled || cmd.Flags().Changed(name)
+	}
+
+	filter.includes, _ = cmd.Flags().GetStringSlice(WARMUP_FLAG_INCLUDE)
+	filter.excludes, _ = cmd.Flags().GetStringSlice(WARMUP_FLAG_EXCLUDE)
+	for _, pattern := range append(append([]string{}, filter.includes...), filter.excludes...) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %v", pattern, err)
+		}
+	}
+
+	var err error
+	if value, _ := cmd.Flags().GetString(WARMUP_FLAG_MIN_SIZE); value != "" {
+		if filter.minSize, err = utils.ParseSize(value); err != nil {
+			return nil, err
+		}
+	}
+	if value, _ := cmd.Flags().GetString(WARMUP_FLAG_MAX_SIZE); value != "" {
+		if filter.maxSize, err = utils.ParseSize(value); err != nil {
+			return nil, err
+		}
+	}
+	if filter.maxSize > 0 && filter.minSize > filter.maxSize {
+		return nil, fmt.Errorf("--%s is larger than --%s", WARMUP_FLAG_MIN_SIZE, WARMUP_FLAG_MAX_SIZE)
+	}
+	if value, _ := cmd.Flags().GetString(WARMUP_FLAG_NEWER_THAN); value != "" {
+		duration, err := utils.ParseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		filter.newerThan = time.Now().Add(-duration)
+	}
+	return filter, nil
+}
+
+func (filter *warmupFilter) match(name string, info fs.FileInfo) bool {
+	if !info.Mode().IsRegular() {
+		return false
+	}
+	if len(filter.includes) > 0 && !matchAny(filter.includes, name) {
+		return false
+	}
+	if matchAny(filter.excludes, name) {
+		return false
+	}
+	size := uint64(info.Size())
+	if size < filter.minSize || (filter.maxSize > 0 && size > filter.maxSize) {
+		return false
+	}
+	return filter.newerThan.IsZero() || info.ModTime().After(filter.newerThan)
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// walk the tree through mountpoint, returns inodes of matched files and their total bytes
+func collectWarmupFiles(root string, filter *warmupFilter) ([]string, uint64, error) {
+	inodes := []string{}
+	var bytes uint64
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if !filter.match(entry.Name(), info) {
+			return nil
+		}
+		if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+			inodes = append(inodes, fmt.Sprintf("%d", stat.Ino))
+			bytes += uint64(info.Size())
+		}
+		return nil
+	})
+	return inodes, bytes, err
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dustin/go-humanize"
+	"golang.org/x/sys/unix"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	WARMUP_LIMIT_EXAMPLE = `Examples:
+   # change limits of a running warmup task
+   $ dingo fs warmup limit /mnt/dir1 --rate-limit 100MiB/s
+
+   $ dingo fs warmup limit /mnt/dir1 --concurrency 4
+
+   # remove rate limit
+   $ dingo fs warmup limit /mnt/dir1 --rate-limit 0`
+
+	WARMUP_FLAG_RATE_LIMIT  = "rate-limit"
+	WARMUP_FLAG_CONCURRENCY = "concurrency"
+)
+
+type limitOptions struct {
+	path   string
+	limits warmupLimits
+}
+
+// limits passed to client, unset ones are not changed and 0 means unlimited
+type warmupLimits struct {
+	rateLimit      uint64 // bytes per second
+	concurrency    uint32
+	setRateLimit   bool
+	setConcurrency bool
+}
+
+func NewWarmupLimitCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options limitOptions
+
+	cmd := &cobra.Command{
+		Use:               "limit PATH [OPTIONS]",
+		Short:             "Change rate limit and concurrency of a running warmup task",
+		Args:              utils.ExactArgs(1),
+		ValidArgsFunction: utils.CompleteFirstArg(utils.CompleteMountPoints),
+		Example:           WARMUP_LIMIT_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			options.path, _ = filepath.Abs(args[0])
+			limits, err := getWarmupLimits(cmd)
+			if err != nil {
+				return err
+			}
+			if !limits.setRateLimit && !limits.setConcurrency {
+				return fmt.Errorf("--%s or --%s is required", WARMUP_FLAG_RATE_LIMIT, WARMUP_FLAG_CONCURRENCY)
+			}
+			options.limits = limits
+
+			return runLimit(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	addWarmupLimitFlags(cmd)
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+
+	return cmd
+}
+
+func runLimit(cmd *cobra.Command, dingocli *cli.DingoCli, options limitOptions) error {
+	total, _, _, err := getWarmupProgress(options.path)
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return fmt.Errorf("no warmup task in progress for [%s]", options.path)
+	}
+
+	if err := setWarmupLimits(options.path, options.limits); err != nil {
+		return err
+	}
+	fmt.Printf("Successfully set limits of warmup task [%s]: %s\n", options.path, options.limits)
+	return nil
+}
+
+func addWarmupLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().String(WARMUP_FLAG_RATE_LIMIT, "", "Max bandwidth of warmup, e.g. 200MiB/s, 0 is unlimited")
+	cmd.Flags().Uint32(WARMUP_FLAG_CONCURRENCY, 0, "Max files warmed up concurrently, 0 is decided by client")
+}
+
+func getWarmupLimits(cmd *cobra.Command) (warmupLimits, error) {
+	limits := warmupLimits{
+		setRateLimit:   cmd.Flags().Changed(WARMUP_FLAG_RATE_LIMIT),
+		setConcurrency: cmd.Flags().Changed(WARMUP_FLAG_CONCURRENCY),
+	}
+	if limits.setRateLimit {
+		rate, _ := cmd.Flags().GetString(WARMUP_FLAG_RATE_LIMIT)
+		bytes, err := parseRateLimit(rate)
+		if err != nil {
+			return limits, err
+		}
+		limits.rateLimit = bytes
+	}
+	if limits.setConcurrency {
+		limits.concurrency, _ = cmd.Flags().GetUint32(WARMUP_FLAG_CONCURRENCY)
+	}
+	return limits, nil
+}
+
+// e.g. 200MiB/s, 200MiB and 209715200 are the same
+func parseRateLimit(rate string) (uint64, error) {
+	bytes, err := humanize.ParseBytes(strings.TrimSuffix(strings.TrimSpace(rate), "/s"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate limit %s, e.g. 200MiB/s", rate)
+	}
+	return bytes, nil
+}
+
+func (limits warmupLimits) String() string {
+	items := []string{}
+	if limits.setRateLimit {
+		items = append(items, fmt.Sprintf("rate_limit=%d", limits.rateLimit))
+	}
+	if limits.setConcurrency {
+		items = append(items, fmt.Sprintf("concurrency=%d", limits.concurrency))
+	}
+	return strings.Join(items, ",")
+}
+
+func setWarmupLimits(path string, limits warmupLimits) error {
+	err := unix.Setxattr(path, DINGOFS_WARMUP_LIMIT_XATTR, []byte(limits.String()), 0)
+	if err == unix.ENOTSUP || err == unix.EOPNOTSUPP {
+		return fmt.Errorf("client does not support warmup limits, please upgrade dingofs client")
+	} else if err != nil {
+		return fmt.Errorf("%s: %v", DINGOFS_WARMUP_LIMIT_XATTR, err)
+	}
+	return nil
+}
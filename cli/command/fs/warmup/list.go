@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+const (
+	WARMUP_LIST_EXAMPLE = `Examples:
+   $ dingo fs warmup list`
+)
+
+type listOptions struct {
+	format string
+}
+
+// warmup task started by warmup add, client only reports progress of a path,
+// so tasks are recorded locally to be listed
+type WarmupTask struct {
+	Path       string    `json:"path"`
+	MountPoint string    `json:"mountpoint"`
+	StartTime  time.Time `json:"start_time"`
+	Total      int64     `json:"total"`
+	Finished   int64     `json:"finished"`
+	Errors     int64     `json:"errors"`
+	// finished files per second
+	Rate float64 `json:"rate"`
+}
+
+func NewWarmupListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [OPTIONS]",
+		Short:   "List in-progress warmup tasks of local mountpoints",
+		Args:    utils.NoArgs,
+		Example: WARMUP_LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runList(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	tasks, err := listWarmupTasks(dingocli)
+	if err != nil {
+		return err
+	}
+	outputResult.Result = tasks
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	renderWarmupTasks(tasks)
+
+	return nil
+}
+
+func renderWarmupTasks(tasks []*WarmupTask) {
+	header := []string{common.ROW_PATH, common.ROW_MOUNTPOINT, common.ROW_TOTAL, common.ROW_FINISHED, common.ROW_ERRORS,
+		common.ROW_PROGRESS, common.ROW_START, common.ROW_RATE}
+	table.SetHeader(header)
+	rows := make([]map[string]string, 0)
+	for _, task := range tasks {
+		rows = append(rows, map[string]string{
+			common.ROW_PATH:       task.Path,
+			common.ROW_MOUNTPOINT: task.MountPoint,
+			common.ROW_TOTAL:      fmt.Sprintf("%d", task.Total),
+			common.ROW_FINISHED:   fmt.Sprintf("%d", task.Finished),
+			common.ROW_ERRORS:     fmt.Sprintf("%d", task.Errors),
+			common.ROW_PROGRESS:   warmupPercent(task.Finished+task.Errors, task.Total),
+			common.ROW_START:      task.StartTime.Local().Format("2006-01-02 15:04:05"),
+			common.ROW_RATE:       fmt.Sprintf("%.1f files/s", task.Rate),
+		})
+	}
+	list := table.ListMap2ListSortByKeys(rows, header, []string{common.ROW_START})
+	table.AppendBulk(list)
+	table.RenderWithNoData("no warmup task in progress")
+}
+
+func warmupPercent(done, total int64) string {
+	if total <= 0 {
+		return "100.0%"
+	}
+	return fmt.Sprintf("%.1f%%", float64(done)*100/float64(total))
+}
+
+func recordWarmupTask(dingocli *cli.DingoCli, path, mountpoint string) {
+	task := &WarmupTask{Path: path, MountPoint: mountpoint, StartTime: time.Now()}
+	data, err := json.Marshal(task)
+	if err == nil {
+		err = dingocli.Storage().SetWarmupTask(path, string(data))
+	}
+	if err != nil { // only warmup list is affected
+		logger.Warnf("record warmup task %s failed: %v", path, err)
+	}
+}
+
+// query progress of recorded tasks whose mountpoint is still mounted,
+// finished tasks are removed from records
+func listWarmupTasks(dingocli *cli.DingoCli) ([]*WarmupTask, error) {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return nil, err
+	}
+	mounted := map[string]bool{}
+	for _, mountpoint := range mountpoints {
+		mounted[mountpoint.MountPoint] = true
+	}
+
+	items, err := dingocli.Storage().GetWarmupTasks()
+	if err != nil {
+		return nil, err
+	}
+	tasks := []*WarmupTask{}
+	for _, item := range items {
+		task := &WarmupTask{}
+		if err := json.Unmarshal([]byte(item.Data), task); err != nil {
+			logger.Warnf("invalid warmup task %s: %v", item.Id, err)
+			continue
+		}
+		if !mounted[task.MountPoint] {
+			dingocli.Storage().DeleteWarmupTask(task.Path)
+			continue
+		}
+
+		task.Total, task.Finished, task.Errors, err = getWarmupProgress(task.Path)
+		if err != nil || task.Total == 0 { // finished or path removed
+			dingocli.Storage().DeleteWarmupTask(task.Path)
+			continue
+		}
+		if elapsed := time.Since(task.StartTime).Seconds(); elapsed > 0 {
+			task.Rate = float64(task.Finished) / elapsed
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// the deepest mountpoint which path is in
+func findWarmupMountPoint(path string, mountpoints []string) string {
+	found := ""
+	for _, mountpoint := range mountpoints {
+		if path != mountpoint && !strings.HasPrefix(path, strings.TrimSuffix(mountpoint, "/")+"/") {
+			continue
+		}
+		if len(mountpoint) > len(found) {
+			found = mountpoint
+		}
+	}
+	return found
+}
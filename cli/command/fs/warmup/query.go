@@ -18,30 +18,50 @@ package warmup
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
 	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
 	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/dingodb/dingocli/pkg/logger"
 	"github.com/fatih/color"
 	"github.com/pkg/xattr"
-	"github.com/schollz/progressbar/v3"
 
 	"github.com/spf13/cobra"
 )
 
 const (
 	WARMUP_QUERY_EXAMPLE = `Examples:
-   $ dingo fs warmup query /mnt/dir1`
+   $ dingo fs warmup query /mnt/dir1
+
+   $ dingo fs warmup query /mnt/dir1 --no-progress
+
+   $ dingo fs warmup query /mnt/dir1 --format json --wait`
+
+	WARMUP_QUERY_INTERVAL = 200 * time.Millisecond
 )
 
 type queryOptions struct {
-	path string
+	path       string
+	format     string
+	noProgress bool
+	wait       bool
+}
+
+// counters of the last query, which are kept after warmup finished
+type WarmupProgress struct {
+	Path     string `json:"path"`
+	Total    int64  `json:"total"`
+	Finished int64  `json:"finished"`
+	Errors   int64  `json:"errors"`
+	// true if warmup finished or not started
+	Done bool `json:"done"`
 }
 
 func NewWarmupQueryCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -53,9 +73,13 @@ func NewWarmupQueryCommand(dingocli *cli.DingoCli) *cobra.Command {
 		Args:    utils.ExactArgs(1),
 		Example: WARMUP_QUERY_EXAMPLE,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
 			output.SetShow(true)
 
 			options.path = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+			options.noProgress, _ = cmd.Flags().GetBool("no-progress")
+			options.wait, _ = cmd.Flags().GetBool("wait")
 
 			return runQuery(cmd, dingocli, options)
 		},
@@ -63,12 +87,19 @@ func NewWarmupQueryCommand(dingocli *cli.DingoCli) *cobra.Command {
 		DisableFlagsInUseLine: true,
 	}
 
+	cmd.Flags().Bool("no-progress", false, "Print total/finished/errors instead of progress bar")
+	cmd.Flags().Bool("wait", false, "Wait for warmup finished, only for --no-progress and json format")
+	utils.AddFormatFlag(cmd)
+	utils.AddConfigFileFlag(cmd)
 	utils.SetFlagErrorFunc(cmd)
 
 	return cmd
 }
 
 func runQuery(cmd *cobra.Command, dingocli *cli.DingoCli, options queryOptions) error {
+	if options.format == "json" || options.noProgress {
+		return runQueryNoProgress(cmd, options)
+	}
 
 	var warmErrors int64 = 0
 	var finished int64 = 0
@@ -88,24 +119,11 @@ func runQuery(cmd *cobra.Command, dingocli *cli.DingoCli, options queryOptions)
 		return nil
 	}
 
-	var bar *progressbar.ProgressBar = progressbar.NewOptions64(total,
-		progressbar.OptionSetDescription("[cyan]Warmup[reset] "+filename+"..."),
-		progressbar.OptionShowCount(),
-		progressbar.OptionSpinnerType(14),
-		progressbar.OptionFullWidth(),
-		progressbar.OptionThrottle(65*time.Millisecond),
-		progressbar.OptionSetRenderBlankState(true),
-		progressbar.OptionOnCompletion(func() {
-			fmt.Fprint(os.Stderr, "\n")
-		}),
-		progressbar.OptionEnableColorCodes(true),
-		progressbar.OptionSetTheme(progressbar.Theme{
-			Saucer:        "[green]=[reset]",
-			SaucerHead:    "[green]>[reset]",
-			SaucerPadding: " ",
-			BarStart:      "[",
-			BarEnd:        "]",
-		}))
+	// log level changed in configure file takes effect while waiting
+	rpc.WatchRpcConfig(cmd)
+
+	bar := output.NewProgress(total, "Warmup "+filename)
+	defer bar.Finish()
 
 	for {
 		total, finished, warmErrors, err = getWarmupProgress(options.path)
@@ -120,18 +138,70 @@ func runQuery(cmd *cobra.Command, dingocli *cli.DingoCli, options queryOptions)
 
 		bar.Set64(finished + warmErrors)
 
-		time.Sleep(200 * time.Millisecond)
+		time.Sleep(WARMUP_QUERY_INTERVAL)
 	}
 
+	bar.Finish()
+
 	if warmErrors > 0 { //warmup failed
 		fmt.Println(color.RedString("\nwarmup finished,%d errors\n", warmErrors))
 	}
 
-	bar.Finish()
+	return nil
+}
+
+// for cron and CI, exits with non-zero code if any file failed to warmup
+func runQueryNoProgress(cmd *cobra.Command, options queryOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
 
+	if options.wait {
+		rpc.WatchRpcConfig(cmd)
+	}
+	progress, err := queryWarmupProgress(options.path, options.wait)
+	if err != nil {
+		return err
+	}
+	if progress.Errors > 0 {
+		outputResult.Error = errno.ERR_WARMUP_FAILED.F("%d errors", progress.Errors)
+	}
+	outputResult.Result = progress
+
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	fmt.Printf("path=%s total=%d finished=%d errors=%d done=%t\n",
+		progress.Path, progress.Total, progress.Finished, progress.Errors, progress.Done)
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
 	return nil
 }
 
+// query once, or until warmup finished if wait is set
+func queryWarmupProgress(path string, wait bool) (*WarmupProgress, error) {
+	progress := &WarmupProgress{Path: path}
+	for {
+		total, finished, warmErrors, err := getWarmupProgress(path)
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("warmup result: total[%d], finished[%d], errors[%d]", total, finished, warmErrors)
+		if total == 0 {
+			progress.Done = true
+			return progress, nil
+		}
+
+		progress.Total, progress.Finished, progress.Errors = total, finished, warmErrors
+		if !wait {
+			return progress, nil
+		}
+		time.Sleep(WARMUP_QUERY_INTERVAL)
+	}
+}
+
 func getWarmupProgress(path string) (int64, int64, int64, error) {
 	// result data format [finished/total/errors]
 	logger.Infof("get warmup xattr")
@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package warmup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	WARMUP_STATUS_EXAMPLE = `Examples:
+   $ dingo fs warmup status
+
+   # refresh every 5 seconds until all warmup tasks finished
+   $ dingo fs warmup status --watch --interval 5s`
+
+	WARMUP_CLEAR_SCREEN = "\033[2J\033[1;1H"
+)
+
+type statusOptions struct {
+	watch    bool
+	interval time.Duration
+	format   string
+}
+
+// progress of all warmup tasks of local mountpoints
+type WarmupStatus struct {
+	Tasks    []*WarmupTask `json:"tasks"`
+	Total    int64         `json:"total"`
+	Finished int64         `json:"finished"`
+	Errors   int64         `json:"errors"`
+}
+
+func NewWarmupStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options statusOptions
+
+	cmd := &cobra.Command{
+		Use:     "status [OPTIONS]",
+		Short:   "Show combined progress of all warmup tasks",
+		Args:    utils.NoArgs,
+		Example: WARMUP_STATUS_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+			if options.interval <= 0 {
+				return fmt.Errorf("invalid interval %s", options.interval)
+			}
+
+			return runStatus(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().BoolVarP(&options.watch, "watch", "w", false, "Refresh until all warmup tasks finished")
+	cmd.Flags().DurationVar(&options.interval, "interval", 2*time.Second, "Refresh interval of watch mode")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runStatus(cmd *cobra.Command, dingocli *cli.DingoCli, options statusOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	status, err := getWarmupStatus(dingocli)
+	if err != nil {
+		return err
+	}
+
+	// json is rendered once, even in watch mode
+	if options.format == "json" {
+		outputResult.Result = status
+		return output.OutputJson(outputResult)
+	}
+	if !options.watch {
+		renderWarmupStatus(status)
+		return nil
+	}
+
+	// log level changed in configure file takes effect while watching
+	rpc.WatchRpcConfig(cmd)
+
+	ticker := time.NewTicker(options.interval)
+	defer ticker.Stop()
+	for {
+		fmt.Print(WARMUP_CLEAR_SCREEN)
+		fmt.Printf("Every %s: dingo fs warmup status\t%s\n\n", options.interval, time.Now().Format("2006-01-02 15:04:05"))
+		table.Reset()
+		renderWarmupStatus(status)
+		if len(status.Tasks) == 0 {
+			return nil
+		}
+
+		<-ticker.C
+		if status, err = getWarmupStatus(dingocli); err != nil {
+			return err
+		}
+	}
+}
+
+func getWarmupStatus(dingocli *cli.DingoCli) (*WarmupStatus, error) {
+	tasks, err := listWarmupTasks(dingocli)
+	if err != nil {
+		return nil, err
+	}
+	status := &WarmupStatus{Tasks: tasks}
+	for _, task := range tasks {
+		status.Total += task.Total
+		status.Finished += task.Finished
+		status.Errors += task.Errors
+	}
+	return status, nil
+}
+
+func renderWarmupStatus(status *WarmupStatus) {
+	renderWarmupTasks(status.Tasks)
+	if len(status.Tasks) == 0 {
+		return
+	}
+	fmt.Printf("%d tasks, %d/%d files finished, %d errors, %s\n", len(status.Tasks), status.Finished, status.Total,
+		status.Errors, warmupPercent(status.Finished+status.Errors, status.Total))
+}
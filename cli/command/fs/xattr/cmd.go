@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xattr
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/cli/command/fs/cache"
+	"github.com/dingodb/dingocli/cli/command/fs/warmup"
+	"github.com/dingodb/dingocli/internal/errno"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// names without a namespace are dingofs xattrs, e.g. warmup.op is dingofs.warmup.op,
+// other namespaces (user, trusted, security, system) are passed through
+const (
+	DINGOFS_XATTR_NAMESPACE = "dingofs."
+)
+
+var xattrNamespaces = []string{DINGOFS_XATTR_NAMESPACE, "user.", "trusted.", "security.", "system."}
+
+type dingofsXattr struct {
+	name        string
+	description string
+	// answered by client, but not returned by listxattr
+	readable bool
+}
+
+var dingofsXattrs = []*dingofsXattr{
+	{cliutil.MOUNTPOINT_FSID_XATTR, "id of filesystem", true},
+	{cliutil.MOUNTPOINT_MDSADDR_XATTR, "mds addresses of client", true},
+	{cliutil.MOUNTPOINT_VERSION_XATTR, "version of client", true},
+	{cliutil.MOUNTPOINT_COMMIT_XATTR, "commit of client", true},
+	{cliutil.MOUNTPOINT_CACHEDIR_XATTR, "local cache dirs of client", true},
+	{cliutil.MOUNTPOINT_ADMIN_SOCKET_XATTR, "admin socket of client", true},
+	{warmup.DINGOFS_WARMUP_OP_XATTR, "set to warm up inodes, get progress as total/finished/errors", true},
+	{warmup.DINGOFS_WARMUP_LIMIT_XATTR, "set limits of warmup", false},
+	{cache.DINGOFS_CACHE_DROP_XATTR, "set scope=local or scope=remote to evict cached blocks", false},
+}
+
+type Xattr struct {
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+func NewXattrCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "xattr",
+		Short: "Manage extended attributes of dingofs files",
+		Args:  cliutil.NoArgs,
+	}
+
+	cmd.AddCommand(
+		NewXattrGetCommand(dingocli),
+		NewXattrSetCommand(dingocli),
+		NewXattrListCommand(dingocli),
+	)
+
+	return cmd
+}
+
+// add dingofs namespace if name has no namespace
+func fullXattrName(name string) string {
+	for _, namespace := range xattrNamespaces {
+		if strings.HasPrefix(name, namespace) {
+			return name
+		}
+	}
+	return DINGOFS_XATTR_NAMESPACE + name
+}
+
+func xattrDescription(name string) string {
+	for _, x := range dingofsXattrs {
+		if x.name == name {
+			return x.description
+		}
+	}
+	return ""
+}
+
+// binary value is shown in hex, e.g. 0x0a0b
+func formatXattrValue(value []byte) string {
+	if utf8.Valid(value) {
+		return strings.TrimRight(string(value), "\x00")
+	}
+	return "0x" + hex.EncodeToString(value)
+}
+
+// xattrs are answered by client, so path must be in a dingofs mountpoint
+func checkDingoFSPath(path string) error {
+	if _, err := cliutil.FindDingoFSMountPoint(path); err != nil {
+		return errno.ERR_GET_MOUNTPOINTS_FAILED.E(err)
+	}
+	return nil
+}
+
+func xattrError(name string, err error) error {
+	return fmt.Errorf("%s: %v", name, err)
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xattr
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/pkg/xattr"
+	"github.com/spf13/cobra"
+)
+
+const (
+	XATTR_GET_EXAMPLE = `Examples:
+   $ dingo fs xattr get /mnt/dingofs fsid version
+
+   $ dingo fs xattr get /mnt/dingofs/dir1 warmup.op --format json`
+)
+
+type getOptions struct {
+	path   string
+	names  []string
+	format string
+}
+
+func NewXattrGetCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options getOptions
+
+	cmd := &cobra.Command{
+		Use:     "get PATH NAME... [OPTIONS]",
+		Short:   "Get extended attributes of path",
+		Args:    utils.RequiresMinArgs(2),
+		Example: XATTR_GET_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.path = args[0]
+			options.names = args[1:]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runGet(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runGet(cmd *cobra.Command, dingocli *cli.DingoCli, options getOptions) error {
+	if err := checkDingoFSPath(options.path); err != nil {
+		return err
+	}
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	xattrs := []*Xattr{}
+	for _, name := range options.names {
+		name = fullXattrName(name)
+		value, err := xattr.Get(options.path, name)
+		if err != nil {
+			outputResult.Error = errno.FromError(xattrError(name, err))
+			break
+		}
+		xattrs = append(xattrs, &Xattr{Name: name, Value: formatXattrValue(value), Description: xattrDescription(name)})
+	}
+	outputResult.Result = xattrs
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	printXattrs(xattrs)
+	return nil
+}
+
+func printXattrs(xattrs []*Xattr) {
+	header := []string{common.ROW_NAME, common.ROW_VALUE, common.ROW_DESCRIPTION}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, x := range xattrs {
+		rows = append(rows, []string{x.Name, x.Value, x.Description})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no extended attribute")
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xattr
+
+import (
+	"sort"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/pkg/xattr"
+	"github.com/spf13/cobra"
+)
+
+const (
+	XATTR_LIST_EXAMPLE = `Examples:
+   $ dingo fs xattr list /mnt/dingofs
+
+   $ dingo fs xattr list /mnt/dingofs/file1 --format json`
+)
+
+type listOptions struct {
+	path   string
+	format string
+}
+
+func NewXattrListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "list PATH [OPTIONS]",
+		Short:   "List extended attributes of path, including dingofs xattrs",
+		Args:    utils.ExactArgs(1),
+		Example: XATTR_LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.path = args[0]
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runList(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+// dingofs xattrs are not returned by listxattr, so readable ones are probed
+func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) error {
+	if err := checkDingoFSPath(options.path); err != nil {
+		return err
+	}
+
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+	names, err := xattr.List(options.path)
+	if err != nil {
+		outputResult.Error = errno.FromError(xattrError("listxattr", err))
+	}
+	for _, x := range dingofsXattrs {
+		if x.readable && !utils.Contains(names, x.name) {
+			names = append(names, x.name)
+		}
+	}
+	sort.Strings(names)
+
+	xattrs := []*Xattr{}
+	for _, name := range names {
+		// dingofs xattrs of mountpoint only exist on root
+		value, err := xattr.Get(options.path, name)
+		if err != nil {
+			continue
+		}
+		xattrs = append(xattrs, &Xattr{Name: name, Value: formatXattrValue(value), Description: xattrDescription(name)})
+	}
+	outputResult.Result = xattrs
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+
+	printXattrs(xattrs)
+	return nil
+}
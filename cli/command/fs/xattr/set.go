@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package xattr
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	XATTR_SET_EXAMPLE = `Examples:
+   $ dingo fs xattr set /mnt/dingofs/dir1 cache.drop scope=local
+
+   $ dingo fs xattr set /mnt/dingofs/file1 user.owner alice`
+)
+
+type setOptions struct {
+	path  string
+	name  string
+	value string
+}
+
+func NewXattrSetCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options setOptions
+
+	cmd := &cobra.Command{
+		Use:     "set PATH NAME VALUE [OPTIONS]",
+		Short:   "Set an extended attribute of path",
+		Args:    utils.ExactArgs(3),
+		Example: XATTR_SET_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.path = args[0]
+			options.name = fullXattrName(args[1])
+			options.value = args[2]
+
+			return runSet(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	return cmd
+}
+
+func runSet(cmd *cobra.Command, dingocli *cli.DingoCli, options setOptions) error {
+	if err := checkDingoFSPath(options.path); err != nil {
+		return err
+	}
+
+	action := cli.NewAction(cli.ACTION_SYSCALL, "setxattr(%s, %s, %s)", options.path, options.name, options.value)
+	err := dingocli.Perform(action, func() error {
+		return unix.Setxattr(options.path, options.name, []byte(options.value), 0)
+	})
+	if err != nil {
+		return xattrError(options.name, err)
+	}
+
+	if dingocli.IsDryRun() {
+		return nil
+	}
+	fmt.Printf("Successfully set %s of %s\n", options.name, options.path)
+	return nil
+}
@@ -35,11 +35,13 @@ import (
 
 const (
 	MDS_STATUS_EXAMPLE = `Examples:
-   $ dingo mds status`
+   $ dingo mds status
+   $ dingo mds status --resolve-only  # Only print mds addresses, e.g. resolved from dns srv record`
 )
 
 type statusOptions struct {
-	format string
+	format      string
+	resolveOnly bool
 }
 
 func NewStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
@@ -68,12 +70,15 @@ func NewStatusCommand(dingocli *cli.DingoCli) *cobra.Command {
 	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
 	utils.AddConfigFileFlag(cmd)
 	utils.AddFormatFlag(cmd)
+	utils.AddListFlags(cmd)
 
 	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
 	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
 	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+	utils.AddUint32Flag(cmd, utils.RPCCONCURRENCY, "Number of rpc requests sent at once")
 
 	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+	cmd.Flags().BoolVar(&options.resolveOnly, "resolve-only", false, "Only print resolved mds addresses")
 
 	return cmd
 }
@@ -82,6 +87,10 @@ func runStatus(cmd *cobra.Command, dingocli *cli.DingoCli, options statusOptions
 	outputResult := &common.OutputResult{
 		Error: errno.ERR_OK,
 	}
+	if options.resolveOnly {
+		return runResolveOnly(cmd, dingocli, options)
+	}
+
 	// new rpc
 	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, "GetMDSList")
 	if err != nil {
@@ -117,15 +126,20 @@ func runStatus(cmd *cobra.Command, dingocli *cli.DingoCli, options statusOptions
 	}
 
 	// set table header
-	header := []string{common.ROW_ID, common.ROW_ADDR, common.ROW_STATE, common.ROW_LASTONLINETIME, common.ROW_ONLINE_STATE}
+	header := []string{common.ROW_ID, common.ROW_ADDR, common.ROW_VERSION, common.ROW_STATE, common.ROW_LASTONLINETIME, common.ROW_ONLINE_STATE}
 	table.SetHeader(header)
 	// fill table
 	mdsInfos := result.GetMdses()
+	// versions of all mds are got at once
+	versions, versionErrs := rpc.Batch(cmd, mdsInfos, func(mdsInfo *mds.MDS) (string, error) {
+		return rpc.GetMDSVersion(fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort()))
+	})
 	rows := make([]map[string]string, 0)
-	for _, mdsInfo := range mdsInfos {
+	for i, mdsInfo := range mdsInfos {
 		row := make(map[string]string)
 		row[common.ROW_ID] = fmt.Sprintf("%d", mdsInfo.GetId())
 		row[common.ROW_ADDR] = fmt.Sprintf("%s:%d", mdsInfo.GetLocation().GetHost(), mdsInfo.GetLocation().GetPort())
+		row[common.ROW_VERSION] = utils.Ternary(versionErrs[i] == nil, versions[i], common.ROW_VALUE_UNKNOWN)
 		row[common.ROW_STATE] = mdsInfo.GetState().String()
 		unixTime := int64(mdsInfo.GetLastOnlineTimeMs())
 		t := time.Unix(unixTime/1000, (unixTime%1000)*1000000)
@@ -144,3 +158,26 @@ func runStatus(cmd *cobra.Command, dingocli *cli.DingoCli, options statusOptions
 
 	return nil
 }
+
+func runResolveOnly(cmd *cobra.Command, dingocli *cli.DingoCli, options statusOptions) error {
+	addrs, err := utils.GetMDSAddrSlice(cmd)
+	if options.format == "json" {
+		outputResult := &common.OutputResult{
+			Error:  errno.ERR_OK,
+			Result: addrs,
+		}
+		if err != nil {
+			outputResult.Error = errno.ERR_HOSTNAME_NOT_RESOLVED.E(err)
+		}
+		return output.OutputJson(outputResult)
+	}
+	if err != nil {
+		return err
+	}
+
+	dingocli.WriteOutln("mdsaddr: %s", utils.GetStringFlag(cmd, utils.DINGOFS_MDSADDR))
+	for _, addr := range addrs {
+		dingocli.WriteOutln("  %s", addr)
+	}
+	return nil
+}
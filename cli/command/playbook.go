@@ -215,7 +215,7 @@ func execute(dingocli *cli.DingoCli, options playbookOptions, idx int, hc *hosts
 	retC <- result{index: idx, host: name, out: out, err: err}
 }
 
-func output(dingocli *cli.DingoCli, ret *result) {
+func printResult(dingocli *cli.DingoCli, ret *result) {
 	dingocli.WriteOutln("")
 	out, err := ret.out, ret.err
 	dingocli.WriteOutln("%s [%s]", color.YellowString(ret.host),
@@ -237,7 +237,7 @@ func receiver(dingocli *cli.DingoCli, total int) {
 		rets[ret.index] = ret
 		for {
 			if v, ok := rets[current]; ok {
-				output(dingocli, &v)
+				printResult(dingocli, &v)
 				current++
 			} else {
 				break
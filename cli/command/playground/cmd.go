@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package playground
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/spf13/cobra"
+)
+
+// dingo playground is the same as dingo playground run, a one-command evaluation of dingofs
+func NewPlaygroundCommand(dingocli *cli.DingoCli) *cobra.Command {
+	cmd := newRunCommand(dingocli, "playground [OPTIONS]", "Run a local single-node cluster with a mounted filesystem")
+	cmd.GroupID = "DEPLOY"
+
+	cmd.AddCommand(
+		NewPlaygroundRunCommand(dingocli),
+		NewPlaygroundListCommand(dingocli),
+		NewPlaygroundRemoveCommand(dingocli),
+	)
+
+	return cmd
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package playground
+
+import (
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	PLAYGROUND_LIST_EXAMPLE = `Examples:
+   $ dingo playground list`
+)
+
+type listOptions struct {
+	format string
+}
+
+type PlaygroundItem struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	CreateTime string `json:"create_time"`
+	MountPoint string `json:"mountpoint"`
+	Dir        string `json:"dir"`
+}
+
+func NewPlaygroundListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [OPTIONS]",
+		Short:   "List playgrounds",
+		Args:    utils.NoArgs,
+		Example: PLAYGROUND_LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runList(dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddFormatFlag(cmd)
+	utils.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+// a playground recorded as running whose components are all gone is exited,
+// e.g. dingo is killed before tearing down, which can be cleaned by playground remove
+func runList(dingocli *cli.DingoCli, options listOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	playgrounds, err := dingocli.Storage().GetPlaygrounds("%")
+	if err != nil {
+		return errno.ERR_GET_ALL_PLAYGROUND_FAILED.E(err)
+	}
+	items := []*PlaygroundItem{}
+	for _, record := range playgrounds {
+		item := &PlaygroundItem{
+			Name:       record.Name,
+			Status:     record.Status,
+			CreateTime: record.CreateTime.Format("2006-01-02 15:04:05"),
+			MountPoint: record.MountPoint,
+		}
+		if layout, err := NewLayout(dingocli, record.Name); err == nil {
+			item.Dir = layout.Dir
+			if !layout.IsRunning() {
+				item.Status = PLAYGROUND_STATUS_EXITED
+			}
+		}
+		items = append(items, item)
+	}
+	outputResult.Result = items
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_NAME, common.ROW_STATUS, common.ROW_CREATE_TIME, common.ROW_MOUNTPOINT, common.ROW_PATH}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, item := range items {
+		rows = append(rows, []string{item.Name, item.Status, item.CreateTime, item.MountPoint, item.Dir})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no playground")
+
+	return nil
+}
@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package playground
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	compmgr "github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+)
+
+const (
+	PLAYGROUND_DIR          = "playground"
+	PLAYGROUND_DEFAULT_NAME = "playground"
+
+	// status recorded in storage, a running playground whose dingo is killed stays running
+	PLAYGROUND_STATUS_STARTING = "starting"
+	PLAYGROUND_STATUS_RUNNING  = "running"
+	PLAYGROUND_STATUS_EXITED   = "exited"
+
+	PLAYGROUND_MINIO_CONTAINER = "dingo-playground-%s-minio"
+	PLAYGROUND_STOP_TIMEOUT    = 10 * time.Second
+	PLAYGROUND_CHECK_INTERVAL  = 500 * time.Millisecond
+)
+
+var (
+	// started in order and stopped in reverse order
+	PLAYGROUND_COMPONENTS = []string{compmgr.DINGO_MDS, compmgr.DINGO_DACHE, compmgr.DINGO_CLIENT}
+
+	playgroundNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+)
+
+/*
+ * $HOME/.dingo/playground/<name>
+ *   - /conf/{dingo-mds,dingo-cache,dingo-client}.conf
+ *   - /data/{minio,dingo-cache,dingo-client}
+ *   - /logs/{dingo-mds,dingo-cache,dingo-client}
+ *   - /run/{dingo-mds,dingo-cache,dingo-client}.pid
+ *   - /mnt, mountpoint unless --mountpoint is specified
+ */
+type Layout struct {
+	Name string
+	Dir  string
+}
+
+func NewLayout(dingocli *cli.DingoCli, name string) (*Layout, error) {
+	if !playgroundNameRegex.MatchString(name) {
+		return nil, fmt.Errorf("invalid playground name %s, should consist of letters, digits, '_' and '-'", name)
+	}
+	return &Layout{Name: name, Dir: filepath.Join(dingocli.RootDir(), PLAYGROUND_DIR, name)}, nil
+}
+
+func (l *Layout) ConfFile(component string) string {
+	return filepath.Join(l.Dir, "conf", component+".conf")
+}
+
+func (l *Layout) DataDir(component string) string {
+	return filepath.Join(l.Dir, "data", component)
+}
+
+func (l *Layout) LogDir(component string) string {
+	return filepath.Join(l.Dir, "logs", component)
+}
+
+func (l *Layout) PidFile(component string) string {
+	return filepath.Join(l.Dir, "run", component+".pid")
+}
+
+func (l *Layout) MountPoint() string {
+	return filepath.Join(l.Dir, "mnt")
+}
+
+func (l *Layout) MinioContainer() string {
+	return fmt.Sprintf(PLAYGROUND_MINIO_CONTAINER, l.Name)
+}
+
+func (l *Layout) Mkdir() error {
+	dirs := []string{filepath.Join(l.Dir, "conf"), filepath.Join(l.Dir, "run"), l.DataDir("minio"), l.MountPoint()}
+	for _, component := range PLAYGROUND_COMPONENTS {
+		dirs = append(dirs, l.DataDir(component), l.LogDir(component))
+	}
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errno.ERR_CREATE_DIRECTORY_FAILED.E(err)
+		}
+	}
+	return nil
+}
+
+// pid of the component started by the playground, 0 if it is not running,
+// the command line is checked as the pid may be reused after the playground exits
+func (l *Layout) RunningPid(component string) int {
+	data, err := os.ReadFile(l.PidFile(component))
+	if err != nil {
+		return 0
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0
+	}
+	cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil || !strings.Contains(string(cmdline), component) {
+		return 0
+	}
+	return pid
+}
+
+func (l *Layout) IsRunning() bool {
+	for _, component := range PLAYGROUND_COMPONENTS {
+		if l.RunningPid(component) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func isMounted(mountpoint string) bool {
+	mountpoints, err := utils.GetDingoFSMountPoints()
+	if err != nil {
+		return false
+	}
+	for _, mp := range mountpoints {
+		if mp.MountPoint == mountpoint {
+			return true
+		}
+	}
+	return false
+}
+
+// terminate the process and kill it if it does not exit in PLAYGROUND_STOP_TIMEOUT
+func stopProcess(dingocli *cli.DingoCli, component string, pid int) error {
+	action := cli.NewAction(cli.ACTION_SYSCALL, "kill(pid=%d, SIGTERM) of %s", pid, component)
+	if err := dingocli.Perform(action, func() error { return syscall.Kill(pid, syscall.SIGTERM) }); err != nil && err != syscall.ESRCH {
+		return err
+	}
+	if dingocli.IsDryRun() {
+		return nil
+	}
+	deadline := time.Now().Add(PLAYGROUND_STOP_TIMEOUT)
+	for time.Now().Before(deadline) {
+		if err := syscall.Kill(pid, 0); err == syscall.ESRCH {
+			return nil
+		}
+		time.Sleep(PLAYGROUND_CHECK_INTERVAL / 5)
+	}
+	logger.Warnf("%s (pid %d) does not exit in %s, kill it", component, pid, PLAYGROUND_STOP_TIMEOUT)
+	if err := syscall.Kill(pid, syscall.SIGKILL); err != nil && err != syscall.ESRCH {
+		return err
+	}
+	return nil
+}
+
+// Teardown umounts the filesystem, stops components and the object store, and removes
+// files of the playground unless keep, it goes on after failures and returns the first one
+func Teardown(dingocli *cli.DingoCli, l *Layout, mountpoint string, keep bool) error {
+	var firstErr error
+	record := func(err error) {
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if mountpoint != "" && isMounted(mountpoint) {
+		action := cli.NewAction(cli.ACTION_SYSCALL, "umount(%s)", mountpoint)
+		err := dingocli.Perform(action, func() error {
+			if err := syscall.Unmount(mountpoint, 0); err != nil {
+				return syscall.Unmount(mountpoint, syscall.MNT_DETACH)
+			}
+			return nil
+		})
+		record(err)
+	}
+	for i := len(PLAYGROUND_COMPONENTS) - 1; i >= 0; i-- {
+		component := PLAYGROUND_COMPONENTS[i]
+		if pid := l.RunningPid(component); pid > 0 {
+			if err := stopProcess(dingocli, component, pid); err != nil {
+				record(fmt.Errorf("stop %s (pid %d) failed: %v", component, pid, err))
+			}
+		}
+	}
+
+	// minio container is removed by name, no container engine means no container
+	if _, err := exec.LookPath(dingocli.Engine()); err == nil {
+		action := cli.NewAction(cli.ACTION_COMMAND, "%s rm -f %s", dingocli.Engine(), l.MinioContainer())
+		record(dingocli.Perform(action, func() error {
+			out, err := exec.Command(dingocli.Engine(), "rm", "-f", l.MinioContainer()).CombinedOutput()
+			if err != nil && !strings.Contains(strings.ToLower(string(out)), "no such container") {
+				return fmt.Errorf("remove container %s failed: %s", l.MinioContainer(), strings.TrimSpace(string(out)))
+			}
+			return nil
+		}))
+	}
+
+	// never remove files through a mountpoint which is still mounted
+	if !keep && !dingocli.IsDryRun() && mountpoint != "" && isMounted(mountpoint) {
+		record(fmt.Errorf("%s is still mounted, %s is kept", mountpoint, l.Dir))
+	} else if !keep {
+		action := cli.NewAction(cli.ACTION_FILE, "remove %s", l.Dir)
+		record(dingocli.Perform(action, func() error { return os.RemoveAll(l.Dir) }))
+	}
+
+	action := cli.NewAction(cli.ACTION_FILE, "delete playground %s from database", l.Name)
+	record(dingocli.Perform(action, func() error {
+		if err := dingocli.Storage().DeletePlayground(l.Name); err != nil {
+			return errno.ERR_DELETE_PLAYGROUND_FAILED.E(err)
+		}
+		return nil
+	}))
+	return firstErr
+}
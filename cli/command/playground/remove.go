@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package playground
+
+import (
+	"fmt"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	PLAYGROUND_REMOVE_EXAMPLE = `Examples:
+   # clean up a playground which is not torn down, e.g. dingo is killed
+   $ dingo playground remove playground`
+)
+
+type removeOptions struct {
+	name string
+	keep bool
+}
+
+func NewPlaygroundRemoveCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options removeOptions
+
+	cmd := &cobra.Command{
+		Use:     "remove NAME [OPTIONS]",
+		Aliases: []string{"rm"},
+		Short:   "Stop and remove playground",
+		Args:    utils.ExactArgs(1),
+		Example: PLAYGROUND_REMOVE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+
+			options.name = args[0]
+
+			return runRemove(dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().BoolVar(&options.keep, "keep", false, "Keep configuration, data and logs of playground")
+
+	utils.AddConfigFileFlag(cmd)
+
+	return cmd
+}
+
+func runRemove(dingocli *cli.DingoCli, options removeOptions) error {
+	layout, err := NewLayout(dingocli, options.name)
+	if err != nil {
+		return err
+	}
+	playgrounds, err := dingocli.Storage().GetPlaygrounds(options.name)
+	if err != nil {
+		return errno.ERR_GET_PLAYGROUND_BY_NAME_FAILED.E(err)
+	} else if len(playgrounds) == 0 && !utils.PathExist(layout.Dir) {
+		return errno.ERR_PLAYGROUND_NOT_FOUND.S(options.name)
+	}
+
+	mountpoint := layout.MountPoint()
+	if len(playgrounds) > 0 {
+		mountpoint = playgrounds[0].MountPoint
+	}
+	if err := Teardown(dingocli, layout, mountpoint, options.keep); err != nil {
+		return err
+	}
+	if !dingocli.IsDryRun() {
+		fmt.Printf("Successfully removed playground %s\n", options.name)
+	}
+	return nil
+}
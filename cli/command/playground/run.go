@@ -0,0 +1,537 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package playground
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	compmgr "github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/dingodb/dingocli/pkg/logger"
+	pbmdserror "github.com/dingodb/dingocli/proto/dingofs/proto/error"
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+const (
+	PLAYGROUND_RUN_EXAMPLE = `Examples:
+   $ dingo playground
+
+   # components of a chosen version, files are kept for inspection after exit
+   $ dingo playground --version v4.1.0 --keep
+
+   # store data in an existing s3 instead of a local minio
+   $ dingo playground --storage s3 --s3.ak AK --s3.sk SK --s3.endpoint http://10.0.0.1:9000 --s3.bucketname playground`
+
+	PLAYGROUND_STORAGE_MINIO = "minio"
+	PLAYGROUND_STORAGE_S3    = "s3"
+
+	// meta is kept in memory of mds and lost when the playground exits
+	PLAYGROUND_META_ENGINE_DUMMY = "dummy"
+
+	PLAYGROUND_LISTEN_IP     = "127.0.0.1"
+	PLAYGROUND_MINIO_AK      = "playground"
+	PLAYGROUND_MINIO_SK      = "playground-secret"
+	PLAYGROUND_BLOCK_SIZE    = 4 * 1024 * 1024
+	PLAYGROUND_CHUNK_SIZE    = 64 * 1024 * 1024
+	PLAYGROUND_CACHE_SIZE_MB = 1024
+	PLAYGROUND_FUSE_DEVICE   = "/dev/fuse"
+)
+
+type runOptions struct {
+	name       string
+	version    string
+	mountpoint string
+	storage    string
+	metaEngine string
+	metaURL    string
+	mdsPort    uint32
+	cachePort  uint32
+	minioPort  uint32
+	minioImage string
+	noCache    bool
+	keep       bool
+	timeout    time.Duration
+}
+
+type s3Info struct {
+	ak       string
+	sk       string
+	endpoint string
+	bucket   string
+}
+
+type playground struct {
+	cmd        *cobra.Command
+	dingocli   *cli.DingoCli
+	layout     *Layout
+	options    runOptions
+	components map[string]*compmgr.Component
+	mdsAddr    string
+	cacheAddr  string
+	s3         s3Info
+	exited     chan error
+}
+
+func NewPlaygroundRunCommand(dingocli *cli.DingoCli) *cobra.Command {
+	return newRunCommand(dingocli, "run [OPTIONS]", "Run a local single-node cluster with a mounted filesystem")
+}
+
+func newRunCommand(dingocli *cli.DingoCli, use, short string) *cobra.Command {
+	var options runOptions
+
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Args:    utils.NoArgs,
+		Example: PLAYGROUND_RUN_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			switch options.storage {
+			case PLAYGROUND_STORAGE_MINIO, PLAYGROUND_STORAGE_S3:
+			default:
+				return fmt.Errorf("invalid --storage %s, should be: %s, %s", options.storage, PLAYGROUND_STORAGE_MINIO, PLAYGROUND_STORAGE_S3)
+			}
+			if options.metaEngine != PLAYGROUND_META_ENGINE_DUMMY && options.metaURL == "" {
+				return fmt.Errorf("--meta-url is required by --meta-engine %s", options.metaEngine)
+			}
+
+			return runPlayground(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	flags := cmd.Flags()
+	flags.StringVar(&options.name, "name", PLAYGROUND_DEFAULT_NAME, "Name of playground, which is also the name of filesystem and cache group")
+	flags.StringVar(&options.version, "version", compmgr.LASTEST_VERSION, "Version of dingo-mds, dingo-cache and dingo-client")
+	flags.StringVar(&options.mountpoint, "mountpoint", "", "Mountpoint of filesystem (default: mnt in directory of playground)")
+	flags.StringVar(&options.storage, "storage", PLAYGROUND_STORAGE_MINIO, "Object storage, should be: minio (a local container), s3")
+	flags.StringVar(&options.metaEngine, "meta-engine", PLAYGROUND_META_ENGINE_DUMMY, "Storage engine of mds, dummy keeps meta in memory of mds")
+	flags.StringVar(&options.metaURL, "meta-url", "", "Storage url of mds, e.g. list://10.0.0.1:22001 for dingo-store")
+	flags.Uint32Var(&options.mdsPort, "mds-port", 7400, "Port of dingo-mds")
+	flags.Uint32Var(&options.cachePort, "cache-port", 9301, "Port of dingo-cache")
+	flags.Uint32Var(&options.minioPort, "minio-port", 19000, "Port of minio")
+	flags.StringVar(&options.minioImage, "minio-image", "minio/minio:latest", "Container image of minio")
+	flags.BoolVar(&options.noCache, "no-cache", false, "Not start dingo-cache")
+	flags.BoolVar(&options.keep, "keep", false, "Keep configuration, data and logs of playground after exit")
+	flags.DurationVar(&options.timeout, "timeout", 60*time.Second, "Timeout of waiting for every component ready")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_AK, "S3 access key")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_SK, "S3 secret key")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_ENDPOINT, "S3 endpoint")
+	utils.AddStringFlag(cmd, utils.DINGOFS_S3_BUCKETNAME, "S3 bucketname")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	return cmd
+}
+
+// components run in foreground of dingo, everything is torn down when dingo exits
+// by ctrl+c, SIGTERM or exit of any component
+func runPlayground(cmd *cobra.Command, dingocli *cli.DingoCli, options runOptions) error {
+	layout, err := NewLayout(dingocli, options.name)
+	if err != nil {
+		return err
+	}
+	if layout.IsRunning() {
+		return errno.ERR_PLAYGROUND_ALREADY_RUNNING.F("%s, stop it or run 'dingo playground remove %s'", options.name, options.name)
+	}
+	if options.mountpoint == "" {
+		options.mountpoint = layout.MountPoint()
+	} else if options.mountpoint, err = filepath.Abs(options.mountpoint); err != nil {
+		return err
+	}
+	if _, err := os.Stat(PLAYGROUND_FUSE_DEVICE); err != nil {
+		return errno.ERR_START_PLAYGROUND_FAILED.F("fuse is required to mount filesystem: %v", err)
+	}
+
+	// leftover of a playground which is not torn down, e.g. dingo is killed
+	if playgrounds, err := dingocli.Storage().GetPlaygrounds(options.name); err != nil {
+		return errno.ERR_GET_PLAYGROUND_BY_NAME_FAILED.E(err)
+	} else if len(playgrounds) > 0 {
+		logger.Infof("remove leftover of playground %s", options.name)
+		if err := Teardown(dingocli, layout, playgrounds[0].MountPoint, false); err != nil {
+			return err
+		}
+	}
+
+	pg := &playground{
+		cmd:        cmd,
+		dingocli:   dingocli,
+		layout:     layout,
+		options:    options,
+		components: map[string]*compmgr.Component{},
+		mdsAddr:    fmt.Sprintf("%s:%d", PLAYGROUND_LISTEN_IP, options.mdsPort),
+		cacheAddr:  fmt.Sprintf("%s:%d", PLAYGROUND_LISTEN_IP, options.cachePort),
+		exited:     make(chan error, len(PLAYGROUND_COMPONENTS)),
+	}
+	if err := pg.install(); err != nil {
+		return err
+	}
+	if err := layout.Mkdir(); err != nil {
+		return err
+	}
+	if err := dingocli.Storage().InsertPlayground(options.name, options.mountpoint); err != nil {
+		return errno.ERR_INSERT_PLAYGROUND_FAILED.E(err)
+	}
+	pg.setStatus(PLAYGROUND_STATUS_STARTING)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	defer func() {
+		dingocli.WriteOutln("Tearing down playground %s", options.name)
+		if terr := Teardown(dingocli, layout, options.mountpoint, options.keep); terr != nil {
+			dingocli.WriteOutln("tear down playground %s failed: %v, run 'dingo playground remove %s' to retry",
+				options.name, terr, options.name)
+		}
+	}()
+
+	if err := pg.start(ctx); err != nil {
+		return err
+	}
+	pg.setStatus(PLAYGROUND_STATUS_RUNNING)
+	pg.printSummary()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-pg.exited:
+		return errno.ERR_START_PLAYGROUND_FAILED.E(err)
+	}
+}
+
+func (pg *playground) setStatus(status string) {
+	if err := pg.dingocli.Storage().SetPlaygroundStatus(pg.options.name, status); err != nil {
+		logger.Warnf("set status of playground %s failed: %v", pg.options.name, err)
+	}
+}
+
+// installed version is used if exists, otherwise it is downloaded
+func (pg *playground) install() error {
+	componentManager, err := compmgr.NewComponentManager()
+	if err != nil {
+		return err
+	}
+	for _, name := range PLAYGROUND_COMPONENTS {
+		if name == compmgr.DINGO_DACHE && pg.options.noCache {
+			continue
+		}
+		version, _, err := componentManager.FindVersion(name, pg.options.version)
+		if err != nil {
+			return fmt.Errorf("find %s:%s failed: %v", name, pg.options.version, err)
+		}
+		component, err := componentManager.FindInstallComponent(name, version)
+		if err != nil {
+			if component, err = componentManager.InstallComponent(name, version); err != nil {
+				return fmt.Errorf("failed to install %s binary: %v", name, err)
+			}
+		}
+		binary := filepath.Join(component.Path, component.Name)
+		if err := utils.AddExecutePermission(binary); err != nil {
+			return fmt.Errorf("failed to add execute permission for %s,error: %v", binary, err)
+		}
+		pg.components[name] = component
+	}
+	return nil
+}
+
+func (pg *playground) start(ctx context.Context) error {
+	if err := pg.startStorage(ctx); err != nil {
+		return err
+	}
+	if err := pg.startMDS(ctx); err != nil {
+		return err
+	}
+	if err := pg.createFs(ctx); err != nil {
+		return err
+	}
+	if !pg.options.noCache {
+		if err := pg.startCache(ctx); err != nil {
+			return err
+		}
+	}
+	return pg.mount(ctx)
+}
+
+func (pg *playground) startStorage(ctx context.Context) error {
+	if pg.options.storage == PLAYGROUND_STORAGE_S3 {
+		pg.s3 = s3Info{
+			ak:       utils.GetStringFlag(pg.cmd, utils.DINGOFS_S3_AK),
+			sk:       utils.GetStringFlag(pg.cmd, utils.DINGOFS_S3_SK),
+			endpoint: utils.GetStringFlag(pg.cmd, utils.DINGOFS_S3_ENDPOINT),
+			bucket:   utils.GetStringFlag(pg.cmd, utils.DINGOFS_S3_BUCKETNAME),
+		}
+		if pg.s3.ak == "" || pg.s3.sk == "" || pg.s3.endpoint == "" || pg.s3.bucket == "" {
+			return fmt.Errorf("s3 info is incomplete, please check s3.ak, s3.sk, s3.endpoint, s3.bucketname")
+		}
+		return nil
+	}
+
+	// minio only listens on localhost, so the fixed credentials are fine
+	pg.s3 = s3Info{
+		ak:       PLAYGROUND_MINIO_AK,
+		sk:       PLAYGROUND_MINIO_SK,
+		endpoint: fmt.Sprintf("http://%s:%d", PLAYGROUND_LISTEN_IP, pg.options.minioPort),
+		bucket:   "dingofs-" + strings.ToLower(pg.options.name),
+	}
+	args := []string{"run", "-d", "--name", pg.layout.MinioContainer(),
+		"-p", fmt.Sprintf("%s:%d:9000", PLAYGROUND_LISTEN_IP, pg.options.minioPort),
+		"-v", pg.layout.DataDir("minio") + ":/data",
+		"-e", "MINIO_ROOT_USER=" + pg.s3.ak,
+		"-e", "MINIO_ROOT_PASSWORD=" + pg.s3.sk,
+		pg.options.minioImage, "server", "/data"}
+	if out, err := exec.Command(pg.dingocli.Engine(), args...).CombinedOutput(); err != nil {
+		return errno.ERR_START_PLAYGROUND_FAILED.F("start minio container failed: %s", strings.TrimSpace(string(out)))
+	}
+	store := utils.NewS3Store(pg.s3.endpoint, pg.s3.bucket, pg.s3.ak, pg.s3.sk)
+	return pg.waitReady(ctx, "minio", func() error {
+		response, err := http.Get(pg.s3.endpoint + "/minio/health/live")
+		if err != nil {
+			return err
+		}
+		response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("minio is not live: %s", response.Status)
+		}
+		return store.CreateBucket()
+	})
+}
+
+func (pg *playground) startMDS(ctx context.Context) error {
+	conf := []string{
+		"--id=1",
+		fmt.Sprintf("--port=%d", pg.options.mdsPort),
+		"--log_dir=" + pg.layout.LogDir(compmgr.DINGO_MDS),
+		"--mds_storage_engine=" + pg.options.metaEngine,
+	}
+	if pg.options.metaURL != "" {
+		conf = append(conf, "--storage_url="+pg.options.metaURL)
+	}
+	if err := pg.writeConf(compmgr.DINGO_MDS, conf); err != nil {
+		return err
+	}
+	args := []string{"--conf=" + pg.layout.ConfFile(compmgr.DINGO_MDS)}
+	if err := pg.startProcess(compmgr.DINGO_MDS, args); err != nil {
+		return err
+	}
+	return pg.waitReady(ctx, compmgr.DINGO_MDS, func() error { return dialTCP(pg.mdsAddr) })
+}
+
+// the filesystem is created once, it exists already if mds keeps meta in an external storage
+func (pg *playground) createFs(ctx context.Context) error {
+	return pg.waitReady(ctx, "filesystem "+pg.options.name, func() error {
+		getRpc := &rpc.GetFsRpc{
+			Info:    rpc.CreateNewMdsRpcWithEndPoint(pg.cmd, []string{pg.mdsAddr}, "GetFsInfo"),
+			Request: &mds.GetFsInfoRequest{FsName: pg.options.name},
+		}
+		if response, rpcError := rpc.GetRpcResponse(getRpc.Info, getRpc); rpcError.GetCode() == errno.ERR_OK.GetCode() &&
+			response.(*mds.GetFsInfoResponse).GetError().GetErrcode() == pbmdserror.Errno_OK {
+			return nil
+		}
+
+		createRpc := &rpc.CreateFsRpc{
+			Info: rpc.CreateNewMdsRpcWithEndPoint(pg.cmd, []string{pg.mdsAddr}, "CreateFs"),
+			Request: &mds.CreateFsRequest{
+				FsName:        pg.options.name,
+				BlockSize:     PLAYGROUND_BLOCK_SIZE,
+				ChunkSize:     PLAYGROUND_CHUNK_SIZE,
+				FsType:        mds.FsType_S3,
+				Owner:         "anonymous",
+				Capacity:      math.MaxInt32,
+				PartitionType: mds.PartitionType_MONOLITHIC_PARTITION,
+				FsExtra: &mds.FsExtra{S3Info: &mds.S3Info{
+					Ak:         pg.s3.ak,
+					Sk:         pg.s3.sk,
+					Endpoint:   pg.s3.endpoint,
+					Bucketname: pg.s3.bucket,
+				}},
+			},
+		}
+		response, rpcError := rpc.GetRpcResponse(createRpc.Info, createRpc)
+		if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+			return rpcError
+		}
+		if mdsErr := response.(*mds.CreateFsResponse).GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+			return errno.ERR_RPC_FAILED.S(mdsErr.String())
+		}
+		return nil
+	})
+}
+
+func (pg *playground) startCache(ctx context.Context) error {
+	conf := []string{
+		"--id=" + uuid.New().String(),
+		"--listen_ip=" + PLAYGROUND_LISTEN_IP,
+		fmt.Sprintf("--listen_port=%d", pg.options.cachePort),
+		"--group_name=" + pg.options.name,
+		"--mds_addrs=" + pg.mdsAddr,
+		"--cache_dir=" + pg.layout.DataDir(compmgr.DINGO_DACHE),
+		fmt.Sprintf("--cache_size_mb=%d", PLAYGROUND_CACHE_SIZE_MB),
+		"--log_dir=" + pg.layout.LogDir(compmgr.DINGO_DACHE),
+	}
+	if err := pg.writeConf(compmgr.DINGO_DACHE, conf); err != nil {
+		return err
+	}
+	args := []string{"--flagfile=" + pg.layout.ConfFile(compmgr.DINGO_DACHE)}
+	if err := pg.startProcess(compmgr.DINGO_DACHE, args); err != nil {
+		return err
+	}
+	return pg.waitReady(ctx, compmgr.DINGO_DACHE, func() error { return dialTCP(pg.cacheAddr) })
+}
+
+func (pg *playground) mount(ctx context.Context) error {
+	if err := os.MkdirAll(pg.options.mountpoint, 0755); err != nil {
+		return err
+	}
+	conf := []string{
+		utils.CLIENT_LOG_DIR_FLAG + "=" + pg.layout.LogDir(compmgr.DINGO_CLIENT),
+		utils.CLIENT_CACHE_DIR_FLAG + "=" + pg.layout.DataDir(compmgr.DINGO_CLIENT),
+	}
+	if !pg.options.noCache {
+		conf = append(conf, "--cache_group="+pg.options.name)
+	}
+	if err := pg.writeConf(compmgr.DINGO_CLIENT, conf); err != nil {
+		return err
+	}
+	args := []string{
+		fmt.Sprintf("mds://%s/%s", pg.mdsAddr, pg.options.name),
+		pg.options.mountpoint,
+		"--flagfile=" + pg.layout.ConfFile(compmgr.DINGO_CLIENT),
+	}
+	if err := pg.startProcess(compmgr.DINGO_CLIENT, args); err != nil {
+		return err
+	}
+	return pg.waitReady(ctx, pg.options.mountpoint, func() error {
+		_, err := os.Stat(filepath.Join(pg.options.mountpoint, ".stats"))
+		return err
+	})
+}
+
+func (pg *playground) writeConf(component string, conf []string) error {
+	return os.WriteFile(pg.layout.ConfFile(component), []byte(strings.Join(conf, "\n")+"\n"), 0644)
+}
+
+// stdout and stderr of the component are written to <component>.out in its log directory
+func (pg *playground) startProcess(component string, args []string) error {
+	binary := filepath.Join(pg.components[component].Path, pg.components[component].Name)
+	outPath := filepath.Join(pg.layout.LogDir(component), component+".out")
+	out, err := os.OpenFile(outPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	oscmd := exec.Command(binary, args...)
+	oscmd.Stdout, oscmd.Stderr = out, out
+	// own process group, so ctrl+c of terminal reaches dingo only, which umounts before stopping components
+	oscmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := oscmd.Start(); err != nil {
+		out.Close()
+		return errno.ERR_START_PLAYGROUND_FAILED.F("start %s failed: %v", component, err)
+	}
+	logger.Infof("start %s (pid %d): %s %s", component, oscmd.Process.Pid, binary, strings.Join(args, " "))
+	if err := os.WriteFile(pg.layout.PidFile(component), []byte(fmt.Sprintf("%d\n", oscmd.Process.Pid)), 0644); err != nil {
+		logger.Warnf("write pid file of %s failed: %v", component, err)
+	}
+
+	go func() {
+		err := oscmd.Wait()
+		out.Close()
+		pg.exited <- fmt.Errorf("%s exited: %v, see %s", component, err, outPath)
+	}()
+	return nil
+}
+
+// check is retried until it succeeds, fails if any component exits or in timeout
+func (pg *playground) waitReady(ctx context.Context, name string, check func() error) error {
+	spinner := output.NewSpinner(fmt.Sprintf("Waiting for %s ready", name))
+	defer spinner.Finish()
+
+	ticker := time.NewTicker(PLAYGROUND_CHECK_INTERVAL)
+	defer ticker.Stop()
+	timeout := time.After(pg.options.timeout)
+	for {
+		err := check()
+		if err == nil {
+			return nil
+		}
+		spinner.Add64(1)
+		select {
+		case <-ctx.Done():
+			return errno.ERR_CANCEL_OPERATION
+		case exitErr := <-pg.exited:
+			return errno.ERR_START_PLAYGROUND_FAILED.E(exitErr)
+		case <-timeout:
+			return errno.ERR_START_PLAYGROUND_FAILED.F("%s is not ready in %s: %v", name, pg.options.timeout, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+func dialTCP(addr string) error {
+	conn, err := net.DialTimeout("tcp", addr, time.Second)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (pg *playground) printSummary() {
+	version := func(name string) string {
+		return fmt.Sprintf("%s:%s", name, pg.components[name].Version)
+	}
+	pg.dingocli.WriteOutln("")
+	pg.dingocli.WriteOutln("Playground %s is ready", pg.options.name)
+	pg.dingocli.WriteOutln("  mds:         %s (%s)", pg.mdsAddr, version(compmgr.DINGO_MDS))
+	if !pg.options.noCache {
+		pg.dingocli.WriteOutln("  cache group: %s, %s (%s)", pg.options.name, pg.cacheAddr, version(compmgr.DINGO_DACHE))
+	}
+	pg.dingocli.WriteOutln("  storage:     %s %s/%s", pg.options.storage, pg.s3.endpoint, pg.s3.bucket)
+	pg.dingocli.WriteOutln("  filesystem:  %s mounted at %s (%s)", pg.options.name, pg.options.mountpoint, version(compmgr.DINGO_CLIENT))
+	pg.dingocli.WriteOutln("  logs:        %s", filepath.Join(pg.layout.Dir, "logs"))
+	pg.dingocli.WriteOutln("")
+	pg.dingocli.WriteOutln("Try it in another terminal:")
+	pg.dingocli.WriteOutln("  $ dd if=/dev/zero of=%s bs=1M count=100", filepath.Join(pg.options.mountpoint, "test"))
+	pg.dingocli.WriteOutln("  $ dingo fs list --mdsaddr %s", pg.mdsAddr)
+	pg.dingocli.WriteOutln("")
+	pg.dingocli.WriteOutln("Press Ctrl+C to tear down the playground")
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	RPC_CALL_EXAMPLE = `Examples:
+   $ dingo rpc call MDSService.GetFsInfo --data '{"fs_name": "dingofs1"}'
+
+   # request from file or stdin
+   $ dingo rpc call GetFsInfo --data @request.json
+   $ echo '{"fs_id": 1}' | dingo rpc call GetFsInfo --data -
+
+   # show request without sending it
+   $ dingo --dry-run rpc call UmountFs --data '{"fs_name": "dingofs1", "client_id": "7d16a4a9"}'`
+
+	RPC_DATA_STDIN = "-"
+	RPC_DATA_FILE  = "@"
+)
+
+// methods which do not change the cluster, others require confirmation
+var readOnlyPrefixes = []string{"Get", "List", "Load", "Check", "Scan", "Query", "Lookup", "Read", "Stat", "Find"}
+
+type callOptions struct {
+	method protoreflect.MethodDescriptor
+	data   string
+	format string
+}
+
+func NewRpcCallCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options callOptions
+
+	cmd := &cobra.Command{
+		Use:     "call METHOD [OPTIONS]",
+		Short:   "Call a method of mds with request in json",
+		Args:    utils.ExactArgs(1),
+		Example: RPC_CALL_EXAMPLE,
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) != 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			names := []string{}
+			for _, method := range rpc.ListMethods() {
+				names = append(names, fmt.Sprintf("%s.%s", method.Parent().Name(), method.Name()))
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			utils.ReadCommandConfig(cmd)
+			output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+
+			method, err := rpc.FindMethod(args[0])
+			if err != nil {
+				return err
+			}
+			options.method = method
+			value, _ := cmd.Flags().GetString("data")
+			if options.data, err = readRequestData(value); err != nil {
+				return err
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runCall(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	cmd.Flags().String("data", "", "Request in json, @FILE reads it from file and - from stdin")
+
+	utils.AddBoolFlag(cmd, utils.VERBOSE, "Show more debug info")
+	utils.AddConfigFileFlag(cmd)
+	utils.AddFormatFlag(cmd)
+
+	utils.AddDurationFlag(cmd, utils.RPCTIMEOUT, "RPC timeout")
+	utils.AddDurationFlag(cmd, utils.RPCRETRYDElAY, "RPC retry delay")
+	utils.AddUint32Flag(cmd, utils.RPCRETRYTIMES, "RPC retry times")
+
+	utils.AddStringFlag(cmd, utils.DINGOFS_MDSADDR, "Specify mds address")
+
+	return cmd
+}
+
+func readRequestData(value string) (string, error) {
+	var data []byte
+	var err error
+	if value == RPC_DATA_STDIN {
+		data, err = io.ReadAll(os.Stdin)
+	} else if file, ok := strings.CutPrefix(value, RPC_DATA_FILE); ok {
+		data, err = os.ReadFile(file)
+	} else {
+		return value, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read request failed: %v", err)
+	}
+	return string(data), nil
+}
+
+func readOnlyMethod(name string) bool {
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// the request is sent like requests of other commands, with retry, redirect and token of context
+func runCall(cmd *cobra.Command, dingocli *cli.DingoCli, options callOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	request, err := rpc.NewRawRequest(options.method, options.data)
+	if err != nil {
+		return err
+	}
+	mdsRpc, err := rpc.CreateNewMdsRpc(cmd, string(options.method.Name()))
+	if err != nil {
+		return err
+	}
+	rawRpc := &rpc.RawRpc{
+		Info:    mdsRpc,
+		Method:  options.method,
+		Request: request,
+	}
+
+	methodName := rpc.FullMethodName(options.method)
+	if !dingocli.IsDryRun() && !readOnlyMethod(string(options.method.Name())) &&
+		!utils.Confirm("%s may change the cluster, are you sure to call it?", methodName) {
+		return fmt.Errorf("abort rpc call")
+	}
+
+	action := cli.NewAction(cli.ACTION_RPC, "%s(%s)", methodName, request.String())
+	var response *dynamicpb.Message
+	dingocli.Perform(action, func() error {
+		result, rpcError := rpc.GetRpcResponse(rawRpc.Info, rawRpc)
+		if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+			outputResult.Error = rpcError
+			return nil
+		}
+		response = result.(*dynamicpb.Message)
+		if mdsErr := rpc.RawResponseError(response); mdsErr != "" {
+			outputResult.Error = errno.ERR_RPC_FAILED.S(mdsErr)
+		}
+		return nil
+	})
+	if dingocli.IsDryRun() {
+		return nil
+	}
+
+	// print result
+	if options.format == "json" {
+		if response != nil {
+			if outputResult.Result, err = output.MarshalProtoJson(response); err != nil {
+				return err
+			}
+		}
+		return output.OutputJson(outputResult)
+	}
+	if response != nil {
+		data, err := output.ProtoMessageToJson(response)
+		if err != nil {
+			return err
+		}
+		fmt.Println(data)
+	}
+	if outputResult.Error.GetCode() != errno.ERR_OK.GetCode() {
+		return outputResult.Error
+	}
+	return nil
+}
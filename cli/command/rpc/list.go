@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"strings"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/table"
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	RPC_LIST_EXAMPLE = `Examples:
+   $ dingo rpc list
+
+   # methods about quota
+   $ dingo rpc list quota`
+)
+
+type listOptions struct {
+	filter string
+	format string
+}
+
+type RpcMethod struct {
+	Name     string `json:"name"`
+	Request  string `json:"request"`
+	Response string `json:"response"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+func NewRpcListCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options listOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [FILTER] [OPTIONS]",
+		Short:   "List methods which can be called by rpc call",
+		Args:    utils.RequiresMaxArgs(1),
+		Example: RPC_LIST_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				options.filter = strings.ToLower(args[0])
+			}
+			options.format = utils.GetStringFlag(cmd, utils.FORMAT)
+
+			return runList(cmd, dingocli, options)
+		},
+		SilenceUsage:          false,
+		DisableFlagsInUseLine: true,
+	}
+
+	utils.SetFlagErrorFunc(cmd)
+
+	// add flags
+	utils.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, dingocli *cli.DingoCli, options listOptions) error {
+	outputResult := &common.OutputResult{
+		Error: errno.ERR_OK,
+	}
+
+	methods := []*RpcMethod{}
+	for _, method := range rpc.ListMethods() {
+		name := string(method.FullName())
+		if options.filter != "" && !strings.Contains(strings.ToLower(name), options.filter) {
+			continue
+		}
+		methods = append(methods, &RpcMethod{
+			Name:     name,
+			Request:  string(method.Input().FullName()),
+			Response: string(method.Output().FullName()),
+			ReadOnly: readOnlyMethod(string(method.Name())),
+		})
+	}
+	outputResult.Result = methods
+
+	// print result
+	if options.format == "json" {
+		return output.OutputJson(outputResult)
+	}
+
+	header := []string{common.ROW_METHOD, common.ROW_REQUEST, common.ROW_RESPONSE, common.ROW_READONLY}
+	table.SetHeader(header)
+	rows := make([][]string, 0)
+	for _, method := range methods {
+		rows = append(rows, []string{
+			method.Name,
+			method.Request,
+			method.Response,
+			utils.Ternary(method.ReadOnly, "yes", "no"),
+		})
+	}
+	table.AppendBulk(rows)
+	table.RenderWithNoData("no method matched")
+
+	return nil
+}
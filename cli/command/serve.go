@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	"github.com/dingodb/dingocli/internal/api"
+	cliutil "github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	SERVE_EXAMPLE = `Examples:
+   $ DINGO_API_TOKEN=secret dingo serve --api --listen 0.0.0.0:8190
+   $ curl -H "Authorization: Bearer secret" http://127.0.0.1:8190/api/v1/fs
+
+   # serve https with token in file
+   $ dingo serve --api --token-file /etc/dingo/api.token --tls-cert server.crt --tls-key server.key`
+)
+
+type serveOptions struct {
+	api       bool
+	listen    string
+	tokenFile string
+	tlsCert   string
+	tlsKey    string
+	timeout   time.Duration
+	parallel  int
+}
+
+func NewServeCommand(dingocli *cli.DingoCli) *cobra.Command {
+	var options serveOptions
+
+	cmd := &cobra.Command{
+		Use:     "serve --api [OPTIONS]",
+		Short:   "Serve commands as REST/JSON api",
+		GroupID: "UTILS",
+		Args:    cliutil.NoArgs,
+		Example: SERVE_EXAMPLE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !options.api {
+				return fmt.Errorf("--api is required")
+			}
+			if (options.tlsCert == "") != (options.tlsKey == "") {
+				return fmt.Errorf("--tls-cert and --tls-key should be set together")
+			}
+			return runServe(dingocli, options)
+		},
+		DisableFlagsInUseLine: true,
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&options.api, "api", false, "Serve REST/JSON api")
+	flags.StringVar(&options.listen, "listen", api.API_DEFAULT_LISTEN, "Address to listen on")
+	flags.StringVar(&options.tokenFile, "token-file", "", "File of bearer token of api requests, default $"+api.API_TOKEN_ENV+" or a generated one")
+	flags.StringVar(&options.tlsCert, "tls-cert", "", "Certificate file to serve https")
+	flags.StringVar(&options.tlsKey, "tls-key", "", "Private key file to serve https")
+	flags.DurationVar(&options.timeout, "timeout", api.API_DEFAULT_TIMEOUT, "Timeout of every request")
+	flags.IntVar(&options.parallel, "parallel", api.API_DEFAULT_PARALLEL, "Number of commands run at the same time")
+
+	return cmd
+}
+
+// token is never passed by flag, which is visible to other users by ps
+func apiToken(options serveOptions) (string, bool, error) {
+	if options.tokenFile != "" {
+		data, err := os.ReadFile(options.tokenFile)
+		if err != nil {
+			return "", false, err
+		}
+		if token := strings.TrimSpace(string(data)); token != "" {
+			return token, false, nil
+		}
+		return "", false, fmt.Errorf("token file %s is empty", options.tokenFile)
+	}
+	if token := os.Getenv(api.API_TOKEN_ENV); token != "" {
+		return token, false, nil
+	}
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", false, err
+	}
+	return hex.EncodeToString(buf), true, nil
+}
+
+func runServe(dingocli *cli.DingoCli, options serveOptions) error {
+	token, generated, err := apiToken(options)
+	if err != nil {
+		return err
+	}
+	server, err := api.NewServer(api.Options{
+		Listen:   options.listen,
+		Token:    token,
+		TLSCert:  options.tlsCert,
+		TLSKey:   options.tlsKey,
+		Timeout:  options.timeout,
+		Parallel: options.parallel,
+		Version:  cli.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	scheme := "http"
+	if options.tlsCert != "" {
+		scheme = "https"
+	}
+	dingocli.WriteOutln("Serving api on %s://%s%s", scheme, options.listen, api.API_PREFIX)
+	if generated {
+		dingocli.WriteOutln("Bearer token: %s", token)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	return server.Serve(ctx)
+}
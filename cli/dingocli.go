@@ -23,20 +23,42 @@ import (
 
 	"github.com/dingodb/dingocli/cli/cli"
 	"github.com/dingodb/dingocli/cli/command"
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/profile"
+	"github.com/dingodb/dingocli/internal/rpc"
+	"github.com/dingodb/dingocli/internal/tracing"
 )
 
 func Execute() {
 	dingocli, err := cli.NewDingoCli()
 	if err != nil {
 		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 
 	id := dingocli.PreAudit(time.Now(), os.Args[1:])
+	defer dingocli.Recover(id, os.Args[1:])
 	cmd := command.NewDingoCliCommand(dingocli)
 	err = cmd.Execute()
+	if ferr := output.FinishOutputFile(err == nil); ferr != nil && err == nil {
+		err = ferr
+		if !output.IsJsonError() {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+	if err != nil && output.IsJsonError() {
+		output.OutputJsonError(err)
+	} else if err == nil {
+		// error is printed in json result by command
+		err = output.ResultError()
+	}
+	tracing.Finish(err)
+	rpc.CloseConnections()
+	rpc.StopDebugRpc()
+	output.StopPager()
+	profile.Finish(os.Stderr)
 	dingocli.PostAudit(id, err)
 	if err != nil {
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+)
+
+type route struct {
+	method string
+	path   string
+	// arguments of the dingo command serving the request
+	args func(r *http.Request) ([]string, error)
+	// the command runs with --yes, confirmation is skipped as the request is the confirmation,
+	// commands of other routes abort if they ask for confirmation as stdin is empty
+	assumeYes bool
+}
+
+type warmupRequest struct {
+	Path string `json:"path"`
+}
+
+var routes = []*route{
+	{http.MethodGet, "/fs", fixedArgs("fs", "list", "--format", "json"), false},
+	{http.MethodGet, "/fs/{fsname}/config", fsArgs("fs", "config", "get", "--all"), false},
+	{http.MethodGet, "/fs/{fsname}/quotas", quotaArgs, false},
+	{http.MethodGet, "/mds", fixedArgs("mds", "status", "--format", "json"), false},
+	{http.MethodGet, "/warmups", fixedArgs("fs", "warmup", "list", "--format", "json"), false},
+	{http.MethodGet, "/warmups/status", fixedArgs("fs", "warmup", "status", "--format", "json"), false},
+	{http.MethodPost, "/warmups", warmupAddArgs, true},
+	{http.MethodGet, "/components", fixedArgs("component", "list", "--format", "json"), false},
+}
+
+func fixedArgs(args ...string) func(r *http.Request) ([]string, error) {
+	return func(r *http.Request) ([]string, error) {
+		return append([]string{}, args...), nil
+	}
+}
+
+func fsArgs(args ...string) func(r *http.Request) ([]string, error) {
+	return func(r *http.Request) ([]string, error) {
+		return append(append([]string{}, args...), "--fsname", r.PathValue("fsname"), "--format", "json"), nil
+	}
+}
+
+// quota of a directory by ?path=, or quotas of all directories
+func quotaArgs(r *http.Request) ([]string, error) {
+	fsname := r.PathValue("fsname")
+	if path := r.URL.Query().Get("path"); path != "" {
+		return []string{"fs", "quota", "get", "--fsname", fsname, "--path", path, "--format", "json"}, nil
+	}
+	return []string{"fs", "quota", "list", "--fsname", fsname, "--format", "json"}, nil
+}
+
+// warmup runs in background of the client, progress is got by GET /warmups
+func warmupAddArgs(r *http.Request) ([]string, error) {
+	request := &warmupRequest{}
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		return nil, fmt.Errorf("invalid body: %v", err)
+	}
+	if !filepath.IsAbs(request.Path) {
+		return nil, fmt.Errorf("path should be an absolute path in dingofs mountpoint")
+	}
+	return []string{"fs", "warmup", "add", filepath.Clean(request.Path), "--daemon"}, nil
+}
@@ -0,0 +1,220 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/pkg/logger"
+)
+
+// REST/JSON gateway of the cli, every request runs a command of this binary in a child process, so commands keep their per-invocation state (config, rpc connections,
+// output) and a panic or hang of one command does not affect others
+const (
+	API_PREFIX           = "/api/v1"
+	API_HEALTH_PATH      = "/healthz"
+	API_TOKEN_ENV        = "DINGO_API_TOKEN"
+	API_DEFAULT_LISTEN   = "127.0.0.1:8190"
+	API_DEFAULT_TIMEOUT  = 60 * time.Second
+	API_DEFAULT_PARALLEL = 8
+	API_MAX_BODY_SIZE    = 1 << 20
+)
+
+type Options struct {
+	Listen   string
+	Token    string
+	TLSCert  string
+	TLSKey   string
+	Timeout  time.Duration
+	Parallel int
+	Version  string
+}
+
+type Server struct {
+	options    Options
+	executable string
+	running    chan struct{}
+	server     *http.Server
+}
+
+// error is same as --format json output of commands, message has the reason not in it
+type Response struct {
+	Error   *errno.ErrorCode `json:"error"`
+	Result  interface{}      `json:"result"`
+	Message string           `json:"message,omitempty"`
+}
+
+func NewServer(options Options) (*Server, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = API_DEFAULT_TIMEOUT
+	}
+	if options.Parallel <= 0 {
+		options.Parallel = API_DEFAULT_PARALLEL
+	}
+	s := &Server{
+		options:    options,
+		executable: executable,
+		running:    make(chan struct{}, options.Parallel),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+API_HEALTH_PATH, s.handleHealth)
+	for _, r := range routes {
+		mux.Handle(r.method+" "+API_PREFIX+r.path, s.authorize(s.handleRoute(r)))
+	}
+	s.server = &http.Server{
+		Addr:              options.Listen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s, nil
+}
+
+// blocks until ctx is done or the server fails
+func (s *Server) Serve(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.options.Listen)
+	if err != nil {
+		return errno.ERR_START_API_SERVER_FAILED.E(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		if s.options.TLSCert != "" {
+			done <- s.server.ServeTLS(listener, s.options.TLSCert, s.options.TLSKey)
+		} else {
+			done <- s.server.Serve(listener)
+		}
+	}()
+
+	select {
+	case err := <-done:
+		return errno.ERR_START_API_SERVER_FAILED.E(err)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.options.Timeout)
+		defer cancel()
+		return s.server.Shutdown(shutdownCtx)
+	}
+}
+
+func (s *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.options.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="dingo"`)
+			writeResponse(w, http.StatusUnauthorized, &Response{Error: errno.ERR_API_UNAUTHORIZED})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, http.StatusOK, &Response{
+		Error:  errno.ERR_OK,
+		Result: map[string]string{"status": "ok", "version": s.options.Version},
+	})
+}
+
+func (s *Server) handleRoute(rt *route) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, API_MAX_BODY_SIZE)
+		args, err := rt.args(r)
+		if err != nil {
+			writeResponse(w, http.StatusBadRequest, &Response{Error: errno.ERR_API_INVALID_REQUEST, Message: err.Error()})
+			return
+		}
+		// context of configuration file is chosen by request
+		if context := r.URL.Query().Get("context"); context != "" {
+			args = append(args, "--context", context)
+		}
+
+		if rt.assumeYes {
+			args = append(args, "--yes")
+		}
+
+		start := time.Now()
+		status, response := s.runCommand(r.Context(), args)
+		logger.Infof("api %s %s -> dingo %s: %d in %s", r.Method, r.URL.Path, strings.Join(args, " "), status, time.Since(start))
+		writeResponse(w, status, response)
+	}
+}
+
+// commands wait for a free slot, so a burst of requests does not start too many processes
+func (s *Server) runCommand(ctx context.Context, args []string) (int, *Response) {
+	select {
+	case s.running <- struct{}{}:
+		defer func() { <-s.running }()
+	case <-ctx.Done():
+		return http.StatusServiceUnavailable, &Response{Error: errno.ERR_API_COMMAND_FAILED, Message: ctx.Err().Error()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.options.Timeout)
+	defer cancel()
+	args = append(args, "--no-pager", "--color", "never")
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.executable, args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	err := cmd.Run()
+
+	// commands print {"error": ..., "result": ...} with --format json, also for failures,
+	// the error envelope is decoded by ErrorCode.UnmarshalJSON
+	response := &Response{}
+	if json.Unmarshal(stdout.Bytes(), response) == nil && response.Error != nil {
+		if response.Error.GetCode() != errno.ERR_OK.GetCode() {
+			response.Message = strings.TrimSpace(stderr.String())
+			return http.StatusInternalServerError, response
+		}
+		return http.StatusOK, response
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return http.StatusGatewayTimeout, &Response{Error: errno.ERR_API_COMMAND_FAILED,
+			Message: fmt.Sprintf("no response in %s", s.options.Timeout)}
+	} else if err != nil {
+		return http.StatusInternalServerError, &Response{Error: errno.ERR_API_COMMAND_FAILED,
+			Message: strings.TrimSpace(stderr.String() + "\n" + err.Error())}
+	}
+	// commands without json output, e.g. warmup add
+	return http.StatusOK, &Response{Error: errno.ERR_OK, Result: map[string]string{"output": strings.TrimSpace(stdout.String())}}
+}
+
+func writeResponse(w http.ResponseWriter, status int, response *Response) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(&Response{Error: errno.ERR_API_COMMAND_FAILED, Message: "marshal response failed: " + err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append([]byte(logger.Redact(string(data))), '\n'))
+}
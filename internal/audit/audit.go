@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/pkg/logger"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+)
+
+// every invocation of dingo is appended to ~/.dingo/audit.log as a line of json,
+// the file is rotated by size into audit-<time>.log next to it, so a shared
+// production host keeps a bounded history of who ran what and how it ended
+const (
+	AUDIT_LOG_FILE        = "audit.log"
+	AUDIT_LOG_MAX_SIZE    = 10 // megabytes
+	AUDIT_LOG_MAX_BACKUPS = 5
+
+	STATUS_ABORT   = "abort"
+	STATUS_SUCCESS = "success"
+	STATUS_FAIL    = "fail"
+	STATUS_CANCEL  = "cancel"
+)
+
+// args and command are redacted, duration is in milliseconds
+type Entry struct {
+	Id        string    `json:"id"`
+	Time      time.Time `json:"time"`
+	User      string    `json:"user"`
+	Hostname  string    `json:"hostname"`
+	WorkDir   string    `json:"work_dir"`
+	Args      []string  `json:"args"`
+	Command   string    `json:"command"`
+	Duration  int64     `json:"duration_ms"`
+	Status    string    `json:"status"`
+	ExitCode  int       `json:"exit_code"`
+	ErrorCode int       `json:"error_code,omitempty"`
+	Error     string    `json:"error,omitempty"`
+
+	args []string // secrets of flags are registered while running, args are redacted again in Finish
+}
+
+// entry of an invocation starting now, finished by Finish
+func NewEntry(now time.Time, args []string) *Entry {
+	entry := &Entry{
+		Id:     fmt.Sprintf("%s-%d", now.Format("20060102150405"), os.Getpid()),
+		Time:   now,
+		User:   currentUser(),
+		Status: STATUS_ABORT,
+		args:   args,
+	}
+	entry.Hostname, _ = os.Hostname()
+	entry.WorkDir, _ = os.Getwd()
+	entry.redact()
+	return entry
+}
+
+func (e *Entry) redact() {
+	e.Args = logger.RedactArgs(e.args)
+	e.Command = "dingo " + strings.Join(e.Args, " ")
+}
+
+func currentUser() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+func (e *Entry) Finish(now time.Time, status string, exitCode, errorCode int, err error) {
+	e.Duration = now.Sub(e.Time).Milliseconds()
+	e.Status = status
+	e.ExitCode = exitCode
+	e.ErrorCode = errorCode
+	e.redact()
+	if err != nil {
+		e.Error = logger.Redact(err.Error())
+	}
+}
+
+func (e *Entry) DurationString() string {
+	return (time.Duration(e.Duration) * time.Millisecond).String()
+}
+
+// Append writes the entry to file, and rotates it if it is too large
+func Append(filename string, entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	writer := &lumberjack.Logger{
+		Filename:   filename,
+		MaxSize:    AUDIT_LOG_MAX_SIZE,
+		MaxBackups: AUDIT_LOG_MAX_BACKUPS,
+		LocalTime:  true,
+	}
+	defer writer.Close()
+	_, err = writer.Write(append(data, '\n'))
+	return err
+}
+
+// Read returns entries of file and its rotated backups, oldest first,
+// invalid lines (e.g. truncated by a full disk) are skipped
+func Read(filename string) ([]*Entry, error) {
+	ext := filepath.Ext(filename)
+	backups, err := filepath.Glob(strings.TrimSuffix(filename, ext) + "-*" + ext)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*Entry{}
+	for _, file := range append(backups, filename) {
+		items, err := readFile(file)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		entries = append(entries, items...)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+	return entries, nil
+}
+
+func readFile(filename string) ([]*Entry, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := []*Entry{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		entry := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
@@ -143,6 +143,11 @@ const (
 
 	// upgrade
 	KEY_UPGRADE_FLAG = "UPGRADE_FLAG"
+	KEY_MDS_LEADER   = "MDS_LEADER"
+
+	// systemd
+	KEY_COMPONENT_BINARIES = "COMPONENT_BINARIES"
+	KEY_COMPONENT_VERSION  = "COMPONENT_VERSION"
 
 	// env
 	KEY_ENV_MDS_ADDR = "cluster_mds_addr"
@@ -51,6 +51,7 @@ const (
 	ROW_UUID           = "uuid"
 	ROW_NAME           = "name"
 	ROW_NLINK          = "nlink"
+	ROW_MODE           = "mode"
 	ROW_NUM            = "num"
 	ROW_ONLINE_STATE   = "onlineState"
 	ROW_OPERATION      = "operation"
@@ -103,6 +104,10 @@ const (
 	ROW_INODES_IUSED      = "iused"
 	ROW_INODES_PERCENT    = "iuse%"
 	ROW_INODES_REAL_IUSED = "realiused"
+	ROW_USED_DELTA        = "delta"
+	ROW_INODES_DELTA      = "idelta"
+	ROW_AVAIL             = "avail"
+	ROW_INODES_IFREE      = "ifree"
 
 	//fuse
 	ROW_FUSE_CONNECTION = "CONNECTION"
@@ -111,6 +116,20 @@ const (
 	//mds
 	ROW_MDS_NUM = "mdsnum"
 
+	// warmup
+	ROW_FINISHED = "finished"
+	ROW_ERRORS   = "errors"
+	ROW_PROGRESS = "progress"
+	ROW_RATE     = "rate"
+
+	// fs check
+	ROW_DETAIL = "detail"
+
+	// local mountpoint
+	ROW_PID    = "pid"
+	ROW_UPTIME = "uptime"
+	ROW_HEALTH = "health"
+
 	// delete subdir
 	ROW_DELETE_INODES = "delete inodes"
 
@@ -126,4 +145,81 @@ const (
 	ROW_WANT_LENGTH = "wantLength"
 	ROW_GOT_INODES  = "gotInodes"
 	ROW_GOT_LENGTH  = "gotLength"
+
+	// fs bench
+	ROW_ITEM  = "item"
+	ROW_VALUE = "value"
+	ROW_COST  = "cost"
+
+	// fs gc
+	ROW_MTIME = "mtime"
+
+	// fs config
+	ROW_MUTABLE = "mutable"
+
+	// fs profile
+	ROW_OP             = "op"
+	ROW_COUNT          = "count"
+	ROW_OPS_PER_SECOND = "ops/s"
+	ROW_AVG_LATENCY    = "avg"
+	ROW_MAX_LATENCY    = "max"
+	ROW_TIME_PERCENT   = "time%"
+
+	// fs summary
+	ROW_RANGE         = "range"
+	ROW_FILES_PERCENT = "files%"
+	ROW_SIZE_PERCENT  = "size%"
+
+	// fs analyze
+	ROW_ATIME = "atime"
+
+	// fs xattr
+	ROW_DESCRIPTION = "description"
+
+	// fs lsof
+	ROW_CLIENT_PID = "clientPid"
+	ROW_COMMAND    = "command"
+	ROW_FD         = "fd"
+	ROW_AGE        = "age"
+
+	// fs chunk
+	ROW_CACHE = "cache"
+
+	// fs quota report
+	ROW_WARN_AT = "warnAt"
+
+	// fs storage probe
+	ROW_STEP    = "step"
+	ROW_LATENCY = "latency"
+	ROW_CAUSE   = "cause"
+
+	// auth status
+	ROW_CONTEXT    = "context"
+	ROW_USER       = "user"
+	ROW_ROLE       = "role"
+	ROW_TOKEN      = "token"
+	ROW_LOGIN_AT   = "loginAt"
+	ROW_EXPIRES_AT = "expiresAt"
+
+	// rpc list
+	ROW_METHOD   = "method"
+	ROW_REQUEST  = "request"
+	ROW_RESPONSE = "response"
+
+	// fs metrics
+	ROW_LABELS = "labels"
+
+	// audit
+	ROW_EXECUTE_TIME = "executeTime"
+	ROW_DURATION     = "duration"
+	ROW_EXIT_CODE    = "exitCode"
+	ROW_ERROR_CODE   = "errorCode"
+	ROW_WORK_DIR     = "workDir"
+
+	// fs versions
+	ROW_EXPECTED = "expected"
+
+	// fs slowlog
+	ROW_PATH_PREFIX = "pathPrefix"
+	ROW_P99_LATENCY = "p99"
 )
@@ -41,6 +41,7 @@ type ComponentManager struct {
 	avaliable     []*Component
 	repodata      map[string]*BinaryRepoData
 	mirror        string
+	commitFilter  CommitFilter
 }
 
 func NewComponentManager() (*ComponentManager, error) {
@@ -126,9 +127,33 @@ func (cm *ComponentManager) LoadAvailableComponentVersions(name string) ([]*Comp
 		})
 	}
 
+	// commit builds are only listed on demand
+	if !cm.commitFilter.IsEmpty() {
+		commits := repodata.GetCommits()
+		for _, commit := range repodata.FilterCommits(cm.commitFilter) {
+			detail := commits[commit]
+			components = append(components, &Component{
+				Name:     name,
+				Version:  commit,
+				Commit:   detail.Commit,
+				Release:  detail.BuildTime,
+				IsActive: false,
+				Path:     "",
+				URL:      URLJoin(cm.mirror, detail.Path),
+			})
+		}
+	}
+
 	return components, nil
 }
 
+// set retention filter for commit builds and reload available components
+func (cm *ComponentManager) SetCommitFilter(filter CommitFilter) error {
+	cm.commitFilter = filter
+	_, err := cm.LoadAvailableComponents()
+	return err
+}
+
 func (cm *ComponentManager) LoadAvailableComponents() ([]*Component, error) {
 	var components []*Component
 
@@ -180,6 +205,9 @@ func (cm *ComponentManager) FindVersion(name, version string) (string, *BinaryDe
 
 	default:
 		binaryDetail, ok = repodata.FindVersion(version)
+		if !ok {
+			binaryDetail, ok = repodata.FindCommit(version)
+		}
 		if !ok {
 			return "", nil, fmt.Errorf("%s: version '%s' not found", name, version)
 		}
@@ -235,7 +263,7 @@ func (cm *ComponentManager) installOrUpdateComponent(name, version string, isUpd
 
 	fmt.Printf("Download %s from %s\n", name, newComponent.URL)
 
-	err = utils.DownloadFileWithProgress(newComponent.URL, newComponent.Path, newComponent.Name)
+	err = utils.DownloadFileWithProgress(newComponent.URL, newComponent.Path, newComponent.Name, downloadProgress(newComponent.Name))
 	if err != nil {
 		return nil, fmt.Errorf("failed to download %s: %v", name, err)
 	}
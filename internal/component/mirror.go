@@ -0,0 +1,170 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dingodb/dingocli/internal/utils"
+)
+
+type MirrorOptions struct {
+	Components     []string // empty means all components
+	Versions       []string // empty means all tags
+	IncludeMain    bool
+	IncludeCommits bool
+}
+
+type MirrorResult struct {
+	Name       string
+	Version    string
+	Path       string
+	Downloaded bool
+}
+
+// clone upstream repository metadata and binaries into target directory,
+// the layout is the same as upstream so the directory can be served as a mirror:
+//
+//	<target>/<component>.version
+//	<target>/<detail.Path>
+func CloneMirror(source, target string, options MirrorOptions) ([]*MirrorResult, error) {
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create mirror directory: %w", err)
+	}
+
+	names := options.Components
+	if len(names) == 0 {
+		names = ALL_COMPONENTS
+	}
+
+	var results []*MirrorResult
+	for _, name := range names {
+		remote, err := NewBinaryRepoData(source, name)
+		if err != nil {
+			return results, err
+		}
+
+		// the previous snapshot is used to skip unchanged builds
+		versionFile := filepath.Join(target, fmt.Sprintf("%s.version", name))
+		local, _ := ParseFromFile(versionFile)
+
+		filtered := FilterBinaryRepoData(remote, options)
+		for _, item := range mirrorItems(filtered) {
+			result, err := cloneBinary(source, target, name, item, local)
+			if err != nil {
+				return results, err
+			}
+			results = append(results, result)
+		}
+
+		data, err := json.MarshalIndent(filtered, "", "  ")
+		if err != nil {
+			return results, fmt.Errorf("failed to marshal %s metadata: %w", name, err)
+		}
+		if err := os.WriteFile(versionFile, data, 0644); err != nil {
+			return results, err
+		}
+	}
+
+	return results, nil
+}
+
+// keep the builds which match mirror options
+func FilterBinaryRepoData(repodata *BinaryRepoData, options MirrorOptions) *BinaryRepoData {
+	filtered := &BinaryRepoData{
+		Binary:      repodata.Binary,
+		GeneratedAt: repodata.GeneratedAt,
+		Branches:    map[string]BinaryDetail{},
+		Commits:     map[string]BinaryDetail{},
+		Tags:        map[string]BinaryDetail{},
+	}
+
+	versions := utils.Slice2Map(options.Versions)
+	for tag, detail := range repodata.GetTags() {
+		if len(versions) == 0 || versions[tag] {
+			filtered.Tags[tag] = detail
+		}
+	}
+
+	if options.IncludeMain {
+		if main, ok := repodata.GetMain(); ok {
+			filtered.Branches[MAIN_VERSION] = *main
+		}
+	}
+
+	if options.IncludeCommits {
+		for commit, detail := range repodata.GetCommits() {
+			filtered.Commits[commit] = detail
+		}
+	}
+
+	return filtered
+}
+
+type mirrorItem struct {
+	version string
+	detail  BinaryDetail
+}
+
+func mirrorItems(repodata *BinaryRepoData) []mirrorItem {
+	var items []mirrorItem
+	for tag, detail := range repodata.Tags {
+		items = append(items, mirrorItem{tag, detail})
+	}
+	for branch, detail := range repodata.Branches {
+		items = append(items, mirrorItem{branch, detail})
+	}
+	for commit, detail := range repodata.Commits {
+		items = append(items, mirrorItem{commit, detail})
+	}
+	return items
+}
+
+func findMirrorDetail(repodata *BinaryRepoData, version string) (BinaryDetail, bool) {
+	if repodata == nil {
+		return BinaryDetail{}, false
+	}
+	for _, details := range []map[string]BinaryDetail{repodata.Tags, repodata.Branches, repodata.GetCommits()} {
+		if detail, ok := details[version]; ok {
+			return detail, true
+		}
+	}
+	return BinaryDetail{}, false
+}
+
+func cloneBinary(source, target, name string, item mirrorItem, local *BinaryRepoData) (*MirrorResult, error) {
+	dest := filepath.Join(target, filepath.FromSlash(item.detail.Path))
+	result := &MirrorResult{
+		Name:    name,
+		Version: item.version,
+		Path:    dest,
+	}
+
+	previous, ok := findMirrorDetail(local, item.version)
+	if ok && previous.BuildTime == item.detail.BuildTime && previous.Path == item.detail.Path && utils.IsFileExists(dest) {
+		return result, nil
+	}
+
+	url := URLJoin(source, item.detail.Path)
+	if err := utils.DownloadFileWithProgress(url, filepath.Dir(dest), filepath.Base(dest), downloadProgress(filepath.Base(dest))); err != nil {
+		return nil, fmt.Errorf("failed to download %s:%s: %w", name, item.version, err)
+	}
+	result.Downloaded = true
+
+	return result, nil
+}
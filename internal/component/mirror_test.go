@@ -0,0 +1,73 @@
+// Copyright (c) 2025 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterBinaryRepoData(t *testing.T) {
+	repoData := &BinaryRepoData{
+		Binary: "dingo-mds",
+		Tags: map[string]BinaryDetail{
+			"v1.0.0": {Path: "/tags/v1.0.0/dingo-mds"},
+			"v1.1.0": {Path: "/tags/v1.1.0/dingo-mds"},
+		},
+		Branches: map[string]BinaryDetail{
+			"main": {Path: "/branches/main/dingo-mds"},
+		},
+		Commits: map[string]BinaryDetail{
+			"abc123": {Path: "/commits/abc123/dingo-mds"},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		options         MirrorOptions
+		expectedTags    int
+		expectedBranch  int
+		expectedCommits int
+	}{
+		{
+			name:         "all tags only",
+			options:      MirrorOptions{},
+			expectedTags: 2,
+		},
+		{
+			name:         "filter by version",
+			options:      MirrorOptions{Versions: []string{"v1.1.0"}},
+			expectedTags: 1,
+		},
+		{
+			name:            "include main and commits",
+			options:         MirrorOptions{IncludeMain: true, IncludeCommits: true},
+			expectedTags:    2,
+			expectedBranch:  1,
+			expectedCommits: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := FilterBinaryRepoData(repoData, tt.options)
+			assert.Equal(t, "dingo-mds", filtered.Binary)
+			assert.Len(t, filtered.Tags, tt.expectedTags)
+			assert.Len(t, filtered.Branches, tt.expectedBranch)
+			assert.Len(t, filtered.Commits, tt.expectedCommits)
+		})
+	}
+}
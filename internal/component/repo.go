@@ -14,7 +14,12 @@
 
 package component
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
 
 type BinaryRepoData struct {
 	Binary      string                  `json:"binary"`
@@ -22,6 +27,20 @@ type BinaryRepoData struct {
 	Branches    map[string]BinaryDetail `json:"branches"`
 	Commits     map[string]BinaryDetail `json:"commits"`
 	Tags        map[string]BinaryDetail `json:"tags"`
+
+	// commits section may contain hundreds of builds, it is kept raw
+	// and only decoded when GetCommits is called
+	rawCommits json.RawMessage
+}
+
+// filter for commit builds, zero value means no limitation
+type CommitFilter struct {
+	Since time.Time
+	Limit int
+}
+
+func (f CommitFilter) IsEmpty() bool {
+	return f.Since.IsZero() && f.Limit <= 0
 }
 
 type BinaryDetail struct {
@@ -38,10 +57,61 @@ func (b *BinaryRepoData) GetTags() map[string]BinaryDetail {
 	return b.Tags
 }
 
+func (b *BinaryRepoData) UnmarshalJSON(data []byte) error {
+	type repoData BinaryRepoData
+	aux := struct {
+		*repoData
+		Commits json.RawMessage `json:"commits"`
+	}{
+		repoData: (*repoData)(b),
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	b.Commits = nil
+	b.rawCommits = aux.Commits
+
+	return nil
+}
+
 func (b *BinaryRepoData) GetCommits() map[string]BinaryDetail {
+	if b.Commits == nil && len(b.rawCommits) > 0 {
+		commits := map[string]BinaryDetail{}
+		if err := json.Unmarshal(b.rawCommits, &commits); err == nil {
+			b.Commits = commits
+		}
+		b.rawCommits = nil
+	}
 	return b.Commits
 }
 
+// return commit builds which match the filter, newest first
+func (b *BinaryRepoData) FilterCommits(filter CommitFilter) []string {
+	commits := b.GetCommits()
+
+	var matched []string
+	for commit, detail := range commits {
+		if !filter.Since.IsZero() {
+			buildTime, err := ParseBuildTime(detail.BuildTime)
+			if err != nil || buildTime.Before(filter.Since) {
+				continue
+			}
+		}
+		matched = append(matched, commit)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return commits[matched[i]].BuildTime > commits[matched[j]].BuildTime
+	})
+
+	if filter.Limit > 0 && len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+
+	return matched
+}
+
 func (b *BinaryRepoData) GetLatest() (string, *BinaryDetail, bool) {
 	latest := "v0.0.0"
 	for version := range b.Tags {
@@ -74,6 +144,14 @@ func (b *BinaryRepoData) FindVersion(tag string) (*BinaryDetail, bool) {
 	return nil, false
 }
 
+func (b *BinaryRepoData) FindCommit(commit string) (*BinaryDetail, bool) {
+	if detail, exists := b.GetCommits()[commit]; exists {
+		return &detail, true
+	}
+
+	return nil, false
+}
+
 func (b *BinaryRepoData) GetName() string {
 	return b.Binary
 }
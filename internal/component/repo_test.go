@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -443,6 +444,42 @@ func TestBinaryRepoData_Integration(t *testing.T) {
 	assert.Len(t, data.GetCommits(), 1)
 }
 
+func TestBinaryRepoData_LazyCommits(t *testing.T) {
+	data, err := ParseBinaryRepoData([]byte(`{
+		"binary": "dingo-mds",
+		"tags": {},
+		"commits": {
+			"abc123": {"path": "/commits/abc123", "build_time": "2023-01-01T00:00:00Z"},
+			"def456": {"path": "/commits/def456", "build_time": "2023-02-01T00:00:00Z"}
+		}
+	}`))
+	require.NoError(t, err)
+
+	// commits are not decoded until requested
+	assert.Nil(t, data.Commits)
+	assert.Len(t, data.GetCommits(), 2)
+
+	detail, found := data.FindCommit("def456")
+	assert.True(t, found)
+	assert.Equal(t, "/commits/def456", detail.Path)
+}
+
+func TestBinaryRepoData_FilterCommits(t *testing.T) {
+	data := &BinaryRepoData{
+		Commits: map[string]BinaryDetail{
+			"c1": {BuildTime: "2023-01-01T00:00:00Z"},
+			"c2": {BuildTime: "2023-02-01T00:00:00Z"},
+			"c3": {BuildTime: "2023-03-01T00:00:00Z"},
+		},
+	}
+
+	since := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []string{"c3", "c2", "c1"}, data.FilterCommits(CommitFilter{}))
+	assert.Equal(t, []string{"c3", "c2"}, data.FilterCommits(CommitFilter{Since: since}))
+	assert.Equal(t, []string{"c3"}, data.FilterCommits(CommitFilter{Limit: 1}))
+}
+
 // Benchmark tests
 func BenchmarkBinaryRepoData_GetLatest(b *testing.B) {
 	data := &BinaryRepoData{
@@ -9,8 +9,18 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
 )
 
+var buildTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
 // input string maybe:
 // dingo-mds:v1.0.0
 // dingo-client
@@ -78,3 +88,39 @@ func ParseFromURL(url string) (*BinaryRepoData, error) {
 
 	return ParseBinaryRepoData(data)
 }
+
+func ParseBuildTime(value string) (time.Time, error) {
+	for _, layout := range buildTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid build time: %s", value)
+}
+
+// input string maybe:
+// 2025-01-01
+// 2025-01-01T00:00:00Z
+// 720h (relative to now)
+func ParseSince(value string) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return time.Time{}, fmt.Errorf("invalid since value '%s', duration must not be negative", value)
+		}
+		return time.Now().Add(-d), nil
+	}
+
+	t, err := ParseBuildTime(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid since value '%s', expect date or duration", value)
+	}
+	return t, nil
+}
+
+// progress of downloading component binary
+func downloadProgress(name string) func(total int64) utils.ProgressWriter {
+	return func(total int64) utils.ProgressWriter {
+		return output.NewProgress(total, "Downloading "+name, output.WithBytes())
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -342,3 +343,65 @@ func BenchmarkParseBinaryRepoData(b *testing.B) {
 		ParseBinaryRepoData(data)
 	}
 }
+
+func TestParseSince(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+		ago      time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "date",
+			input:    "2025-01-01",
+			expected: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "date time",
+			input:    "2025-01-01 08:30:00",
+			expected: time.Date(2025, 1, 1, 8, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "rfc3339",
+			input:    "2025-01-01T08:30:00+08:00",
+			expected: time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "duration",
+			input: "720h",
+			ago:   720 * time.Hour,
+		},
+		{
+			name:    "negative duration",
+			input:   "-1h",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			input:   "yesterday",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseSince(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.ago > 0 {
+				assert.WithinDuration(t, time.Now().Add(-tt.ago), result, time.Minute)
+			} else {
+				assert.True(t, tt.expected.Equal(result), "expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -302,6 +302,18 @@ func (dc *DeployConfig) GetMdsStorageUrl() string {
 	return dc.getString(CONFIG_MDS_STORAGE_URL)
 }
 
+func (dc *DeployConfig) GetDeployMode() string {
+	return dc.getString(CONFIG_DEPLOY_MODE)
+}
+
+func (dc *DeployConfig) GetDeployDir() string {
+	return dc.getString(CONFIG_DEPLOY_DIR)
+}
+
+func (dc *DeployConfig) GetComponentVersion() string {
+	return dc.getString(CONFIG_COMPONENT_VERSION)
+}
+
 type (
 	ConfFile struct {
 		Name       string
@@ -66,6 +66,12 @@ const (
 	DEFAULT_DINGODB_PROXY_SERVER_PORT       = 13000
 	DEFAULT_DINGODB_WEB_EXPORT_PORT         = 19100
 	DEFAULT_DINGO_MDS_CLUSTER_ID            = 0
+	DEFAULT_DEPLOY_DIR                      = "/opt/dingo"
+	DEFAULT_COMPONENT_VERSION               = "latest"
+
+	// deploy mode
+	DEPLOY_MODE_CONTAINER = "container"
+	DEPLOY_MODE_SYSTEMD   = "systemd"
 )
 
 type (
@@ -633,6 +639,31 @@ var (
 		false,
 		nil,
 	)
+
+	// services run in containers, or as systemd units with binaries of the local component repository
+	CONFIG_DEPLOY_MODE = itemset.insert(
+		KIND_DINGOFS,
+		"deploy_mode",
+		REQUIRE_STRING,
+		true,
+		DEPLOY_MODE_CONTAINER,
+	)
+
+	CONFIG_DEPLOY_DIR = itemset.insert(
+		KIND_DINGOFS,
+		"deploy_dir",
+		REQUIRE_STRING,
+		true,
+		DEFAULT_DEPLOY_DIR,
+	)
+
+	CONFIG_COMPONENT_VERSION = itemset.insert(
+		KIND_DINGOFS,
+		"component_version",
+		REQUIRE_STRING,
+		true,
+		DEFAULT_COMPONENT_VERSION,
+	)
 )
 
 func (i *item) Key() string {
@@ -228,9 +228,18 @@ var (
 	// 115: database/SQL (execute SQL statement: audit table)
 	ERR_GET_AUDIT_LOGS_FAILE = EC(115000, "execute SQL failed which get audit logs")
 	// 116: database/SQL (execute SQL statement: any table)
-	ERR_INSERT_CLIENT_CONFIG_FAILED = EC(116000, "execute SQL failed which insert client config")
-	ERR_SELECT_CLIENT_CONFIG_FAILED = EC(116001, "execute SQL failed which select client config")
-	ERR_DELETE_CLIENT_CONFIG_FAILED = EC(116002, "execute SQL failed which delete client config")
+	ERR_INSERT_CLIENT_CONFIG_FAILED  = EC(116000, "execute SQL failed which insert client config")
+	ERR_SELECT_CLIENT_CONFIG_FAILED  = EC(116001, "execute SQL failed which select client config")
+	ERR_DELETE_CLIENT_CONFIG_FAILED  = EC(116002, "execute SQL failed which delete client config")
+	ERR_INSERT_QUOTA_TEMPLATE_FAILED = EC(116003, "execute SQL failed which insert quota template")
+	ERR_SELECT_QUOTA_TEMPLATE_FAILED = EC(116004, "execute SQL failed which select quota templates")
+	ERR_DELETE_QUOTA_TEMPLATE_FAILED = EC(116005, "execute SQL failed which delete quota template")
+	ERR_INSERT_QUOTA_WARN_FAILED     = EC(116006, "execute SQL failed which insert quota warning threshold")
+	ERR_SELECT_QUOTA_WARN_FAILED     = EC(116007, "execute SQL failed which select quota warning thresholds")
+	ERR_DELETE_QUOTA_WARN_FAILED     = EC(116008, "execute SQL failed which delete quota warning threshold")
+	ERR_INSERT_AUTH_TOKEN_FAILED     = EC(116009, "execute SQL failed which insert auth token")
+	ERR_SELECT_AUTH_TOKEN_FAILED     = EC(116010, "execute SQL failed which select auth tokens")
+	ERR_DELETE_AUTH_TOKEN_FAILED     = EC(116011, "execute SQL failed which delete auth token")
 	// 117: database/SQL (execute SQL statement: monitor table)
 	ERR_GET_MONITOR_FAILED     = EC(117000, "execute SQL failed while get monitor")
 	ERR_REPLACE_MONITOR_FAILED = EC(117001, "execute SQL failed while replace monitor")
@@ -267,6 +276,7 @@ var (
 	ERR_VOLUME_BLOCKSIZE_BE_MULTIPLE_OF_512        = EC(221011, "volume block size be a multiple of 512B, like 1KiB, 2KiB, 3KiB...")
 	// 222: command options (client/fs)
 	ERR_FS_MOUNTPOINT_REQUIRE_ABSOLUTE_PATH = EC(222000, "mount point must be an absolute path")
+	ERR_GET_MOUNTPOINTS_FAILED              = EC(222001, "get dingofs mountpoints failed")
 
 	// 301: configure (common: invalid configure value)
 	ERR_UNSUPPORT_CONFIGURE_VALUE_TYPE = EC(301000, "unsupport configure value type")
@@ -286,6 +296,7 @@ var (
 	ERR_UNSUPPORT_DINGOADM_LOG_LEVEL      = EC(311000, "unsupport dingocli log level")
 	ERR_UNSUPPORT_DINGOADM_CONFIGURE_ITEM = EC(311001, "unsupport dingocli configure item")
 	ERR_UNSUPPORT_DINGOADM_DATABASE_URL   = EC(311002, "unsupport dingocli database url")
+	ERR_INVALID_DINGO_CONFIGURE_FILE      = EC(311003, "dingo configure file has invalid items")
 
 	// 320: configure (hosts.yaml: parse failed)
 	ERR_HOSTS_FILE_NOT_FOUND   = EC(320000, "hosts file not found")
@@ -391,11 +402,24 @@ var (
 	ERR_ENABLE_ETCD_AUTH_FAILED              = EC(410023, "enable etcd auth failed")
 
 	// 430: common (dingofs client)
-	ERR_FS_PATH_ALREADY_MOUNTED    = EC(430000, "path already mounted")
-	ERR_CREATE_FILESYSTEM_FAILED   = EC(430001, "create filesystem failed")
-	ERR_MOUNT_FILESYSTEM_FAILED    = EC(430002, "mount filesystem failed")
-	ERR_UMOUNT_FILESYSTEM_FAILED   = EC(430003, "umount filesystem failed")
-	ERR_ENCODE_INFO_TO_JSON_FAILED = EC(420004, "encode info to json failed")
+	ERR_FS_PATH_ALREADY_MOUNTED      = EC(430000, "path already mounted")
+	ERR_CREATE_FILESYSTEM_FAILED     = EC(430001, "create filesystem failed")
+	ERR_MOUNT_FILESYSTEM_FAILED      = EC(430002, "mount filesystem failed")
+	ERR_UMOUNT_FILESYSTEM_FAILED     = EC(430003, "umount filesystem failed")
+	ERR_ENCODE_INFO_TO_JSON_FAILED   = EC(420004, "encode info to json failed")
+	ERR_FILESYSTEM_IS_MOUNTED        = EC(430005, "filesystem is still mounted")
+	ERR_PURGE_FILESYSTEM_DATA_FAILED = EC(430006, "purge filesystem data failed")
+	ERR_FILESYSTEM_CHECK_FAILED      = EC(430007, "filesystem check found inconsistencies")
+	ERR_WARMUP_FAILED                = EC(430008, "warmup finished with errors")
+	ERR_FS_BENCH_FAILED              = EC(430009, "benchmark failed")
+	ERR_GC_OBJECTS_FAILED            = EC(430010, "garbage collect objects failed")
+	ERR_MIGRATE_STORAGE_FAILED       = EC(430011, "migrate storage failed")
+	ERR_FS_SYNC_FAILED               = EC(430012, "sync files failed")
+	ERR_STORAGE_PROBE_FAILED         = EC(430013, "storage probe failed")
+	ERR_QUOTA_ABOVE_WARNING          = EC(430014, "quota usage is above warning threshold")
+	ERR_IMPORT_QUOTA_FAILED          = EC(430015, "import quotas failed")
+	ERR_LOAD_META_FAILED             = EC(430016, "load metadata failed")
+	ERR_OBJECT_STORE_SHARED          = EC(430017, "object store is shared by other filesystems")
 
 	// 440: common (polarfs)
 	ERR_GET_OS_REELASE_FAILED       = EC(440000, "get os release failed")
@@ -538,7 +562,58 @@ var (
 	ERR_CREATE_META_TABLE_FAILED = EC(650000, "create meta table failed")
 
 	// 660: rpc
-	ERR_RPC_FAILED = EC(660000, "rpc request to mds cluster failed")
+	ERR_RPC_FAILED            = EC(660000, "rpc request to mds cluster failed")
+	ERR_MDS_UNREACHABLE       = EC(660001, "connect to mds cluster failed")
+	ERR_RPC_TIMEOUT           = EC(660002, "rpc request to mds cluster timed out")
+	ERR_RPC_UNAUTHENTICATED   = EC(660003, "rpc request is not authenticated by mds cluster")
+	ERR_RPC_PERMISSION_DENIED = EC(660004, "rpc request is denied by mds cluster for insufficient privilege")
+	ERR_MDS_VERSION_TOO_OLD   = EC(660005, "version of mds cluster is too old for the command")
+
+	// 670: client admin socket
+	ERR_CLIENT_ADMIN_NOT_FOUND = EC(670000, "admin socket of client not found")
+	ERR_CLIENT_ADMIN_FAILED    = EC(670001, "request to admin socket of client failed")
+
+	// 680: api server
+	ERR_API_UNAUTHORIZED        = EC(680000, "api request is not authorized")
+	ERR_API_INVALID_REQUEST     = EC(680001, "invalid api request")
+	ERR_API_COMMAND_FAILED      = EC(680002, "command of api request failed")
+	ERR_START_API_SERVER_FAILED = EC(680003, "start api server failed")
+
+	// 681: metrics
+	ERR_SCRAPE_METRICS_FAILED = EC(681000, "scrape metrics failed")
+
+	// 682: debug bundle
+	ERR_CREATE_BUNDLE_FAILED = EC(682000, "create support bundle failed")
+
+	// 683: audit log
+	ERR_READ_AUDIT_LOG_FAILED = EC(683000, "read audit log failed")
+	ERR_AUDIT_LOG_NOT_FOUND   = EC(683001, "audit log not found")
+
+	// 684: fs health
+	ERR_FS_UNHEALTHY = EC(684000, "filesystem is unhealthy")
+
+	// 685: fs versions
+	ERR_VERSION_MISMATCH = EC(685000, "versions of components mismatch")
+
+	// 686: fs config diff
+	ERR_CONFIG_DRIFT = EC(686000, "configuration of clients differs")
+
+	// 687: crash
+	ERR_COMMAND_PANIC = EC(687000, "command panicked")
+
+	// 688: playground
+	ERR_PLAYGROUND_ALREADY_RUNNING = EC(688000, "playground is already running")
+	ERR_START_PLAYGROUND_FAILED    = EC(688001, "start playground failed")
+
+	// 689: systemd deploy
+	ERR_UNSUPPORT_DEPLOY_MODE           = EC(689000, "unsupport deploy mode")
+	ERR_ROLE_NOT_SUPPORTED_BY_SYSTEMD   = EC(689001, "role is not supported by systemd deploy mode")
+	ERR_INSTALL_COMPONENT_BINARY_FAILED = EC(689002, "install component binary failed")
+	ERR_START_SYSTEMD_SERVICE_FAILED    = EC(689003, "start systemd service failed")
+	ERR_SYSTEMD_SERVICE_UNHEALTHY       = EC(689004, "systemd service is unhealthy")
+	ERR_DRAIN_CACHE_MEMBER_FAILED       = EC(689005, "drain cache member failed")
+	ERR_LOAD_UPGRADE_STATE_FAILED       = EC(689006, "load upgrade state failed")
+	ERR_SAVE_UPGRADE_STATE_FAILED       = EC(689007, "save upgrade state failed")
 
 	// 690: execuetr task (others)
 	ERR_START_CRONTAB_IN_CONTAINER_FAILED = EC(690000, "start crontab in container failed")
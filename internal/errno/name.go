@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package errno
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+/*
+ * stable error names in json output, automation should branch on them
+ * instead of the numeric code or the message:
+ *
+ *   {"error": {"code": "E_MDS_UNREACHABLE", "errno": 660001, "message": "..."}}
+ *
+ * well-known errors have their own names, others are named by category
+ * of the numeric code (see the table in errno.go), names are never changed
+ * once released.
+ */
+const (
+	NAME_OK                  = "OK"
+	NAME_INIT_FAILED         = "E_INIT_FAILED"
+	NAME_DATABASE_FAILED     = "E_DATABASE_FAILED"
+	NAME_INVALID_ARGUMENT    = "E_INVALID_ARGUMENT"
+	NAME_INVALID_CONFIG      = "E_INVALID_CONFIG"
+	NAME_OPERATION_FAILED    = "E_OPERATION_FAILED"
+	NAME_PRECHECK_FAILED     = "E_PRECHECK_FAILED"
+	NAME_EXECUTE_FAILED      = "E_EXECUTE_FAILED"
+	NAME_RPC_FAILED          = "E_RPC_FAILED"
+	NAME_RPC_TIMEOUT         = "E_RPC_TIMEOUT"
+	NAME_MDS_UNREACHABLE     = "E_MDS_UNREACHABLE"
+	NAME_HOSTNAME_UNRESOLVED = "E_HOSTNAME_UNRESOLVED"
+	NAME_CANCELED            = "E_CANCELED"
+	NAME_UNKNOWN             = "E_UNKNOWN"
+)
+
+var (
+	codeNames = map[int]string{}
+
+	// category name by the first digit of code
+	categoryNames = map[int]string{
+		1: NAME_DATABASE_FAILED,
+		2: NAME_INVALID_ARGUMENT,
+		3: NAME_INVALID_CONFIG,
+		4: NAME_OPERATION_FAILED,
+		5: NAME_PRECHECK_FAILED,
+		6: NAME_EXECUTE_FAILED,
+	}
+)
+
+func init() {
+	for e, name := range map[*ErrorCode]string{
+		ERR_OK:                    NAME_OK,
+		ERR_RPC_FAILED:            NAME_RPC_FAILED,
+		ERR_RPC_TIMEOUT:           NAME_RPC_TIMEOUT,
+		ERR_MDS_UNREACHABLE:       NAME_MDS_UNREACHABLE,
+		ERR_HOSTNAME_NOT_RESOLVED: NAME_HOSTNAME_UNRESOLVED,
+		ERR_CANCEL_OPERATION:      NAME_CANCELED,
+		ERR_UNKNOWN:               NAME_UNKNOWN,
+	} {
+		codeNames[e.Code] = name
+	}
+}
+
+func (e *ErrorCode) GetName() string {
+	if name, ok := codeNames[e.Code]; ok {
+		return name
+	} else if e.Code < 100000 {
+		return NAME_INIT_FAILED
+	} else if name, ok := categoryNames[e.Code/100000]; ok {
+		return name
+	}
+	return NAME_UNKNOWN
+}
+
+func (e *ErrorCode) GetMessage() string {
+	if e.Clue == "" {
+		return e.Description
+	}
+	return e.Description + ": " + e.Clue
+}
+
+func (e *ErrorCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code    string `json:"code"`
+		Errno   int    `json:"errno"`
+		Message string `json:"message"`
+	}{e.GetName(), e.Code, e.GetMessage()})
+}
+
+// both the envelope above and the numeric code before it, {"code": 660001, "description": "..."},
+// are accepted, so outputs of older dingo can still be decoded
+func (e *ErrorCode) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Code        json.RawMessage `json:"code"`
+		Errno       int             `json:"errno"`
+		Message     string          `json:"message"`
+		Description string          `json:"description"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(v.Code, &e.Code); err == nil {
+		e.Description = v.Description
+		return nil
+	}
+	e.Code = v.Errno
+	e.Description, e.Clue = v.Message, ""
+	// message is description with clue
+	for _, known := range elist {
+		if known.Code == e.Code && strings.HasPrefix(v.Message, known.Description) {
+			e.Description = known.Description
+			e.Clue = strings.TrimPrefix(strings.TrimPrefix(v.Message, known.Description), ": ")
+			break
+		}
+	}
+	return nil
+}
+
+// convert any error to error code, errors without code are unknown errors
+func FromError(err error) *ErrorCode {
+	var e *ErrorCode
+	if errors.As(err, &e) {
+		return e
+	}
+	return &ErrorCode{
+		Code:        ERR_UNKNOWN.Code,
+		Description: ERR_UNKNOWN.Description,
+		Clue:        err.Error(),
+	}
+}
@@ -0,0 +1,54 @@
+package errno
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetName(t *testing.T) {
+	assert := assert.New(t)
+	for e, expect := range map[*ErrorCode]string{
+		ERR_OK:                           NAME_OK,
+		ERR_MDS_UNREACHABLE:              NAME_MDS_UNREACHABLE,
+		ERR_RPC_TIMEOUT:                  NAME_RPC_TIMEOUT,
+		ERR_GET_USER_HOME_DIR_FAILED:     NAME_INIT_FAILED,
+		ERR_ID_NOT_FOUND:                 NAME_INVALID_ARGUMENT,
+		ERR_INVALID_DINGO_CONFIGURE_FILE: NAME_INVALID_CONFIG,
+		ERR_CANCEL_OPERATION:             NAME_CANCELED,
+	} {
+		assert.Equal(expect, e.GetName(), e.Description)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+	data, err := json.Marshal(map[string]interface{}{"error": FromError(errors.New("boom"))})
+	assert.NoError(err)
+	assert.JSONEq(`{"error": {"code": "E_UNKNOWN", "errno": 999999, "message": "unknown error: boom"}}`, string(data))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	e := &ErrorCode{}
+	assert.NoError(json.Unmarshal([]byte(`{"code": "E_RPC_FAILED", "errno": 660000, "message": "rpc request to mds cluster failed: timeout"}`), e))
+	assert.Equal(660000, e.GetCode())
+	assert.Equal(NAME_RPC_FAILED, e.GetName())
+	assert.Equal("timeout", e.Clue)
+	assert.Equal("rpc request to mds cluster failed: timeout", e.GetMessage())
+
+	e = &ErrorCode{}
+	assert.NoError(json.Unmarshal([]byte(`{"code": 0, "description": "success"}`), e))
+	assert.Equal(ERR_OK.GetCode(), e.GetCode())
+	assert.Equal("success", e.Description)
+
+	data, err := json.Marshal(FromError(errors.New("boom")))
+	assert.NoError(err)
+	e = &ErrorCode{}
+	assert.NoError(json.Unmarshal(data, e))
+	assert.Equal(ERR_UNKNOWN.GetCode(), e.GetCode())
+	assert.Equal("boom", e.Clue)
+}
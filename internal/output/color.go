@@ -0,0 +1,59 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+)
+
+// color policy of all output, see https://no-color.org
+//
+//	auto:   colorize if stdout is a terminal and NO_COLOR is not set
+//	always: colorize even if stdout is redirected
+//	never:  no ANSI escape codes
+const (
+	FLAG_COLOR = "color"
+
+	COLOR_AUTO   = "auto"
+	COLOR_ALWAYS = "always"
+	COLOR_NEVER  = "never"
+
+	ENV_NO_COLOR = "NO_COLOR"
+)
+
+func SetColorMode(mode string) error {
+	switch mode {
+	case COLOR_AUTO, "":
+		color.NoColor = os.Getenv(ENV_NO_COLOR) != "" ||
+			os.Getenv("TERM") == "dumb" ||
+			!isatty.IsTerminal(os.Stdout.Fd())
+	case COLOR_ALWAYS:
+		color.NoColor = false
+	case COLOR_NEVER:
+		color.NoColor = true
+	default:
+		return fmt.Errorf("invalid color mode '%s', must be one of %s|%s|%s",
+			mode, COLOR_AUTO, COLOR_ALWAYS, COLOR_NEVER)
+	}
+	return nil
+}
+
+func ColorEnabled() bool {
+	return !color.NoColor
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// --output-file writes stdout of command to a temporary file in the same directory,
+// which is renamed to the target after command succeeded, so readers never see a
+// partial file, progress and errors in stderr are still shown on terminal
+const (
+	FLAG_OUTPUT_FILE = "output-file"
+
+	OUTPUT_FILE_MODE = 0644
+)
+
+var (
+	outputFile  string
+	outputTemp  *os.File
+	savedStdout = -1
+)
+
+func StartOutputFile(filename string) error {
+	dir := filepath.Dir(filename)
+	temp, err := os.CreateTemp(dir, "."+filepath.Base(filename)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create output file in %s failed: %v", dir, err)
+	}
+
+	stdout, err := unix.Dup(int(os.Stdout.Fd()))
+	if err != nil {
+		temp.Close()
+		os.Remove(temp.Name())
+		return err
+	}
+	if err := unix.Dup2(int(temp.Fd()), int(os.Stdout.Fd())); err != nil {
+		unix.Close(stdout)
+		temp.Close()
+		os.Remove(temp.Name())
+		return err
+	}
+
+	outputFile, outputTemp, savedStdout = filename, temp, stdout
+	return nil
+}
+
+// restore stdout, the output file is replaced only if commit is true
+func FinishOutputFile(commit bool) error {
+	if outputTemp == nil {
+		return nil
+	}
+	temp := outputTemp
+	outputTemp = nil
+	defer os.Remove(temp.Name())
+
+	syncErr := os.Stdout.Sync()
+	if err := unix.Dup2(savedStdout, int(os.Stdout.Fd())); err != nil {
+		return err
+	}
+	unix.Close(savedStdout)
+	savedStdout = -1
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	if !commit {
+		return nil
+	}
+
+	if syncErr != nil {
+		return fmt.Errorf("write output file %s failed: %v", outputFile, syncErr)
+	}
+	if err := os.Chmod(temp.Name(), OUTPUT_FILE_MODE); err != nil {
+		return err
+	}
+	if err := os.Rename(temp.Name(), outputFile); err != nil {
+		return fmt.Errorf("write output file %s failed: %v", outputFile, err)
+	}
+	return nil
+}
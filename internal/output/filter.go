@@ -0,0 +1,184 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// filter, sort and limit rows of list commands, column names are case-insensitive:
+//
+//	--filter name=dingo-*   keep rows whose column matches the shell pattern, repeatable
+//	--filter status!=NORMAL keep rows whose column does not match
+//	--sort-by -size,name    sort by columns, '-' prefix for descending, numbers are compared by value
+//	--limit 10              keep the first N rows after filtering and sorting
+//
+// all of them are idempotent, so rows can be processed more than once
+const (
+	SORT_DESC_PREFIX = "-"
+)
+
+type rowFilter struct {
+	index   int
+	pattern string
+	negate  bool
+}
+
+func columnIndex(header []string, name string) (int, error) {
+	name = strings.TrimSpace(name)
+	for i, column := range header {
+		if strings.EqualFold(column, name) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("unknown column '%s', available columns: %s", name, strings.Join(header, ","))
+}
+
+func parseFilters(header []string, filters []string) ([]rowFilter, error) {
+	parsed := []rowFilter{}
+	for _, filter := range filters {
+		negate := false
+		key, value, ok := strings.Cut(filter, "!=")
+		if ok {
+			negate = true
+		} else if key, value, ok = strings.Cut(filter, "="); !ok {
+			return nil, fmt.Errorf("invalid filter '%s', expect key=value or key!=value", filter)
+		}
+
+		index, err := columnIndex(header, key)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := path.Match(value, ""); err != nil {
+			return nil, fmt.Errorf("invalid filter pattern '%s': %v", value, err)
+		}
+		parsed = append(parsed, rowFilter{index: index, pattern: value, negate: negate})
+	}
+	return parsed, nil
+}
+
+func (f rowFilter) match(row []string) bool {
+	cell := ""
+	if f.index < len(row) {
+		cell = row[f.index]
+	}
+	matched, _ := path.Match(f.pattern, cell)
+	return matched != f.negate
+}
+
+// compare cells as numbers if both are numbers, otherwise as strings
+func compareCell(a, b string) int {
+	x, errX := strconv.ParseFloat(a, 64)
+	y, errY := strconv.ParseFloat(b, 64)
+	if errX == nil && errY == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+func FilterRows(header []string, rows [][]string, filters []string) ([][]string, error) {
+	if len(filters) == 0 {
+		return rows, nil
+	}
+	parsed, err := parseFilters(header, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := [][]string{}
+	for _, row := range rows {
+		keep := true
+		for _, filter := range parsed {
+			if !filter.match(row) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered, nil
+}
+
+func SortRows(header []string, rows [][]string, sortBy string) ([][]string, error) {
+	if strings.TrimSpace(sortBy) == "" {
+		return rows, nil
+	}
+
+	type sortKey struct {
+		index int
+		desc  bool
+	}
+	keys := []sortKey{}
+	for _, column := range strings.Split(sortBy, ",") {
+		column = strings.TrimSpace(column)
+		desc := strings.HasPrefix(column, SORT_DESC_PREFIX)
+		index, err := columnIndex(header, strings.TrimPrefix(column, SORT_DESC_PREFIX))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, sortKey{index: index, desc: desc})
+	}
+
+	cell := func(row []string, index int) string {
+		if index < len(row) {
+			return row[index]
+		}
+		return ""
+	}
+	sorted := append([][]string{}, rows...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, key := range keys {
+			result := compareCell(cell(sorted[i], key.index), cell(sorted[j], key.index))
+			if result == 0 {
+				continue
+			}
+			return (result < 0) != key.desc
+		}
+		return false
+	})
+	return sorted, nil
+}
+
+func LimitRows(rows [][]string, limit int) [][]string {
+	if limit > 0 && len(rows) > limit {
+		return rows[:limit]
+	}
+	return rows
+}
+
+// filter, sort and limit rows by options of current command
+func ProcessRows(header []string, rows [][]string) ([][]string, error) {
+	rows, err := FilterRows(header, rows, options.Filters)
+	if err != nil {
+		return nil, err
+	}
+	rows, err = SortRows(header, rows, options.SortBy)
+	if err != nil {
+		return nil, err
+	}
+	return LimitRows(rows, options.Limit), nil
+}
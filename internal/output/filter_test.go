@@ -0,0 +1,109 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var filterHeader = []string{"fsId", "fsName", "status", "size"}
+
+var filterRows = [][]string{
+	{"1", "dingo-1", "NORMAL", "100"},
+	{"2", "dingo-2", "DELETING", "9"},
+	{"3", "test-1", "NORMAL", "1000"},
+	{"4", "dingo-3"},
+}
+
+func TestFilterRows(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []string
+		ids     []string
+		err     string
+	}{
+		{name: "no filter", ids: []string{"1", "2", "3", "4"}},
+		{name: "pattern", filters: []string{"fsname=dingo-*"}, ids: []string{"1", "2", "4"}},
+		{name: "negate", filters: []string{"status!=NORMAL"}, ids: []string{"2", "4"}},
+		{name: "all filters match", filters: []string{"fsName=dingo-*", "STATUS=NORMAL"}, ids: []string{"1"}},
+		{name: "missing cell is empty", filters: []string{"status="}, ids: []string{"4"}},
+		{name: "value with equal sign", filters: []string{"fsName=a=b"}, ids: []string{}},
+		{name: "invalid filter", filters: []string{"status"}, err: "invalid filter 'status', expect key=value or key!=value"},
+		{name: "unknown column", filters: []string{"owner=root"}, err: "unknown column 'owner'"},
+		{name: "invalid pattern", filters: []string{"fsName=[a"}, err: "invalid filter pattern '[a'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			rows, err := FilterRows(filterHeader, filterRows, tt.filters)
+			if tt.err != "" {
+				assert.ErrorContains(err, tt.err)
+				return
+			}
+			assert.NoError(err)
+			ids := []string{}
+			for _, row := range rows {
+				ids = append(ids, row[0])
+			}
+			assert.Equal(tt.ids, ids)
+		})
+	}
+}
+
+func TestSortRows(t *testing.T) {
+	tests := []struct {
+		name   string
+		sortBy string
+		ids    []string
+		err    string
+	}{
+		{name: "no sort", ids: []string{"1", "2", "3", "4"}},
+		{name: "numbers by value", sortBy: "size", ids: []string{"4", "2", "1", "3"}},
+		{name: "descending", sortBy: "-size", ids: []string{"3", "1", "2", "4"}},
+		{name: "strings", sortBy: "fsName", ids: []string{"1", "2", "4", "3"}},
+		{name: "stable by more columns", sortBy: " status , -fsId", ids: []string{"4", "2", "3", "1"}},
+		{name: "unknown column", sortBy: "owner", err: "unknown column 'owner'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			rows, err := SortRows(filterHeader, filterRows, tt.sortBy)
+			if tt.err != "" {
+				assert.ErrorContains(err, tt.err)
+				return
+			}
+			assert.NoError(err)
+			ids := []string{}
+			for _, row := range rows {
+				ids = append(ids, row[0])
+			}
+			assert.Equal(tt.ids, ids)
+		})
+	}
+	// rows of caller are not changed
+	assert.Equal(t, "1", filterRows[0][0])
+}
+
+func TestLimitRows(t *testing.T) {
+	assert := assert.New(t)
+	assert.Len(LimitRows(filterRows, 0), 4)
+	assert.Len(LimitRows(filterRows, 2), 2)
+	assert.Len(LimitRows(filterRows, 10), 4)
+}
+
+func TestCompareCell(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		result int
+	}{
+		{"9", "10", -1},
+		{"1.5", "1.50", 0},
+		{"10", "9", 1},
+		{"a", "b", -1},
+		{"10", "a", -1},
+		{"", "", 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.result, compareCell(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
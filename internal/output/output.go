@@ -22,10 +22,17 @@ import (
 	"os"
 
 	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/pkg/logger"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
+var (
+	// error in json result, which is printed but not returned by command
+	resultError *errno.ErrorCode
+)
+
 func init() {
 	log.SetFlags(log.Ldate | log.Lshortfile | log.Lmicroseconds)
 	log.SetOutput(io.Discard)
@@ -33,7 +40,7 @@ func init() {
 
 func SetShow(show bool) {
 	if show {
-		log.SetOutput(os.Stdout)
+		log.SetOutput(logger.NewRedactWriter(os.Stdout))
 	} else {
 		log.SetOutput(io.Discard)
 	}
@@ -69,7 +76,7 @@ func ProtoMessageToJson(message proto.Message) (string, error) {
 
 func ShowRpcData(request proto.Message, response proto.Message, isShow bool) {
 	if isShow {
-		log.SetOutput(os.Stdout)
+		log.SetOutput(logger.NewRedactWriter(os.Stdout))
 		data, _ := ProtoMessageToJson(request)
 		log.Printf("rpc request info: %s\n", data)
 		data, _ = ProtoMessageToJson(response)
@@ -78,11 +85,41 @@ func ShowRpcData(request proto.Message, response proto.Message, isShow bool) {
 }
 
 func OutputJson(result *common.OutputResult) error {
+	if result.Error != nil && result.Error.GetCode() != errno.ERR_OK.GetCode() {
+		resultError = result.Error
+	}
+	if options.Template != "" {
+		if result.Error != nil && result.Error.GetCode() != errno.ERR_OK.GetCode() {
+			return result.Error
+		}
+		return RenderTemplate(os.Stdout, options.Template, result.Result)
+	}
+
 	output, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return err
 	}
-	fmt.Println(string(output))
+	fmt.Println(logger.Redact(string(output)))
 
 	return nil
 }
+
+// whether errors should be printed as json envelope instead of text
+func IsJsonError() bool {
+	return options.Format == FORMAT_JSON && options.Template == ""
+}
+
+// print error returned by command as {"error": {...}, "result": null}
+func OutputJsonError(err error) error {
+	return OutputJson(&common.OutputResult{
+		Error: errno.FromError(err),
+	})
+}
+
+// error of the json result printed by command, nil if succeeded
+func ResultError() error {
+	if resultError == nil {
+		return nil
+	}
+	return resultError
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"unicode/utf8"
+
+	"golang.org/x/sys/unix"
+)
+
+// less options: quit if output fits in one screen, keep colors, do not clear screen
+const (
+	DEFAULT_LESS_OPTIONS = "FRX"
+
+	// used if size of terminal is unknown
+	DEFAULT_TERMINAL_ROWS = 24
+	DEFAULT_TERMINAL_COLS = 80
+)
+
+var (
+	pager *pagerState
+
+	ansiEscapeRegex = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+)
+
+// stdout is redirected to a pipe, output is buffered until it is longer than the
+// terminal, then the pager is started with it, shorter output is printed directly
+type pagerState struct {
+	command string
+	stdout  int      // original stdout, restored by StopPager
+	direct  *os.File // dup of original stdout, short output and the pager are written to
+	reader  *os.File
+	rows    int
+	cols    int
+	done    chan struct{}
+
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+}
+
+func StartPager(command string) error {
+	stdout, err := unix.Dup(int(os.Stdout.Fd()))
+	if err != nil {
+		return err
+	}
+	direct, err := unix.Dup(stdout)
+	if err != nil {
+		unix.Close(stdout)
+		return err
+	}
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		unix.Close(stdout)
+		unix.Close(direct)
+		return err
+	}
+	if err := unix.Dup2(int(writer.Fd()), int(os.Stdout.Fd())); err != nil {
+		reader.Close()
+		writer.Close()
+		unix.Close(stdout)
+		unix.Close(direct)
+		return err
+	}
+	writer.Close()
+
+	p := &pagerState{
+		command: command,
+		stdout:  stdout,
+		direct:  os.NewFile(uintptr(direct), "stdout"),
+		reader:  reader,
+		rows:    DEFAULT_TERMINAL_ROWS,
+		cols:    DEFAULT_TERMINAL_COLS,
+		done:    make(chan struct{}),
+	}
+	if size, err := unix.IoctlGetWinsize(stdout, unix.TIOCGWINSZ); err == nil && size.Row > 0 && size.Col > 0 {
+		p.rows, p.cols = int(size.Row), int(size.Col)
+	}
+	pager = p
+	go p.copy()
+	return nil
+}
+
+func (p *pagerState) copy() {
+	defer close(p.done)
+
+	var buffered bytes.Buffer
+	var out io.Writer
+	chunk := make([]byte, 32*1024)
+	for {
+		n, err := p.reader.Read(chunk)
+		if n > 0 {
+			if out != nil {
+				// pager quit by user, the rest is drained
+				out.Write(chunk[:n])
+			} else {
+				buffered.Write(chunk[:n])
+				// one line is left for the prompt
+				if screenLines(buffered.Bytes(), p.cols) >= p.rows {
+					out = p.startPager()
+					out.Write(buffered.Bytes())
+					buffered.Reset()
+				}
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	p.reader.Close()
+	if out == nil {
+		p.direct.Write(buffered.Bytes())
+	}
+}
+
+// the pager is not started if it fails, output is printed directly
+func (p *pagerState) startPager() io.Writer {
+	cmd := exec.Command("sh", "-c", p.command)
+	cmd.Stdout = p.direct
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		cmd.Env = append(cmd.Env, "LESS="+DEFAULT_LESS_OPTIONS)
+	}
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return p.direct
+	}
+	if err := cmd.Start(); err != nil {
+		return p.direct
+	}
+	p.cmd, p.stdin = cmd, stdin
+	return stdin
+}
+
+// lines of output on a terminal of cols columns, long lines are wrapped
+func screenLines(data []byte, cols int) int {
+	lines := 0
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		width := utf8.RuneCount(ansiEscapeRegex.ReplaceAll(line, nil))
+		lines += max(1, (width+cols-1)/cols)
+	}
+	// no line after the last newline
+	if bytes.HasSuffix(data, []byte("\n")) {
+		lines--
+	}
+	return lines
+}
+
+// restore the original stdout, then print buffered output or wait for user quitting pager
+func StopPager() {
+	if pager == nil {
+		return
+	}
+	p := pager
+	pager = nil
+
+	// the write end of the pipe is closed, so the copy gets EOF
+	unix.Dup2(p.stdout, int(os.Stdout.Fd()))
+	unix.Close(p.stdout)
+	<-p.done
+	if p.cmd != nil {
+		p.stdin.Close()
+		p.cmd.Wait()
+	}
+	p.direct.Close()
+}
@@ -0,0 +1,26 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScreenLines(t *testing.T) {
+	tests := []struct {
+		data  string
+		cols  int
+		lines int
+	}{
+		{"a\n", 80, 1},
+		{"a\nb\n", 80, 2},
+		{"a\nb", 80, 2},
+		{"\n\n", 80, 2},
+		{"0123456789\n", 4, 3},
+		{"\x1b[31mred\x1b[0m\n", 3, 1},
+		{"中文\n", 80, 1},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.lines, screenLines([]byte(test.data), test.cols), test.data)
+	}
+}
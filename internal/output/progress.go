@@ -0,0 +1,312 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/mattn/go-isatty"
+	"github.com/schollz/progressbar/v3"
+)
+
+// progress of long operations, which is written to stderr so results in stdout are kept clean:
+//
+//	terminal:     progress bar, or spinner if total is unknown
+//	non-terminal: plain log lines every 10 percent or 10 seconds, friendly to systemd and CI logs
+const (
+	PROGRESS_UNKNOWN_TOTAL = -1
+
+	PROGRESS_LOG_PERCENT_STEP = 10
+	PROGRESS_LOG_INTERVAL     = 10 * time.Second
+)
+
+type ProgressOption func(*Progress)
+
+// show current and total as bytes, e.g. 1.2 MiB/12 MiB
+func WithBytes() ProgressOption {
+	return func(p *Progress) {
+		p.bytes = true
+	}
+}
+
+func WithWriter(w io.Writer) ProgressOption {
+	return func(p *Progress) {
+		p.writer = w
+	}
+}
+
+type Progress struct {
+	mu          sync.Mutex
+	description string
+	total       int64
+	current     int64
+	bytes       bool
+	writer      io.Writer
+	finished    bool
+
+	// nil if writer is not a terminal
+	bar *progressbar.ProgressBar
+
+	// last logged state of plain progress
+	lastPercent int64
+	lastLog     time.Time
+}
+
+func NewProgress(total int64, description string, opts ...ProgressOption) *Progress {
+	p := &Progress{
+		description: description,
+		total:       total,
+		writer:      os.Stderr,
+		lastLog:     time.Now(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if f, ok := p.writer.(*os.File); ok && isatty.IsTerminal(f.Fd()) {
+		p.bar = p.newBar()
+	} else {
+		fmt.Fprintf(p.writer, "%s...\n", p.description)
+	}
+	return p
+}
+
+// spinner for operations whose total is unknown, e.g. waiting for mount ready
+func NewSpinner(description string, opts ...ProgressOption) *Progress {
+	return NewProgress(PROGRESS_UNKNOWN_TOTAL, description, opts...)
+}
+
+func (p *Progress) newBar() *progressbar.ProgressBar {
+	options := []progressbar.Option{
+		progressbar.OptionSetWriter(p.writer),
+		progressbar.OptionSetDescription(colorize("[cyan]", p.description+"...")),
+		progressbar.OptionSpinnerType(14),
+		progressbar.OptionSetWidth(30),
+		progressbar.OptionThrottle(65 * time.Millisecond),
+		progressbar.OptionSetRenderBlankState(true),
+		progressbar.OptionOnCompletion(func() {
+			fmt.Fprint(p.writer, "\n")
+		}),
+		progressbar.OptionEnableColorCodes(ColorEnabled()),
+		progressbar.OptionSetTheme(progressbar.Theme{
+			Saucer:        colorize("[green]", "="),
+			SaucerHead:    colorize("[green]", ">"),
+			SaucerPadding: " ",
+			BarStart:      "[",
+			BarEnd:        "]",
+		}),
+	}
+	if p.total != PROGRESS_UNKNOWN_TOTAL {
+		options = append(options, progressbar.OptionShowCount())
+	}
+	if p.bytes {
+		options = append(options, progressbar.OptionShowBytes(true))
+	}
+	return progressbar.NewOptions64(p.total, options...)
+}
+
+// color codes of progressbar, e.g. [green]=[reset]
+func colorize(code, s string) string {
+	if !ColorEnabled() {
+		return s
+	}
+	return code + s + "[reset]"
+}
+
+func (p *Progress) Set64(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.set(n)
+}
+
+func (p *Progress) Add64(n int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.set(p.current + n)
+}
+
+func (p *Progress) set(n int64) {
+	p.current = n
+	if p.bar != nil {
+		p.bar.Set64(n)
+	} else {
+		p.log(false)
+	}
+}
+
+// progress is an io.Writer, e.g. io.Copy(io.MultiWriter(file, progress), body)
+func (p *Progress) Write(b []byte) (int, error) {
+	p.Add64(int64(len(b)))
+	return len(b), nil
+}
+
+func (p *Progress) ChangeMax64(total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.total = total
+	if p.bar != nil {
+		p.bar.ChangeMax64(total)
+	}
+}
+
+func (p *Progress) Describe(description string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.description = description
+	if p.bar != nil {
+		p.bar.Describe(colorize("[cyan]", description+"..."))
+	}
+}
+
+func (p *Progress) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.finished {
+		return
+	}
+	p.finished = true
+	if p.bar != nil {
+		p.bar.Finish()
+	} else {
+		p.log(true)
+	}
+}
+
+// log plain progress line when percent crosses a step or interval elapsed
+func (p *Progress) log(force bool) {
+	percent := int64(-1)
+	if p.total > 0 {
+		percent = p.current * 100 / p.total
+	}
+	if !force {
+		if percent >= 0 && percent/PROGRESS_LOG_PERCENT_STEP == p.lastPercent/PROGRESS_LOG_PERCENT_STEP &&
+			time.Since(p.lastLog) < PROGRESS_LOG_INTERVAL {
+			return
+		}
+		if percent < 0 && time.Since(p.lastLog) < PROGRESS_LOG_INTERVAL {
+			return
+		}
+	}
+	p.lastPercent = percent
+	p.lastLog = time.Now()
+
+	count := p.format(p.current)
+	if p.total > 0 {
+		count = fmt.Sprintf("%s/%s", count, p.format(p.total))
+	}
+	status := ""
+	if force {
+		status = ", done"
+	}
+	if percent >= 0 {
+		fmt.Fprintf(p.writer, "%s: %d%% (%s)%s\n", p.description, percent, count, status)
+	} else {
+		fmt.Fprintf(p.writer, "%s: %s%s\n", p.description, count, status)
+	}
+}
+
+func (p *Progress) format(n int64) string {
+	if p.bytes {
+		return humanize.IBytes(uint64(n))
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+// aggregate view of concurrent tasks, e.g. bulk warmup or downloads,
+// a single progress shows the sum of all tasks and how many tasks are done
+type MultiProgress struct {
+	mu          sync.Mutex
+	description string
+	opts        []ProgressOption
+	progress    *Progress
+	total       int64
+	tasks       int
+	done        int
+}
+
+type ProgressTask struct {
+	multi   *MultiProgress
+	total   int64
+	current int64
+	done    bool
+}
+
+func NewMultiProgress(description string, opts ...ProgressOption) *MultiProgress {
+	return &MultiProgress{
+		description: description,
+		opts:        opts,
+	}
+}
+
+func (m *MultiProgress) AddTask(total int64) *ProgressTask {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tasks++
+	m.total += total
+	if m.progress == nil {
+		m.progress = NewProgress(m.total, m.taskDescription(), m.opts...)
+	} else {
+		m.progress.ChangeMax64(m.total)
+		m.progress.Describe(m.taskDescription())
+	}
+	return &ProgressTask{multi: m, total: total}
+}
+
+func (m *MultiProgress) taskDescription() string {
+	return fmt.Sprintf("%s (%d/%d)", m.description, m.done, m.tasks)
+}
+
+func (m *MultiProgress) Finish() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.progress != nil {
+		m.progress.Finish()
+	}
+}
+
+func (t *ProgressTask) Add64(n int64) {
+	t.multi.mu.Lock()
+	defer t.multi.mu.Unlock()
+	if t.done {
+		return
+	}
+	t.current += n
+	t.multi.progress.Add64(n)
+}
+
+func (t *ProgressTask) Write(b []byte) (int, error) {
+	t.Add64(int64(len(b)))
+	return len(b), nil
+}
+
+// mark task done, the rest of a failed task is counted as finished
+func (t *ProgressTask) Done() {
+	t.multi.mu.Lock()
+	defer t.multi.mu.Unlock()
+	if t.done {
+		return
+	}
+	t.done = true
+	if t.current < t.total {
+		t.multi.progress.Add64(t.total - t.current)
+	}
+	t.multi.done++
+	t.multi.progress.Describe(t.multi.taskDescription())
+}
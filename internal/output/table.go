@@ -0,0 +1,159 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	FORMAT_PLAIN = "plain"
+	FORMAT_JSON  = "json"
+	FORMAT_TABLE = "table"
+	FORMAT_CSV   = "csv"
+	FORMAT_TSV   = "tsv"
+)
+
+// output options of current command, set from --format, --columns, --no-headers
+// and list flags --filter, --sort-by, --limit
+type Options struct {
+	Format    string
+	Columns   []string
+	NoHeaders bool
+	// go-template=... or jsonpath=..., the command outputs json result which is rendered by it
+	Template string
+	Filters  []string
+	SortBy   string
+	Limit    int
+}
+
+var (
+	options = Options{Format: FORMAT_PLAIN}
+)
+
+func SetOptions(opts Options) {
+	if opts.Format == "" {
+		opts.Format = FORMAT_PLAIN
+	}
+	options = opts
+}
+
+func GetOptions() Options {
+	return options
+}
+
+// select columns by name (case-insensitive) in order of --columns, all columns if not specified
+func SelectColumns(header []string, rows [][]string, columns []string) ([]string, [][]string, error) {
+	if len(columns) == 0 {
+		return header, rows, nil
+	}
+
+	indexes := []int{}
+	for _, column := range columns {
+		index := -1
+		for i, name := range header {
+			if strings.EqualFold(name, strings.TrimSpace(column)) {
+				index = i
+				break
+			}
+		}
+		if index == -1 {
+			return nil, nil, fmt.Errorf("unknown column '%s', available columns: %s", column, strings.Join(header, ","))
+		}
+		indexes = append(indexes, index)
+	}
+
+	selectedHeader := []string{}
+	for _, index := range indexes {
+		selectedHeader = append(selectedHeader, header[index])
+	}
+	selectedRows := [][]string{}
+	for _, row := range rows {
+		selected := []string{}
+		for _, index := range indexes {
+			if index < len(row) {
+				selected = append(selected, row[index])
+			} else {
+				selected = append(selected, "")
+			}
+		}
+		selectedRows = append(selectedRows, selected)
+	}
+	return selectedHeader, selectedRows, nil
+}
+
+// render rows as aligned columns without borders, which is friendly to awk and cut
+func RenderTable(w io.Writer, header []string, rows [][]string) error {
+	header, rows, err := SelectColumns(header, rows, options.Columns)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if !options.NoHeaders {
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+	}
+	for _, row := range rows {
+		cells := []string{}
+		for _, cell := range row {
+			// multi-line cell breaks alignment
+			cells = append(cells, strings.ReplaceAll(cell, "\n", " "))
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// render rows in the format of --format, table is used for other formats
+func RenderRows(w io.Writer, header []string, rows [][]string) error {
+	rows, err := ProcessRows(header, rows)
+	if err != nil {
+		return err
+	}
+
+	switch options.Format {
+	case FORMAT_CSV:
+		return RenderCsv(w, header, rows, ',')
+	case FORMAT_TSV:
+		return RenderCsv(w, header, rows, '\t')
+	default:
+		return RenderTable(w, header, rows)
+	}
+}
+
+// render rows as csv (RFC 4180) or tsv, header is the first record unless --no-headers
+func RenderCsv(w io.Writer, header []string, rows [][]string, comma rune) error {
+	header, rows, err := SelectColumns(header, rows, options.Columns)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if !options.NoHeaders {
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
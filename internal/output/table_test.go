@@ -0,0 +1,65 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectColumns(t *testing.T) {
+	assert := assert.New(t)
+	header := []string{"fsId", "fsName", "status"}
+	rows := [][]string{{"1", "fs1", "ok"}, {"2", "fs2"}}
+
+	selectedHeader, selectedRows, err := SelectColumns(header, rows, nil)
+	assert.NoError(err)
+	assert.Equal(header, selectedHeader)
+	assert.Equal(rows, selectedRows)
+
+	// case insensitive, in the order of columns, missing cells are empty
+	selectedHeader, selectedRows, err = SelectColumns(header, rows, []string{"STATUS", " fsid "})
+	assert.NoError(err)
+	assert.Equal([]string{"status", "fsId"}, selectedHeader)
+	assert.Equal([][]string{{"ok", "1"}, {"", "2"}}, selectedRows)
+
+	_, _, err = SelectColumns(header, rows, []string{"fsName", "owner"})
+	assert.ErrorContains(err, "unknown column 'owner', available columns: fsId,fsName,status")
+}
+
+func TestRenderCsv(t *testing.T) {
+	assert := assert.New(t)
+	defer SetOptions(Options{})
+	header := []string{"name", "comment"}
+	rows := [][]string{{"fs1", `has "quotes"`}, {"fs,2", "multi\nline"}}
+
+	var buf bytes.Buffer
+	SetOptions(Options{Format: FORMAT_CSV})
+	assert.NoError(RenderCsv(&buf, header, rows, ','))
+	assert.Equal("name,comment\nfs1,\"has \"\"quotes\"\"\"\n\"fs,2\",\"multi\nline\"\n", buf.String())
+
+	buf.Reset()
+	SetOptions(Options{Format: FORMAT_TSV, Columns: []string{"name"}, NoHeaders: true})
+	assert.NoError(RenderCsv(&buf, header, rows, '\t'))
+	assert.Equal("fs1\nfs,2\n", buf.String())
+
+	SetOptions(Options{Format: FORMAT_CSV, Columns: []string{"owner"}})
+	assert.Error(RenderCsv(&buf, header, rows, ','))
+}
+
+func TestRenderTable(t *testing.T) {
+	assert := assert.New(t)
+	defer SetOptions(Options{})
+	header := []string{"name", "size"}
+	rows := [][]string{{"fs1", "1 GiB"}, {"dingofs2", "2\nGiB"}}
+
+	var buf bytes.Buffer
+	SetOptions(Options{Format: FORMAT_TABLE})
+	assert.NoError(RenderTable(&buf, header, rows))
+	assert.Equal("name      size\nfs1       1 GiB\ndingofs2  2 GiB\n", buf.String())
+
+	buf.Reset()
+	SetOptions(Options{Format: FORMAT_TABLE, Columns: []string{"size"}, NoHeaders: true})
+	assert.NoError(RenderTable(&buf, header, rows))
+	assert.Equal("1 GiB\n2 GiB\n", buf.String())
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// extract fields from structured result:
+//
+//	--format 'go-template={{.Name}} {{.Version}}'  executed for each item if result is a list
+//	--format 'jsonpath=$.items[*].name'             list result is wrapped as {"items": [...]}
+//
+// supported jsonpath: $, .key, ['key'], [n], [*], .*
+const (
+	FORMAT_GO_TEMPLATE = "go-template="
+	FORMAT_JSONPATH    = "jsonpath="
+
+	JSONPATH_ITEMS = "items"
+)
+
+var (
+	jsonPathTokenRegex = regexp.MustCompile(`^(?:\.([A-Za-z0-9_\-]+|\*)|\['([^']*)'\]|\[(\*|[0-9]+)\])`)
+)
+
+func IsTemplateFormat(format string) bool {
+	return strings.HasPrefix(format, FORMAT_GO_TEMPLATE) || strings.HasPrefix(format, FORMAT_JSONPATH)
+}
+
+func RenderTemplate(w io.Writer, format string, result interface{}) error {
+	if strings.HasPrefix(format, FORMAT_GO_TEMPLATE) {
+		return renderGoTemplate(w, strings.TrimPrefix(format, FORMAT_GO_TEMPLATE), result)
+	}
+	return renderJsonPath(w, strings.TrimPrefix(format, FORMAT_JSONPATH), result)
+}
+
+func renderGoTemplate(w io.Writer, text string, result interface{}) error {
+	tmpl, err := template.New("format").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid go-template: %v", err)
+	}
+
+	items := []interface{}{result}
+	if value := reflect.ValueOf(result); value.Kind() == reflect.Slice {
+		items = items[:0]
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, value.Index(i).Interface())
+		}
+	}
+	for _, item := range items {
+		if err := tmpl.Execute(w, item); err != nil {
+			return fmt.Errorf("execute go-template failed: %v", err)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func renderJsonPath(w io.Writer, path string, result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	var root interface{}
+	if err := json.Unmarshal(data, &root); err != nil {
+		return err
+	}
+	if list, ok := root.([]interface{}); ok {
+		root = map[string]interface{}{JSONPATH_ITEMS: list}
+	}
+
+	values, err := EvalJsonPath(root, path)
+	if err != nil {
+		return err
+	}
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			fmt.Fprintln(w, s)
+			continue
+		}
+		data, _ := json.Marshal(value)
+		fmt.Fprintln(w, string(data))
+	}
+	return nil
+}
+
+func EvalJsonPath(root interface{}, path string) ([]interface{}, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid jsonpath '%s', must start with $", path)
+	}
+
+	current := []interface{}{root}
+	rest := path[1:]
+	for rest != "" {
+		matches := jsonPathTokenRegex.FindStringSubmatch(rest)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid jsonpath '%s' at '%s'", path, rest)
+		}
+		rest = rest[len(matches[0]):]
+
+		next := []interface{}{}
+		for _, node := range current {
+			switch {
+			case matches[1] == "*" || matches[3] == "*":
+				next = append(next, jsonPathChildren(node)...)
+			case matches[3] != "":
+				index, _ := strconv.Atoi(matches[3])
+				if list, ok := node.([]interface{}); ok && index < len(list) {
+					next = append(next, list[index])
+				}
+			default:
+				key := matches[1] + matches[2]
+				if object, ok := node.(map[string]interface{}); ok {
+					if value, ok := object[key]; ok {
+						next = append(next, value)
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return current, nil
+}
+
+func jsonPathChildren(node interface{}) []interface{} {
+	switch v := node.(type) {
+	case []interface{}:
+		return v
+	case map[string]interface{}:
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		children := []interface{}{}
+		for _, key := range keys {
+			children = append(children, v[key])
+		}
+		return children
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalJsonPath(t *testing.T) {
+	var root interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"items": [{"name": "fs1", "id": 1}, {"name": "fs2", "id": 2}],
+		"s3 info": {"bucket": "b1"}
+	}`), &root))
+
+	tests := []struct {
+		path   string
+		expect []interface{}
+		err    bool
+	}{
+		{path: "$", expect: []interface{}{root}},
+		{path: "$.items[*].name", expect: []interface{}{"fs1", "fs2"}},
+		{path: "$.items[1].id", expect: []interface{}{float64(2)}},
+		{path: "$['s3 info'].bucket", expect: []interface{}{"b1"}},
+		{path: "$['s3 info'].*", expect: []interface{}{"b1"}},
+		{path: " $.items[0]['name'] ", expect: []interface{}{"fs1"}},
+		// missing keys and indexes out of range select nothing
+		{path: "$.items[2].name", expect: []interface{}{}},
+		{path: "$.missing", expect: []interface{}{}},
+		{path: "$.items.name", expect: []interface{}{}},
+		{path: "items[*]", err: true},
+		{path: "$.items[-1]", err: true},
+		{path: "$.items[", err: true},
+		{path: "$..name", err: true},
+	}
+	for _, tt := range tests {
+		values, err := EvalJsonPath(root, tt.path)
+		if tt.err {
+			assert.Error(t, err, tt.path)
+			continue
+		}
+		assert.NoError(t, err, tt.path)
+		assert.Equal(t, tt.expect, values, tt.path)
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	result := []map[string]interface{}{{"name": "fs1"}, {"name": "fs2"}}
+
+	var buf bytes.Buffer
+	assert.NoError(t, RenderTemplate(&buf, "jsonpath=$.items[*].name", result))
+	assert.Equal(t, "fs1\nfs2\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, RenderTemplate(&buf, "go-template={{.name}}", result))
+	assert.Equal(t, "fs1\nfs2\n", buf.String())
+
+	assert.Error(t, RenderTemplate(&buf, "go-template={{.missing}}", result))
+	assert.Error(t, RenderTemplate(&buf, "go-template={{", result))
+}
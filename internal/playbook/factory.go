@@ -119,6 +119,14 @@ const (
 	// dingo executor
 	SYNC_JAVA_OPTS
 
+	// systemd
+	INSTALL_COMPONENT_BINARY
+	SYNC_SYSTEMD_CONFIG
+	CREATE_SYSTEMD_META_TABLES
+	START_SYSTEMD_SERVICE
+	GET_SYSTEMD_LEADER
+	CHECK_SYSTEMD_SERVICE_HEALTH
+
 	// unknown
 	UNKNOWN
 )
@@ -291,6 +299,19 @@ func (p *Playbook) createTasks(step *PlaybookStep) (*tasks.Tasks, error) {
 			t, err = comm.NewSyncJavaOptsTask(dingocli, config.GetDC(i))
 		case SYNC_GRAFANA_DASHBOARD:
 			t, err = monitor.NewSyncGrafanaDashboardTask(dingocli, config.GetMC(i))
+		// systemd
+		case INSTALL_COMPONENT_BINARY:
+			t, err = comm.NewInstallBinaryTask(dingocli, config.GetDC(i))
+		case SYNC_SYSTEMD_CONFIG:
+			t, err = comm.NewSyncSystemdConfigTask(dingocli, config.GetDC(i))
+		case CREATE_SYSTEMD_META_TABLES:
+			t, err = comm.NewCreateSystemdMetaTablesTask(dingocli, config.GetDC(i))
+		case START_SYSTEMD_SERVICE:
+			t, err = comm.NewStartSystemdServiceTask(dingocli, config.GetDC(i))
+		case GET_SYSTEMD_LEADER:
+			t, err = comm.NewGetSystemdLeaderTask(dingocli, config.GetDC(i))
+		case CHECK_SYSTEMD_SERVICE_HEALTH:
+			t, err = comm.NewCheckSystemdServiceHealthTask(dingocli, config.GetDC(i))
 
 		default:
 			return nil, errno.ERR_UNKNOWN_TASK_TYPE.
@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package profile
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// self-profiling of the cli enabled by the hidden flag --profile-cli, which records time
+// spent in phases of the command and prints a breakdown to stderr at exit,
+// --profile-cli=DIR also writes cpu.pprof and heap.pprof into DIR
+const (
+	FLAG_PROFILE_CLI = "profile-cli"
+	PROFILE_NO_PPROF = "-"
+
+	PHASE_CONFIG = "config"
+	PHASE_RPC    = "rpc"
+	PHASE_IO     = "io"
+	PHASE_HTTP   = "http"
+
+	CPU_PROFILE_FILE  = "cpu.pprof"
+	HEAP_PROFILE_FILE = "heap.pprof"
+
+	SLOWEST_CALLS = 10 // calls listed by the breakdown
+)
+
+// a call of a phase, e.g. an rpc to an mds address
+type Call struct {
+	Phase    string
+	Name     string
+	Duration time.Duration
+	Err      bool
+}
+
+type profiler struct {
+	mux     sync.Mutex
+	enabled bool
+	command string
+	dir     string
+	cpu     *os.File
+	calls   []*Call
+}
+
+var (
+	// as early as the package is initialized, so startup of the process is counted
+	processStart = time.Now()
+	global       = &profiler{}
+)
+
+// Enable starts recording, dir is where pprof files are written, or PROFILE_NO_PPROF
+func Enable(command, dir string) error {
+	global.mux.Lock()
+	defer global.mux.Unlock()
+	global.enabled, global.command = true, command
+	if dir == "" || dir == PROFILE_NO_PPROF {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(filepath.Join(dir, CPU_PROFILE_FILE))
+	if err != nil {
+		return err
+	}
+	if err := pprof.StartCPUProfile(file); err != nil {
+		file.Close()
+		return err
+	}
+	global.dir, global.cpu = dir, file
+	return nil
+}
+
+func Enabled() bool {
+	global.mux.Lock()
+	defer global.mux.Unlock()
+	return global.enabled
+}
+
+// Start returns the function ending the call, callers defer it:
+//
+//	defer profile.Start(profile.PHASE_IO, "statfs")()
+func Start(phase, name string) func() {
+	if !Enabled() {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		Record(phase, name, time.Since(start), false)
+	}
+}
+
+func Record(phase, name string, duration time.Duration, failed bool) {
+	global.mux.Lock()
+	defer global.mux.Unlock()
+	if global.enabled {
+		global.calls = append(global.calls, &Call{Phase: phase, Name: name, Duration: duration, Err: failed})
+	}
+}
+
+// Finish stops pprof and prints the breakdown, nothing is done if not enabled
+func Finish(w io.Writer) {
+	global.mux.Lock()
+	defer global.mux.Unlock()
+	if !global.enabled {
+		return
+	}
+	global.enabled = false
+	if global.cpu != nil {
+		pprof.StopCPUProfile()
+		global.cpu.Close()
+		if err := writeHeapProfile(filepath.Join(global.dir, HEAP_PROFILE_FILE)); err != nil {
+			fmt.Fprintf(w, "write heap profile failed: %v\n", err)
+		}
+	}
+	printBreakdown(w, global.command, time.Since(processStart), global.calls, global.dir)
+}
+
+func writeHeapProfile(filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	runtime.GC()
+	return pprof.WriteHeapProfile(file)
+}
+
+// calls may run at once, so time of phases can add up to more than the total
+func printBreakdown(w io.Writer, command string, total time.Duration, calls []*Call, dir string) {
+	type phaseStat struct {
+		count  int
+		failed int
+		total  time.Duration
+		max    time.Duration
+	}
+	phases := map[string]*phaseStat{}
+	names := []string{}
+	for _, call := range calls {
+		stat, ok := phases[call.Phase]
+		if !ok {
+			stat = &phaseStat{}
+			phases[call.Phase] = stat
+			names = append(names, call.Phase)
+		}
+		stat.count++
+		stat.total += call.Duration
+		if call.Duration > stat.max {
+			stat.max = call.Duration
+		}
+		if call.Err {
+			stat.failed++
+		}
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(w, "\nProfile of '%s': %s in total\n", command, round(total))
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  PHASE\tCALLS\tFAILED\tTOTAL\tAVG\tMAX")
+	for _, name := range names {
+		stat := phases[name]
+		fmt.Fprintf(tw, "  %s\t%d\t%d\t%s\t%s\t%s\n", name, stat.count, stat.failed,
+			round(stat.total), round(stat.total/time.Duration(stat.count)), round(stat.max))
+	}
+	tw.Flush()
+
+	if len(calls) > 0 {
+		slowest := append([]*Call{}, calls...)
+		sort.SliceStable(slowest, func(i, j int) bool { return slowest[i].Duration > slowest[j].Duration })
+		if len(slowest) > SLOWEST_CALLS {
+			slowest = slowest[:SLOWEST_CALLS]
+		}
+		fmt.Fprintf(w, "Slowest %d of %d calls:\n", len(slowest), len(calls))
+		tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, call := range slowest {
+			status := ""
+			if call.Err {
+				status = " (failed)"
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s%s\n", call.Phase, call.Name, round(call.Duration), status)
+		}
+		tw.Flush()
+	}
+	if dir != "" {
+		fmt.Fprintf(w, "pprof: go tool pprof %s\n", filepath.Join(dir, CPU_PROFILE_FILE))
+	}
+}
+
+func round(d time.Duration) time.Duration {
+	if d > time.Second {
+		return d.Round(time.Millisecond)
+	}
+	return d.Round(time.Microsecond)
+}
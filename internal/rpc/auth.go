@@ -0,0 +1,117 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// token of current context is attached to all requests as metadata,
+// mds checks the token and the role of its user
+const (
+	AUTH_METADATA_KEY = "authorization"
+	AUTH_TOKEN_SCHEME = "Bearer "
+	ENV_DINGO_TOKEN   = "DINGO_TOKEN"
+)
+
+// user and role are shown in errors of rejected requests
+type AuthIdentity struct {
+	Context   string
+	User      string
+	Role      string
+	ExpiresAt time.Time
+}
+
+type AuthLoader func() (string, *AuthIdentity)
+
+var (
+	authMutex    sync.Mutex
+	authLoader   AuthLoader
+	authLoaded   bool
+	authToken    string
+	authIdentity *AuthIdentity
+)
+
+// loader is called on the first request, after configuration and context are read
+func SetAuthLoader(loader AuthLoader) {
+	authMutex.Lock()
+	defer authMutex.Unlock()
+	authLoader, authLoaded = loader, false
+}
+
+// token in DINGO_TOKEN has priority over the stored one
+func getAuth() (string, *AuthIdentity) {
+	authMutex.Lock()
+	defer authMutex.Unlock()
+	if !authLoaded {
+		authLoaded = true
+		if authLoader != nil {
+			authToken, authIdentity = authLoader()
+		}
+		if token := os.Getenv(ENV_DINGO_TOKEN); token != "" {
+			authToken, authIdentity = token, &AuthIdentity{User: ENV_DINGO_TOKEN}
+		}
+		logger.AddSecretValue(authToken)
+	}
+	return authToken, authIdentity
+}
+
+func withAuth(ctx context.Context) context.Context {
+	token, _ := getAuth()
+	if token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, AUTH_METADATA_KEY, AUTH_TOKEN_SCHEME+token)
+}
+
+func authErrorHint(err error) string {
+	message := status.Convert(err).Message()
+	token, identity := getAuth()
+	if identity == nil {
+		identity = &AuthIdentity{}
+	}
+	user := identity.User
+	if identity.Role != "" {
+		user = fmt.Sprintf("%s (role %s)", identity.User, identity.Role)
+	}
+	if identity.Context != "" {
+		user = fmt.Sprintf("%s in context %s", user, identity.Context)
+	}
+
+	if status.Code(err) == codes.PermissionDenied {
+		if token == "" {
+			return fmt.Sprintf("%s, not logged in, login by `dingo auth login` with a token of the required role", message)
+		}
+		return fmt.Sprintf("%s, logged in as %s, ask an administrator for a token of the required role", message, user)
+	}
+	switch {
+	case token == "":
+		return fmt.Sprintf("%s, not logged in, login by `dingo auth login`", message)
+	case !identity.ExpiresAt.IsZero() && identity.ExpiresAt.Before(time.Now()):
+		return fmt.Sprintf("%s, token of %s expired at %s, login again by `dingo auth login`",
+			message, user, identity.ExpiresAt.Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("%s, token of %s is rejected, login again by `dingo auth login`", message, user)
+	}
+}
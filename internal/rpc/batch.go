@@ -0,0 +1,66 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"sync"
+
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// list commands send requests of their targets (filesystems, quota directories, mds ...)
+// at once instead of one by one, connections are shared so a batch costs about one round trip
+
+// commands without the flag use global.rpcconcurrency or the default
+func rpcConcurrency(cmd *cobra.Command) int {
+	if concurrency := utils.GetUint32Flag(cmd, utils.RPCCONCURRENCY); concurrency > 0 {
+		return int(concurrency)
+	}
+	return int(utils.DEFAULT_RPCCONCURRENCY)
+}
+
+// Batch calls call for all items with at most --rpcconcurrency calls at once,
+// results and errors are in the order of items
+func Batch[T any, R any](cmd *cobra.Command, items []T, call func(T) (R, error)) ([]R, []error) {
+	results := make([]R, len(items))
+	errs := make([]error, len(items))
+
+	var wg sync.WaitGroup
+	concurrent := make(chan struct{}, rpcConcurrency(cmd))
+	for i := range items {
+		wg.Add(1)
+		concurrent <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-concurrent
+				wg.Done()
+			}()
+			results[i], errs[i] = call(items[i])
+		}(i)
+	}
+	wg.Wait()
+	return results, errs
+}
+
+// FirstError is the first error of a batch in the order of items
+func FirstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
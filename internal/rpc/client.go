@@ -16,12 +16,21 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"reflect"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/profile"
+	"github.com/dingodb/dingocli/internal/tracing"
+	"github.com/dingodb/dingocli/pkg/logger"
 )
 
 var (
@@ -35,6 +44,10 @@ type Rpc struct {
 	RpcRetryDelay time.Duration
 	RpcFuncName   string
 	RpcDataShow   bool
+
+	// guards attempts only, the other fields are not changed after NewRpc
+	mtx      sync.RWMutex
+	attempts int // attempts of the last request
 }
 
 func NewRpc(addrs []string, timeout time.Duration, retryTimes uint32, retryDelay time.Duration, dataShow bool, funcName string) *Rpc {
@@ -48,6 +61,15 @@ func NewRpc(addrs []string, timeout time.Duration, retryTimes uint32, retryDelay
 	}
 }
 
+// whether the last request is sent more than once, a non-idempotent request
+// (e.g. unlink) may succeed in a timed out attempt and fail in the retry
+func (rpc *Rpc) Retried() bool {
+	rpc.mtx.RLock()
+	defer rpc.mtx.RUnlock()
+
+	return rpc.attempts > 1
+}
+
 type RpcFunc interface {
 	NewRpcClient(cc grpc.ClientConnInterface)
 	Stub_Func(ctx context.Context) (interface{}, error)
@@ -59,62 +81,173 @@ type Result struct {
 	result interface{}
 }
 
+// each attempt is logged with --verbose
 func GetRpcResponse(rpc *Rpc, rpcFunc RpcFunc) (interface{}, *errno.ErrorCode) {
 	var result Result
-	for _, address := range rpc.Addrs {
-		conn, err := pool.GetConnection(address, rpc.RpcTimeout, rpc.RpcRetryTimes)
+	if errCode := checkVersion(rpc.Addrs); errCode != nil {
+		return nil, errCode
+	}
+	timeout, rpcRetryTimes, retryDelay := rpc.RpcTimeout, rpc.RpcRetryTimes, rpc.RpcRetryDelay
+	addrs := orderAddrs(rpc.Addrs)
+	refreshed := false
+	attempts := 0
+
+	begin := time.Now()
+	span := tracing.StartRpc(rpc.RpcFuncName)
+	span.SetAttribute("rpc.system", "grpc")
+	span.SetAttribute("rpc.method", rpc.RpcFuncName)
+	span.SetAttribute("rpc.request_bytes", int64(requestSize(rpcFunc)))
+	defer span.End()
+	for i := 0; i < len(addrs); i++ {
+		address := addrs[i]
+		conn, err := pool.GetConnection(address, timeout, rpcRetryTimes)
 		if err != nil {
-			errRpc := errno.ERR_RPC_FAILED
-			errRpc.E(err)
-			result = Result{address, errRpc, nil}
+			result = Result{address, errno.ERR_MDS_UNREACHABLE.E(err), nil}
 			// try other mds address, if provided
 			continue
 		}
 
 		rpcFunc.NewRpcClient(conn)
-		retryTimes := rpc.RpcRetryTimes
 
-		log.Printf("%s: start to rpc [%s],timeout[%v],retrytimes[%d]", address, rpc.RpcFuncName, rpc.RpcTimeout, retryTimes)
-		for {
-			ctx, cancel := context.WithTimeout(context.Background(), rpc.RpcTimeout)
-			defer cancel()
+		log.Printf("%s: start to rpc [%s],timeout[%v],retrytimes[%d]", address, rpc.RpcFuncName, timeout, rpcRetryTimes)
+		var rpcErr error
+		redirected := false
+		for attempt := uint32(0); ; attempt++ {
+			ctx, cancel := context.WithTimeout(span.Inject(withAuth(context.Background())), timeout)
+			start := time.Now()
 			res, err := rpcFunc.Stub_Func(ctx)
-			if err != nil {
-				if retryTimes > 0 { // rpc failed, retrying
-					log.Printf("%s: fail to get rpc [%s] response, retrytimes[%d], retrying...", address, rpc.RpcFuncName, retryTimes)
-					time.Sleep(rpc.RpcRetryDelay)
-					retryTimes--
-					continue
-				} else {
-					result = Result{address, errno.ERR_RPC_FAILED.E(err), nil}
-					log.Printf("%s: fail to get rpc [%s] response", address, rpc.RpcFuncName)
-					break
+			cancel()
+			profile.Record(profile.PHASE_RPC, rpc.RpcFuncName+" "+address, time.Since(start), err != nil)
+			rpcErr = err
+			attempts++
+			debugRpc(address, rpc.RpcFuncName, attempts, rpcFunc, res, err, time.Since(start))
+
+			retry := false
+			if err == nil && redirectResponse(res) {
+				log.Printf("%s: rpc [%s] is not served by the mds, redirect to other mds", address, rpc.RpcFuncName)
+				result = Result{address, errno.ERR_OK, res}
+				redirected = true
+				break
+			} else if err != nil {
+				log.Printf("%s: attempt[%d] of rpc [%s] failed, code[%s], cost[%v]: %v",
+					address, attempt+1, rpc.RpcFuncName, status.Code(err), time.Since(start), err)
+				retry = retryableError(err)
+				if !retry {
+					log.Printf("%s: rpc [%s] error is not retryable", address, rpc.RpcFuncName)
 				}
+			} else if CheckRpcNeedRetry(res) { // rpc ok, but return status != ok
+				log.Printf("%s: attempt[%d] of rpc [%s] returns retryable error, cost[%v]",
+					address, attempt+1, rpc.RpcFuncName, time.Since(start))
+				retry = true
 			}
 
-			// rpc ok, but return status != ok
-			if CheckRpcNeedRetry(res) && retryTimes > 0 {
-				log.Printf("%s: rpc [%s] return error, retrytimes[%d], retrying...", address, rpc.RpcFuncName, retryTimes)
-				time.Sleep(rpc.RpcRetryDelay)
-				retryTimes = retryTimes - 1
-				continue
+			if !retry {
+				if err != nil {
+					result = Result{address, rpcErrorCode(err), nil}
+					log.Printf("%s: fail to get rpc [%s] response", address, rpc.RpcFuncName)
+				} else {
+					// rpc success
+					budget.onSuccess()
+					result = Result{address, errno.ERR_OK, res}
+					log.Printf("%s: get rpc [%s] response successfully, attempts[%d]", address, rpc.RpcFuncName, attempt+1)
+				}
+				break
 			}
-			// rpc success
-			result = Result{address, errno.ERR_OK, res}
 
-			log.Printf("%s: get rpc [%s] response successfully", address, rpc.RpcFuncName)
-			break
+			if attempt >= rpcRetryTimes || !budget.onFailure() {
+				if attempt < rpcRetryTimes {
+					log.Printf("%s: retry budget of rpc is exhausted, give up rpc [%s]", address, rpc.RpcFuncName)
+				}
+				if err != nil {
+					result = Result{address, rpcErrorCode(err), nil}
+				} else {
+					result = Result{address, errno.ERR_OK, res}
+				}
+				log.Printf("%s: fail to get rpc [%s] response after %d attempts", address, rpc.RpcFuncName, attempt+1)
+				break
+			}
+			delay := backoff(retryDelay, attempt)
+			log.Printf("%s: retry rpc [%s] in %v, retrytimes[%d]", address, rpc.RpcFuncName, delay, rpcRetryTimes-attempt)
+			time.Sleep(delay)
 		}
 
-		// Return connection to Pool
-		pool.PutConnection(address, conn)
-		// rpc success
+		// try other mds address if this one is unavailable or does not serve the request,
+		// all mds are tried if none of the addresses serves
+		if redirected {
+			rememberNotServing(address)
+			if i == len(addrs)-1 && !refreshed {
+				refreshed = true
+				addrs = append(addrs, redirectAddrs(addrs)...)
+			}
+			continue
+		}
+		if rpcErr != nil && unreachableError(rpcErr) {
+			continue
+		}
+		if rpcErr == nil {
+			rememberServing(rpc.Addrs, address)
+		}
 		break
 	}
 
+	span.SetAttribute("net.peer.name", result.addr)
+	span.SetAttribute("rpc.attempts", int64(attempts))
+	rpc.mtx.Lock()
+	rpc.attempts = attempts
+	rpc.mtx.Unlock()
+	if message, ok := result.result.(proto.Message); ok {
+		span.SetAttribute("rpc.response_bytes", int64(proto.Size(message)))
+	}
 	if result.err.GetCode() != errno.ERR_OK.GetCode() {
+		span.SetError(result.err)
+		logger.Warnw("rpc failed", "method", rpc.RpcFuncName, "addr", result.addr, "attempts", attempts,
+			"cost", time.Since(begin).String(), "code", result.err.GetCode(), "error", result.err.Error())
 		return nil, result.err
 	}
 
+	logger.Debugw("rpc finished", "method", rpc.RpcFuncName, "addr", result.addr, "attempts", attempts,
+		"cost", time.Since(begin).String())
 	return result.result, result.err
 }
+
+// rpc funcs keep the request in field Request
+func requestMessage(rpcFunc RpcFunc) proto.Message {
+	value := reflect.ValueOf(rpcFunc)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	field := value.Elem().FieldByName("Request")
+	if !field.IsValid() || !field.CanInterface() {
+		return nil
+	}
+	if message, ok := field.Interface().(proto.Message); ok {
+		return message
+	}
+	return nil
+}
+
+func requestSize(rpcFunc RpcFunc) int {
+	if message := requestMessage(rpcFunc); message != nil {
+		return proto.Size(message)
+	}
+	return 0
+}
+
+// classify rpc error by grpc status, so json output has stable error name
+func rpcErrorCode(err error) *errno.ErrorCode {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded:
+		return errno.ERR_RPC_TIMEOUT.E(err)
+	case codes.Unavailable:
+		return errno.ERR_MDS_UNREACHABLE.E(err)
+	case codes.Unauthenticated:
+		return errno.ERR_RPC_UNAUTHENTICATED.S(authErrorHint(err))
+	case codes.PermissionDenied:
+		return errno.ERR_RPC_PERMISSION_DENIED.S(authErrorHint(err))
+	case codes.Unimplemented:
+		return errno.ERR_MDS_VERSION_TOO_OLD.S(fmt.Sprintf("%s, the request is not served by this mds, dingo is built for mds %s",
+			status.Convert(err).Message(), MDS_API_VERSION))
+	default:
+		return errno.ERR_RPC_FAILED.E(err)
+	}
+}
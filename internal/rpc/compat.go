@@ -0,0 +1,185 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/profile"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// version of mds is read from the builtin /version page of its brpc server before
+// the first request, a warning is printed if it is not the version the commands are
+// built against, and commands which need newer mds fail with the required version
+const (
+	MDS_API_VERSION = "v4.0"   // mds api which the commands are built against
+	MIN_MDS_VERSION = "v3.0.0" // older mds does not serve the requests at all
+
+	// minimal mds version of a command, set by RequireMDSVersion
+	ANNOTATION_MIN_MDS_VERSION = "min-mds-version"
+	FLAG_SKIP_VERSION_CHECK    = "skip-version-check"
+
+	MDS_VERSION_URL     = "http://%s/version"
+	MDS_VERSION_TIMEOUT = time.Second
+)
+
+var (
+	versionRegex = regexp.MustCompile(`v?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+	compatMtx     sync.Mutex
+	compatCmd     *cobra.Command
+	compatChecked bool
+	compatErr     *errno.ErrorCode
+)
+
+// major, minor and patch, false if no version is found in s
+func ParseVersion(s string) ([3]int, bool) {
+	var version [3]int
+	match := versionRegex.FindStringSubmatch(s)
+	if match == nil {
+		return version, false
+	}
+	for i := 0; i < 3; i++ {
+		version[i], _ = strconv.Atoi(match[i+1])
+	}
+	return version, true
+}
+
+func CompareVersion(a, b [3]int) int {
+	for i := 0; i < 3; i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func FormatVersion(version [3]int) string {
+	return fmt.Sprintf("v%d.%d.%d", version[0], version[1], version[2])
+}
+
+// GetMDSVersion reads version of the mds at address, e.g. v4.0.1
+func GetMDSVersion(address string) (string, error) {
+	value, err := GetServerVersion(address)
+	if err != nil {
+		return "", err
+	}
+	version, ok := ParseVersion(value)
+	if !ok {
+		return "", fmt.Errorf("no version in '%s' of mds %s", value, address)
+	}
+	return FormatVersion(version), nil
+}
+
+// GetServerVersion reads the /version page of a brpc server, e.g. mds or cache member,
+// which is the version as built, may be with commit
+func GetServerVersion(address string) (string, error) {
+	defer profile.Start(profile.PHASE_HTTP, address+"/version")()
+	client := &http.Client{Timeout: MDS_VERSION_TIMEOUT}
+	response, err := client.Get(fmt.Sprintf(MDS_VERSION_URL, address))
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get version of %s failed: %s", address, response.Status)
+	}
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// command fails before its first request if mds is older than version
+func RequireMDSVersion(cmd *cobra.Command, version string) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[ANNOTATION_MIN_MDS_VERSION] = version
+}
+
+// the check is done by the first request of the command
+func SetVersionCheck(cmd *cobra.Command) {
+	compatMtx.Lock()
+	defer compatMtx.Unlock()
+	compatCmd, compatChecked, compatErr = cmd, false, nil
+}
+
+func checkVersion(addrs []string) *errno.ErrorCode {
+	compatMtx.Lock()
+	defer compatMtx.Unlock()
+	if compatChecked || compatCmd == nil || len(addrs) == 0 {
+		return compatErr
+	}
+	compatChecked = true
+	if skip, _ := compatCmd.Flags().GetBool(FLAG_SKIP_VERSION_CHECK); skip {
+		return nil
+	}
+
+	var address, value string
+	var err error
+	for _, address = range addrs {
+		if value, err = GetMDSVersion(address); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		// version is unknown, e.g. http is disabled, requests are sent as before
+		logger.Warnf("check version of mds failed: %v", err)
+		return nil
+	}
+	compatErr = checkMDSVersion(compatCmd, address, value)
+	return compatErr
+}
+
+func checkMDSVersion(cmd *cobra.Command, address, value string) *errno.ErrorCode {
+	version, _ := ParseVersion(value)
+	api, _ := ParseVersion(MDS_API_VERSION)
+	minimal, _ := ParseVersion(MIN_MDS_VERSION)
+
+	if required := cmd.Annotations[ANNOTATION_MIN_MDS_VERSION]; required != "" {
+		if v, ok := ParseVersion(required); ok && CompareVersion(version, v) < 0 {
+			return errno.ERR_MDS_VERSION_TOO_OLD.F("'%s' requires MDS >= %s, mds %s is %s, upgrade mds or use --%s to try anyway",
+				cmd.CommandPath(), FormatVersion(v), address, value, FLAG_SKIP_VERSION_CHECK)
+		}
+	}
+	if CompareVersion(version, minimal) < 0 {
+		return errno.ERR_MDS_VERSION_TOO_OLD.F("dingo requires MDS >= %s, mds %s is %s, use --%s to try anyway",
+			MIN_MDS_VERSION, address, value, FLAG_SKIP_VERSION_CHECK)
+	}
+	if version[0] != api[0] || version[1] != api[1] {
+		message := fmt.Sprintf("mds %s is %s, dingo is built for mds %s, some commands may fail or show partial results",
+			address, value, MDS_API_VERSION)
+		logger.Warnf("%s", message)
+		fmt.Fprintf(os.Stderr, "%s: %s\n", color.YellowString("[WARNING]"), message)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"strings"
+
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	COMPLETION_RPC_TIMEOUT     = "3s"
+	COMPLETION_RPC_RETRY_TIMES = "0"
+)
+
+// complete filesystem names by querying mds, shell should not hang on an unreachable cluster,
+// so rpc timeout and retry times are limited unless specified in command line
+func CompleteFsName(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	utils.ReadCommandConfig(cmd)
+	for name, value := range map[string]string{
+		utils.RPCTIMEOUT:    COMPLETION_RPC_TIMEOUT,
+		utils.RPCRETRYTIMES: COMPLETION_RPC_RETRY_TIMES,
+	} {
+		if flag := cmd.Flag(name); flag != nil && !flag.Changed {
+			cmd.Flags().Set(name, value)
+		}
+	}
+
+	fsInfos, err := ListFsInfo(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := []string{}
+	for _, fsInfo := range fsInfos {
+		if strings.HasPrefix(fsInfo.GetFsName(), toComplete) {
+			names = append(names, fsInfo.GetFsName())
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// --debug-rpc dumps each attempt of rpc requests to stderr or a file,
+// messages are redacted like logs, so the dump can be attached to an issue
+const (
+	FLAG_DEBUG_RPC   = "debug-rpc"
+	DEBUG_RPC_STDERR = "stderr"
+)
+
+var (
+	debugMtx    sync.Mutex
+	debugWriter io.Writer
+	debugFile   *os.File
+)
+
+func init() {
+	// fields of rpc messages which are not flags
+	logger.AddSensitiveKeys("password", "token", "secretKey", "secret_key")
+}
+
+// target is stderr or a file which is appended
+func StartDebugRpc(target string) error {
+	debugMtx.Lock()
+	defer debugMtx.Unlock()
+	if target == "" {
+		return nil
+	}
+	if target == DEBUG_RPC_STDERR {
+		debugWriter = os.Stderr
+		return nil
+	}
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("open rpc debug file %s failed: %v", target, err)
+	}
+	debugWriter, debugFile = file, file
+	return nil
+}
+
+func StopDebugRpc() {
+	debugMtx.Lock()
+	defer debugMtx.Unlock()
+	if debugFile != nil {
+		debugFile.Close()
+	}
+	debugWriter, debugFile = nil, nil
+}
+
+func debugRpcEnabled() bool {
+	debugMtx.Lock()
+	defer debugMtx.Unlock()
+	return debugWriter != nil
+}
+
+func messageJson(message interface{}) string {
+	msg, ok := message.(proto.Message)
+	if !ok || msg == nil {
+		return "{}"
+	}
+	data, err := output.ProtoMessageToJson(msg)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return data
+}
+
+func indent(text string) string {
+	return "  " + strings.ReplaceAll(strings.TrimRight(text, "\n"), "\n", "\n  ")
+}
+
+// one block per attempt, written at once so blocks of concurrent requests are not mixed
+func debugRpc(address, method string, attempt int, rpcFunc RpcFunc, response interface{}, err error, latency time.Duration) {
+	if !debugRpcEnabled() {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s >>> %s %s (attempt %d)\n", time.Now().Format(time.RFC3339Nano), address, method, attempt)
+	fmt.Fprintf(&b, "%s\n", indent(messageJson(requestMessage(rpcFunc))))
+	if err != nil {
+		st := status.Convert(err)
+		fmt.Fprintf(&b, "<<< %s %s status=%s latency=%v\n  %s\n", address, method, st.Code(), latency, st.Message())
+	} else {
+		code := "OK"
+		if checker, ok := response.(MdsStatusChecker); ok && checker.GetError() != nil {
+			code = checker.GetError().GetErrcode().String()
+		}
+		fmt.Fprintf(&b, "<<< %s %s status=OK errcode=%s latency=%v\n%s\n", address, method, code, latency, indent(messageJson(response)))
+	}
+
+	debugMtx.Lock()
+	defer debugMtx.Unlock()
+	if debugWriter != nil {
+		fmt.Fprint(debugWriter, logger.Redact(b.String()))
+	}
+}
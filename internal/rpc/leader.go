@@ -0,0 +1,107 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"strings"
+	"sync"
+
+	mdsError "github.com/dingodb/dingocli/proto/dingofs/proto/error"
+)
+
+// mds which does not serve a request answers EREDIRECT or ENOT_SERVE, the request is sent
+// to the next address at once instead of backing off, and the address which serves is tried
+// first by later requests of the invocation, so the order of --mdsaddr does not matter
+var (
+	redirectErrors = map[mdsError.Errno]bool{
+		mdsError.Errno_EREDIRECT:  true,
+		mdsError.Errno_ENOT_SERVE: true,
+	}
+
+	servingMtx   sync.RWMutex
+	servingAddrs = map[string]string{} // address list -> address serving it
+	notServing   = map[string]bool{}
+)
+
+func redirectResponse(result interface{}) bool {
+	if checker, ok := result.(MdsStatusChecker); ok {
+		return redirectErrors[checker.GetError().GetErrcode()]
+	}
+	return false
+}
+
+func addrsKey(addrs []string) string {
+	return strings.Join(addrs, ",")
+}
+
+// serving address first, addresses which redirected last
+func orderAddrs(addrs []string) []string {
+	servingMtx.RLock()
+	defer servingMtx.RUnlock()
+
+	serving := servingAddrs[addrsKey(addrs)]
+	ordered := make([]string, 0, len(addrs))
+	if serving != "" {
+		ordered = append(ordered, serving)
+	}
+	redirected := []string{}
+	for _, address := range addrs {
+		switch {
+		case address == serving:
+		case notServing[address]:
+			redirected = append(redirected, address)
+		default:
+			ordered = append(ordered, address)
+		}
+	}
+	return append(ordered, redirected...)
+}
+
+func rememberServing(addrs []string, address string) {
+	servingMtx.Lock()
+	defer servingMtx.Unlock()
+	servingAddrs[addrsKey(addrs)] = address
+	delete(notServing, address)
+}
+
+func rememberNotServing(address string) {
+	servingMtx.Lock()
+	defer servingMtx.Unlock()
+	notServing[address] = true
+	for key, serving := range servingAddrs {
+		if serving == address {
+			delete(servingAddrs, key)
+		}
+	}
+}
+
+// addresses of all mds which are not tried yet, the router of filesystem is refreshed
+// as partitions may be moved to other mds
+func redirectAddrs(tried []string) []string {
+	addrs := []string{}
+	for _, address := range refreshFsMDSRouter() {
+		found := false
+		for _, t := range tried {
+			if t == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			addrs = append(addrs, address)
+		}
+	}
+	return addrs
+}
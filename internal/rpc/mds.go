@@ -171,6 +171,42 @@ type RestoreFromTrashRpc struct {
 	mdsClient mds.MDSServiceClient
 }
 
+type ListClientRpc struct {
+	Info      *Rpc
+	Request   *mds.ListClientRequest
+	mdsClient mds.MDSServiceClient
+}
+
+type SetAttrRpc struct {
+	Info      *Rpc
+	Request   *mds.SetAttrRequest
+	mdsClient mds.MDSServiceClient
+}
+
+type MkNodRpc struct {
+	Info      *Rpc
+	Request   *mds.MkNodRequest
+	mdsClient mds.MDSServiceClient
+}
+
+type SymlinkRpc struct {
+	Info      *Rpc
+	Request   *mds.SymlinkRequest
+	mdsClient mds.MDSServiceClient
+}
+
+type LinkRpc struct {
+	Info      *Rpc
+	Request   *mds.LinkRequest
+	mdsClient mds.MDSServiceClient
+}
+
+type WriteSliceRpc struct {
+	Info      *Rpc
+	Request   *mds.WriteSliceRequest
+	mdsClient mds.MDSServiceClient
+}
+
 // check interface
 var _ RpcFunc = (*GetMdsRpc)(nil)           // check interface
 var _ RpcFunc = (*CreateFsRpc)(nil)         // check interface
@@ -198,6 +234,12 @@ var _ RpcFunc = (*UpdateFsInfoRpc)(nil)     // check interface
 var _ RpcFunc = (*ReadSliceRpc)(nil)        // check interface
 var _ RpcFunc = (*LookupRpc)(nil)           // check interface
 var _ RpcFunc = (*RestoreFromTrashRpc)(nil) // check interface
+var _ RpcFunc = (*ListClientRpc)(nil)       // check interface
+var _ RpcFunc = (*SetAttrRpc)(nil)          // check interface
+var _ RpcFunc = (*MkNodRpc)(nil)            // check interface
+var _ RpcFunc = (*SymlinkRpc)(nil)          // check interface
+var _ RpcFunc = (*LinkRpc)(nil)             // check interface
+var _ RpcFunc = (*WriteSliceRpc)(nil)       // check interface
 
 func (mdsFs *GetMDSRpc) NewRpcClient(cc grpc.ClientConnInterface) {
 	mdsFs.mdsClient = mds.NewMDSServiceClient(cc)
@@ -468,3 +510,63 @@ func (restoreFromTrash *RestoreFromTrashRpc) Stub_Func(ctx context.Context) (int
 	output.ShowRpcData(restoreFromTrash.Request, response, restoreFromTrash.Info.RpcDataShow)
 	return response, err
 }
+
+func (listClient *ListClientRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	listClient.mdsClient = mds.NewMDSServiceClient(cc)
+}
+
+func (listClient *ListClientRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := listClient.mdsClient.ListClient(ctx, listClient.Request)
+	output.ShowRpcData(listClient.Request, response, listClient.Info.RpcDataShow)
+	return response, err
+}
+
+func (setAttr *SetAttrRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	setAttr.mdsClient = mds.NewMDSServiceClient(cc)
+}
+
+func (setAttr *SetAttrRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := setAttr.mdsClient.SetAttr(ctx, setAttr.Request)
+	output.ShowRpcData(setAttr.Request, response, setAttr.Info.RpcDataShow)
+	return response, err
+}
+
+func (mkNod *MkNodRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	mkNod.mdsClient = mds.NewMDSServiceClient(cc)
+}
+
+func (mkNod *MkNodRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := mkNod.mdsClient.MkNod(ctx, mkNod.Request)
+	output.ShowRpcData(mkNod.Request, response, mkNod.Info.RpcDataShow)
+	return response, err
+}
+
+func (symlink *SymlinkRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	symlink.mdsClient = mds.NewMDSServiceClient(cc)
+}
+
+func (symlink *SymlinkRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := symlink.mdsClient.Symlink(ctx, symlink.Request)
+	output.ShowRpcData(symlink.Request, response, symlink.Info.RpcDataShow)
+	return response, err
+}
+
+func (link *LinkRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	link.mdsClient = mds.NewMDSServiceClient(cc)
+}
+
+func (link *LinkRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := link.mdsClient.Link(ctx, link.Request)
+	output.ShowRpcData(link.Request, response, link.Info.RpcDataShow)
+	return response, err
+}
+
+func (writeSlice *WriteSliceRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	writeSlice.mdsClient = mds.NewMDSServiceClient(cc)
+}
+
+func (writeSlice *WriteSliceRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response, err := writeSlice.mdsClient.WriteSlice(ctx, writeSlice.Request)
+	output.ShowRpcData(writeSlice.Request, response, writeSlice.Info.RpcDataShow)
+	return response, err
+}
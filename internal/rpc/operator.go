@@ -128,6 +128,66 @@ func ListFsInfo(cmd *cobra.Command) ([]*mds.FsInfo, error) {
 	return fsInfos, nil
 }
 
+// list names of all cache groups
+func ListCacheGroups(cmd *cobra.Command) ([]string, error) {
+	mdsRpc, err := CreateNewMdsRpc(cmd, "ListGroups")
+	if err != nil {
+		return nil, err
+	}
+	listRpc := &ListCacheGroupRpc{Info: mdsRpc, Request: &mds.ListGroupsRequest{}}
+
+	response, rpcError := GetRpcResponse(listRpc.Info, listRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.ListGroupsResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetGroupNames(), nil
+}
+
+// list cache members of all groups
+func ListCacheMembers(cmd *cobra.Command) ([]*mds.CacheGroupMember, error) {
+	mdsRpc, err := CreateNewMdsRpc(cmd, "ListMembers")
+	if err != nil {
+		return nil, err
+	}
+	listRpc := &ListCacheMemberRpc{Info: mdsRpc, Request: &mds.ListMembersRequest{}}
+
+	response, rpcError := GetRpcResponse(listRpc.Info, listRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.ListMembersResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetMembers(), nil
+}
+
+// list clients registered in mds by heartbeat
+func ListClients(cmd *cobra.Command) ([]*mds.Client, error) {
+	mdsRpc, err := CreateNewMdsRpc(cmd, "ListClient")
+	if err != nil {
+		return nil, err
+	}
+	listClientRpc := &ListClientRpc{Info: mdsRpc, Request: &mds.ListClientRequest{}}
+
+	response, rpcError := GetRpcResponse(listClientRpc.Info, listClientRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.ListClientResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetClients(), nil
+}
+
 // get fsinfo by fsid or fsname
 func GetFsInfo(cmd *cobra.Command, fsId uint32, fsName string) (*mds.FsInfo, error) {
 	// first read from cache
@@ -219,6 +279,10 @@ func DeleteFile(cmd *cobra.Command, fsId uint32, parentId uint64, name string, e
 	}
 	result := response.(*mds.UnLinkResponse)
 	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		// the timed out attempt may have removed the entry already
+		if mdsRpc.Retried() && mdsErr.GetErrcode() == pbmdserror.Errno_ENOT_FOUND {
+			return nil
+		}
 		return errno.ERR_RPC_FAILED.S(mdsErr.String())
 	}
 
@@ -249,6 +313,10 @@ func DeleteDirectory(cmd *cobra.Command, fsId uint32, parentId uint64, name stri
 	}
 	result := response.(*mds.RmDirResponse)
 	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		// the timed out attempt may have removed the entry already
+		if mdsRpc.Retried() && mdsErr.GetErrcode() == pbmdserror.Errno_ENOT_FOUND {
+			return nil
+		}
 		return errno.ERR_RPC_FAILED.S(mdsErr.String())
 	}
 
@@ -734,6 +802,221 @@ func RestoreFromTrash(cmd *cobra.Command, fsId uint32, bucketIno uint64, trashNa
 	return nil
 }
 
+// attributes to set by SetAttr, same as FUSE_SET_ATTR_*
+const (
+	SET_ATTR_MODE  = uint32(1 << 0)
+	SET_ATTR_UID   = uint32(1 << 1)
+	SET_ATTR_GID   = uint32(1 << 2)
+	SET_ATTR_SIZE  = uint32(1 << 3)
+	SET_ATTR_ATIME = uint32(1 << 4)
+	SET_ATTR_MTIME = uint32(1 << 5)
+)
+
+// SetAttr changes mode, uid or gid of an inode selected by toSet, routed like
+// GetInode: a file by its parent's owner, a directory by its own.
+func SetAttr(cmd *cobra.Command, fsId uint32, inodeId uint64, parent uint64, toSet uint32, mode uint32, uid uint32, gid uint32, epoch uint64) (*mds.Inode, error) {
+	return SetInodeAttr(cmd, fsId, inodeId, parent, toSet, &mds.Inode{Mode: mode, Uid: uid, Gid: gid}, epoch)
+}
+
+// SetInodeAttr changes attributes of an inode selected by toSet to the values
+// in attr, times are in nanoseconds as in Inode.
+func SetInodeAttr(cmd *cobra.Command, fsId uint32, inodeId uint64, parent uint64, toSet uint32, attr *mds.Inode, epoch uint64) (*mds.Inode, error) {
+	var endpoint []string
+	if IsFile(inodeId) && parent > 0 {
+		endpoint = GetEndPoint(parent)
+	} else {
+		endpoint = GetEndPoint(inodeId)
+	}
+	if len(endpoint) == 0 {
+		return nil, fmt.Errorf("endpoint is null")
+	}
+	mdsRpc := CreateNewMdsRpcWithEndPoint(cmd, endpoint, "SetAttr")
+	setAttrRpc := &SetAttrRpc{
+		Info: mdsRpc,
+		Request: &mds.SetAttrRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsId:    fsId,
+			Ino:     inodeId,
+			ToSet:   toSet,
+			Mode:    attr.GetMode(),
+			Uid:     attr.GetUid(),
+			Gid:     attr.GetGid(),
+			Length:  attr.GetLength(),
+			Atime:   attr.GetAtime(),
+			Mtime:   attr.GetMtime(),
+		},
+	}
+	response, rpcError := GetRpcResponse(setAttrRpc.Info, setAttrRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.SetAttrResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetInode(), nil
+}
+
+// MkDir creates a directory under parent, routed by the parent inode's owner.
+func MkDir(cmd *cobra.Command, fsId uint32, parent uint64, name string, mode uint32, uid uint32, gid uint32, epoch uint64) (*mds.Inode, error) {
+	endpoint := GetEndPoint(parent)
+	if len(endpoint) == 0 {
+		return nil, fmt.Errorf("endpoint is null")
+	}
+	mdsRpc := CreateNewMdsRpcWithEndPoint(cmd, endpoint, "MkDir")
+	mkDirRpc := &MkDirRpc{
+		Info: mdsRpc,
+		Request: &mds.MkDirRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsId:    fsId,
+			Name:    name,
+			Length:  4096,
+			Uid:     uid,
+			Gid:     gid,
+			Mode:    mode,
+			Parent:  parent,
+		},
+	}
+	response, rpcError := GetRpcResponse(mkDirRpc.Info, mkDirRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.MkDirResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetInode(), nil
+}
+
+// MkNod creates an empty file under parent, routed by the parent inode's owner.
+func MkNod(cmd *cobra.Command, fsId uint32, parent uint64, name string, mode uint32, uid uint32, gid uint32, epoch uint64) (*mds.Inode, error) {
+	endpoint := GetEndPoint(parent)
+	if len(endpoint) == 0 {
+		return nil, fmt.Errorf("endpoint is null")
+	}
+	mdsRpc := CreateNewMdsRpcWithEndPoint(cmd, endpoint, "MkNod")
+	mkNodRpc := &MkNodRpc{
+		Info: mdsRpc,
+		Request: &mds.MkNodRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsId:    fsId,
+			Name:    name,
+			Uid:     uid,
+			Gid:     gid,
+			Mode:    mode,
+			Parent:  parent,
+		},
+	}
+	response, rpcError := GetRpcResponse(mkNodRpc.Info, mkNodRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.MkNodResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetInode(), nil
+}
+
+// Symlink creates a symbolic link to target under parent, routed by the parent
+// inode's owner.
+func Symlink(cmd *cobra.Command, fsId uint32, parent uint64, name string, target string, uid uint32, gid uint32, epoch uint64) (*mds.Inode, error) {
+	endpoint := GetEndPoint(parent)
+	if len(endpoint) == 0 {
+		return nil, fmt.Errorf("endpoint is null")
+	}
+	mdsRpc := CreateNewMdsRpcWithEndPoint(cmd, endpoint, "Symlink")
+	symlinkRpc := &SymlinkRpc{
+		Info: mdsRpc,
+		Request: &mds.SymlinkRequest{
+			Context: &mds.Context{Epoch: epoch},
+			FsId:    fsId,
+			Parent:  parent,
+			Name:    name,
+			Symlink: target,
+			Uid:     uid,
+			Gid:     gid,
+		},
+	}
+	response, rpcError := GetRpcResponse(symlinkRpc.Info, symlinkRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.SymlinkResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetInode(), nil
+}
+
+// Link adds a hard link of a file inode under newParent, routed by the new
+// parent inode's owner.
+func Link(cmd *cobra.Command, fsId uint32, inodeId uint64, newParent uint64, newName string, epoch uint64) (*mds.Inode, error) {
+	endpoint := GetEndPoint(newParent)
+	if len(endpoint) == 0 {
+		return nil, fmt.Errorf("endpoint is null")
+	}
+	mdsRpc := CreateNewMdsRpcWithEndPoint(cmd, endpoint, "Link")
+	linkRpc := &LinkRpc{
+		Info: mdsRpc,
+		Request: &mds.LinkRequest{
+			Context:   &mds.Context{Epoch: epoch},
+			FsId:      fsId,
+			Ino:       inodeId,
+			NewParent: newParent,
+			NewName:   newName,
+		},
+	}
+	response, rpcError := GetRpcResponse(linkRpc.Info, linkRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return nil, rpcError
+	}
+	result := response.(*mds.LinkResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return nil, errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return result.GetInode(), nil
+}
+
+// WriteSlice adds slices to chunks of a file inode. Like ReadSliceAll, the
+// file's endpoint is routed by its parent inode.
+func WriteSlice(cmd *cobra.Command, fsId uint32, inodeId uint64, parent uint64, chunks []*mds.Chunk, epoch uint64) error {
+	endpoint := GetEndPoint(parent)
+	if len(endpoint) == 0 {
+		return fmt.Errorf("endpoint is null")
+	}
+	deltaSlices := make([]*mds.DeltaSlice, 0, len(chunks))
+	for _, chunk := range chunks {
+		deltaSlices = append(deltaSlices, &mds.DeltaSlice{ChunkIndex: chunk.GetIndex(), Slices: chunk.GetSlices()})
+	}
+	mdsRpc := CreateNewMdsRpcWithEndPoint(cmd, endpoint, "WriteSlice")
+	writeSliceRpc := &WriteSliceRpc{
+		Info: mdsRpc,
+		Request: &mds.WriteSliceRequest{
+			Context:     &mds.Context{Epoch: epoch},
+			FsId:        fsId,
+			Parent:      parent,
+			Ino:         inodeId,
+			DeltaSlices: deltaSlices,
+		},
+	}
+	response, rpcError := GetRpcResponse(writeSliceRpc.Info, writeSliceRpc)
+	if rpcError.GetCode() != errno.ERR_OK.GetCode() {
+		return rpcError
+	}
+	result := response.(*mds.WriteSliceResponse)
+	if mdsErr := result.GetError(); mdsErr.GetErrcode() != pbmdserror.Errno_OK {
+		return errno.ERR_RPC_FAILED.S(mdsErr.String())
+	}
+
+	return nil
+}
+
 // UpdateFsInfo sends a full FsInfo back to the mds (read-modify-write); the
 // server merges runtime-mutable fields. This is fs-level, not inode-scoped.
 func UpdateFsInfo(cmd *cobra.Command, fsName string, fsInfo *mds.FsInfo) error {
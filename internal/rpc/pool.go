@@ -19,36 +19,102 @@ import (
 	"log"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 )
 
+// connections are shared by all requests of one invocation, a grpc connection
+// multiplexes concurrent requests, so a few connections per address are enough
+// even for walkers with many threads
+const (
+	POOL_CONNECTIONS_PER_ADDRESS = 4
+
+	KEEPALIVE_TIME    = 30 * time.Second
+	KEEPALIVE_TIMEOUT = 10 * time.Second
+)
+
+type addressConns struct {
+	mux   sync.Mutex // serialize dialing of the address
+	conns []*grpc.ClientConn
+	next  uint32
+}
+
 type ConnectionPool struct {
-	connections map[string][]*grpc.ClientConn
+	connections map[string]*addressConns
+	size        int
 	mux         sync.RWMutex
 }
 
 func NewConnectionPool() *ConnectionPool {
 	return &ConnectionPool{
-		connections: make(map[string][]*grpc.ClientConn),
+		connections: make(map[string]*addressConns),
+		size:        POOL_CONNECTIONS_PER_ADDRESS,
 	}
 }
 
-func (c *ConnectionPool) GetConnection(address string, timeout time.Duration, retrytimes uint32) (*grpc.ClientConn, error) {
+func (c *ConnectionPool) addressConns(address string) *addressConns {
+	c.mux.RLock()
+	entry, ok := c.connections[address]
+	c.mux.RUnlock()
+	if ok {
+		return entry
+	}
+
 	c.mux.Lock()
-	conns, ok := c.connections[address]
-	size := len(conns)
-	if ok && size > 0 {
-		log.Printf("get connection ok,address[%s],size[%d]\n", address, size)
-		conn := c.connections[address][0]
-		c.connections[address] = c.connections[address][1:]
-		c.mux.Unlock()
-		return conn, nil
+	defer c.mux.Unlock()
+	if entry, ok = c.connections[address]; !ok {
+		entry = &addressConns{}
+		c.connections[address] = entry
+	}
+	return entry
+}
+
+func usable(conn *grpc.ClientConn) bool {
+	state := conn.GetState()
+	return state != connectivity.Shutdown && state != connectivity.TransientFailure
+}
+
+// GetConnection returns connections of the address in turn, a new connection is dialed
+// until the pool of the address is full, broken connections are replaced
+func (c *ConnectionPool) GetConnection(address string, timeout time.Duration, retrytimes uint32) (*grpc.ClientConn, error) {
+	entry := c.addressConns(address)
+	entry.mux.Lock()
+	defer entry.mux.Unlock()
+
+	conns := entry.conns[:0]
+	for _, conn := range entry.conns {
+		if usable(conn) {
+			conns = append(conns, conn)
+		} else {
+			log.Printf("%s: drop broken connection, state[%s]", address, conn.GetState())
+			conn.Close()
+		}
+	}
+	entry.conns = conns
+	if len(entry.conns) >= c.size {
+		index := atomic.AddUint32(&entry.next, 1) % uint32(len(entry.conns))
+		log.Printf("get connection ok,address[%s],index[%d],size[%d]\n", address, index, len(entry.conns))
+		return entry.conns[index], nil
 	}
-	c.mux.Unlock()
 
+	conn, err := dial(address, timeout, retrytimes)
+	if err != nil {
+		// reuse established connections of the address
+		if len(entry.conns) > 0 {
+			return entry.conns[0], nil
+		}
+		return nil, err
+	}
+	entry.conns = append(entry.conns, conn)
+	return conn, nil
+}
+
+func dial(address string, timeout time.Duration, retrytimes uint32) (*grpc.ClientConn, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
@@ -59,10 +125,15 @@ func (c *ConnectionPool) GetConnection(address string, timeout time.Duration, re
 			grpc.WithBlock(),
 			grpc.WithMaxMsgSize(math.MaxInt32),
 			grpc.WithInitialConnWindowSize(math.MaxInt32),
-			grpc.WithInitialWindowSize(math.MaxInt32))
+			grpc.WithInitialWindowSize(math.MaxInt32),
+			grpc.WithKeepaliveParams(keepalive.ClientParameters{
+				Time:                KEEPALIVE_TIME,
+				Timeout:             KEEPALIVE_TIMEOUT,
+				PermitWithoutStream: true,
+			}))
 		if err != nil {
 			log.Printf("%s: fail to dial", address)
-			if retrytimes > 0 {
+			if retrytimes > 0 && ctx.Err() == nil {
 				retrytimes--
 				continue
 			}
@@ -74,30 +145,38 @@ func (c *ConnectionPool) GetConnection(address string, timeout time.Duration, re
 	}
 }
 
+// Release closes connections of the address, e.g. the address is not a member of the cluster any more
 func (c *ConnectionPool) Release(address string) {
 	c.mux.Lock()
-	defer c.mux.Unlock()
+	entry, ok := c.connections[address]
+	delete(c.connections, address)
+	c.mux.Unlock()
+	if !ok {
+		return
+	}
 
-	for _, conn := range c.connections[address] {
+	entry.mux.Lock()
+	defer entry.mux.Unlock()
+	for _, conn := range entry.conns {
 		conn.Close()
 	}
-	delete(c.connections, address)
-}
-
-func (c *ConnectionPool) PutConnection(address string, conn *grpc.ClientConn) {
-	c.mux.Lock()
-	defer c.mux.Unlock()
-	c.connections[address] = append(c.connections[address], conn)
+	entry.conns = nil
 }
 
 func (c *ConnectionPool) Close() {
 	c.mux.Lock()
-	defer c.mux.Unlock()
+	addresses := make([]string, 0, len(c.connections))
+	for address := range c.connections {
+		addresses = append(addresses, address)
+	}
+	c.mux.Unlock()
 
-	for address, conns := range c.connections {
-		for _, conn := range conns {
-			conn.Close()
-		}
-		delete(c.connections, address)
+	for _, address := range addresses {
+		c.Release(address)
 	}
 }
+
+// CloseConnections closes all pooled connections when the command is finished
+func CloseConnections() {
+	pool.Close()
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// methods of services bundled in dingo, called with requests built from json,
+// so methods without a dedicated command can be called too
+type RawRpc struct {
+	Info    *Rpc
+	Method  protoreflect.MethodDescriptor
+	Request *dynamicpb.Message
+	conn    grpc.ClientConnInterface
+}
+
+var _ RpcFunc = (*RawRpc)(nil)
+
+func (r *RawRpc) NewRpcClient(cc grpc.ClientConnInterface) {
+	r.conn = cc
+}
+
+func (r *RawRpc) Stub_Func(ctx context.Context) (interface{}, error) {
+	response := dynamicpb.NewMessage(r.Method.Output())
+	err := r.conn.Invoke(ctx, FullMethodName(r.Method), r.Request, response)
+	return response, err
+}
+
+// e.g. /dingofs.pb.mds.MDSService/GetFsInfo
+func FullMethodName(method protoreflect.MethodDescriptor) string {
+	return fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+}
+
+// all methods of bundled services, sorted by full name, descriptors are registered
+// by the generated packages imported by dingo
+func ListMethods() []protoreflect.MethodDescriptor {
+	methods := []protoreflect.MethodDescriptor{}
+	protoregistry.GlobalFiles.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			for j := 0; j < services.Get(i).Methods().Len(); j++ {
+				methods = append(methods, services.Get(i).Methods().Get(j))
+			}
+		}
+		return true
+	})
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].FullName() < methods[j].FullName()
+	})
+	return methods
+}
+
+// name is Method, Service.Method or package.Service.Method, '/' is same as '.',
+// a name matching methods of several services is ambiguous
+func FindMethod(name string) (protoreflect.MethodDescriptor, error) {
+	name = strings.Trim(strings.ReplaceAll(name, "/", "."), ".")
+	matched := []protoreflect.MethodDescriptor{}
+	for _, method := range ListMethods() {
+		fullName := string(method.FullName())
+		if fullName == name || strings.HasSuffix(fullName, "."+name) {
+			matched = append(matched, method)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("method %s not found, see 'dingo rpc list'", name)
+	case 1:
+		return matched[0], nil
+	default:
+		names := []string{}
+		for _, method := range matched {
+			names = append(names, string(method.FullName()))
+		}
+		return nil, fmt.Errorf("method %s is ambiguous: %s", name, strings.Join(names, ", "))
+	}
+}
+
+// request of the method from json in protojson format, field names can be json or proto names
+func NewRawRequest(method protoreflect.MethodDescriptor, data string) (*dynamicpb.Message, error) {
+	request := dynamicpb.NewMessage(method.Input())
+	if strings.TrimSpace(data) == "" {
+		return request, nil
+	}
+	if err := protojson.Unmarshal([]byte(data), request); err != nil {
+		return nil, fmt.Errorf("invalid request of %s: %v", method.Name(), err)
+	}
+	return request, nil
+}
+
+// error of mds in field error of the response, empty if errcode is OK,
+// raw responses are not checked by GetRpcResponse
+func RawResponseError(response *dynamicpb.Message) string {
+	field := response.Descriptor().Fields().ByName("error")
+	if field == nil || field.Message() == nil || !response.Has(field) {
+		return ""
+	}
+	mdsError := response.Get(field).Message()
+	errcode := field.Message().Fields().ByName("errcode")
+	if errcode == nil || errcode.Kind() != protoreflect.EnumKind || mdsError.Get(errcode).Enum() == 0 {
+		return ""
+	}
+	data, _ := protojson.Marshal(mdsError.Interface())
+	return string(data)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// retries back off exponentially from --rpcretrydelay with jitter, and are throttled by
+// a budget shared by all requests of the invocation, so an unhealthy cluster is not
+// flooded by walkers with many threads
+const (
+	RETRY_MAX_DELAY = 10 * time.Second
+
+	RETRY_BUDGET_MAX     = 100.0 // tokens, a failed request takes 1
+	RETRY_BUDGET_REFUND  = 0.1   // tokens given back by a successful request
+	RETRY_BUDGET_RESERVE = 50.0  // no retry if tokens are below the reserve
+)
+
+type retryBudget struct {
+	mux    sync.Mutex
+	tokens float64
+}
+
+var budget = &retryBudget{tokens: RETRY_BUDGET_MAX}
+
+func (b *retryBudget) onSuccess() {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.tokens = min(b.tokens+RETRY_BUDGET_REFUND, RETRY_BUDGET_MAX)
+}
+
+// failure is recorded and whether a retry is allowed is returned
+func (b *retryBudget) onFailure() bool {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	b.tokens = max(b.tokens-1, 0)
+	return b.tokens > RETRY_BUDGET_RESERVE
+}
+
+// delay before the n-th retry (from 0) is in [d/2, d), d = delay * 2^n
+func backoff(delay time.Duration, retry uint32) time.Duration {
+	if delay <= 0 {
+		return 0
+	}
+	d := delay
+	for i := uint32(0); i < retry && d < RETRY_MAX_DELAY; i++ {
+		d *= 2
+	}
+	d = min(d, RETRY_MAX_DELAY)
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// transient errors are retried, errors of the request itself are never
+func retryableError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		// InvalidArgument, NotFound, AlreadyExists, FailedPrecondition, Unimplemented,
+		// Unauthenticated, PermissionDenied, Internal, ...
+		return false
+	}
+}
+
+// the address is skipped and the next one is tried
+func unreachableError(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
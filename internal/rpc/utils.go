@@ -16,9 +16,13 @@ package rpc
 
 import (
 	"fmt"
+	"log"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/dingodb/dingocli/internal/common"
+	"github.com/dingodb/dingocli/internal/output"
 	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
 	"github.com/spf13/cobra"
@@ -27,6 +31,17 @@ import (
 var (
 	mdsRouter common.MDSRouter
 	routerMtx sync.RWMutex
+
+	// filesystem of the router and all mds, used to refresh the router on redirect
+	routerCmd         *cobra.Command
+	routerFsId        uint32
+	routerMDS         []*mds.MDS
+	routerRefreshing  atomic.Bool
+	routerRefreshedAt time.Time
+)
+
+const (
+	ROUTER_REFRESH_INTERVAL = time.Second
 )
 
 func IsDir(inodeId uint64) bool {
@@ -54,10 +69,8 @@ func GetFsEpochByFsId(cmd *cobra.Command, fsId uint32) (uint64, error) {
 	return epoch, nil
 }
 
+// requests are sent without the lock, they may refresh the router on redirect
 func InitFsMDSRouter(cmd *cobra.Command, fsId uint32) error {
-	routerMtx.Lock()
-	defer routerMtx.Unlock()
-
 	fsInfo, err := GetFsInfo(cmd, fsId, "")
 	if err != nil {
 		return err
@@ -68,12 +81,41 @@ func InitFsMDSRouter(cmd *cobra.Command, fsId uint32) error {
 		return err2
 	}
 
+	routerMtx.Lock()
+	defer routerMtx.Unlock()
 	mdsRouter = common.NewMDSRouter(fsInfo.GetPartitionPolicy().GetType())
 	mdsRouter.Init(mds, fsInfo.GetPartitionPolicy())
+	routerCmd, routerFsId, routerMDS = cmd, fsId, mds
+	routerRefreshedAt = time.Now()
 
 	return nil
 }
 
+// endpoints of all mds after the router is refreshed, the router is refreshed at most
+// once per interval and not by requests of the refresh itself
+func refreshFsMDSRouter() []string {
+	routerMtx.RLock()
+	cmd, fsId, refreshedAt := routerCmd, routerFsId, routerRefreshedAt
+	routerMtx.RUnlock()
+
+	if cmd != nil && time.Since(refreshedAt) >= ROUTER_REFRESH_INTERVAL && routerRefreshing.CompareAndSwap(false, true) {
+		log.Printf("refresh mds router of filesystem[%d]", fsId)
+		if err := InitFsMDSRouter(cmd, fsId); err != nil {
+			log.Printf("refresh mds router of filesystem[%d] failed: %v", fsId, err)
+		}
+		routerRefreshing.Store(false)
+	}
+
+	routerMtx.RLock()
+	defer routerMtx.RUnlock()
+	endpoints := []string{}
+	for _, mdsMeta := range routerMDS {
+		location := mdsMeta.GetLocation()
+		endpoints = append(endpoints, fmt.Sprintf("%s:%d", location.Host, location.Port))
+	}
+	return endpoints
+}
+
 func GetFsMDSRouter() common.MDSRouter {
 	routerMtx.RLock()
 	defer routerMtx.RUnlock()
@@ -117,3 +159,11 @@ func CreateNewMdsRpc(cmd *cobra.Command, serviceName string) (*Rpc, error) {
 
 	return mdsRpc, nil
 }
+
+// reload verbosity of long-running command when configure file changed, rpc timeout
+// and retry settings are read from the reloaded configuration by every new request
+func WatchRpcConfig(cmd *cobra.Command) {
+	utils.WatchCommandConfig(cmd, func() {
+		output.SetShow(utils.GetBoolFlag(cmd, utils.VERBOSE))
+	})
+}
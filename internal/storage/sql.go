@@ -318,9 +318,15 @@ var (
 	// set item
 	SetAnyItem = `UPDATE any SET data = ? WHERE id = ?`
 
+	// insert or replace item
+	ReplaceAnyItem = `INSERT OR REPLACE INTO any(id, data) VALUES(?, ?)`
+
 	// select item by id
 	SelectAnyItem = `SELECT * FROM any WHERE id = ?`
 
+	// select items by id prefix
+	SelectAnyItemsByPrefix = `SELECT * FROM any WHERE id LIKE ? || '%'`
+
 	// delete item
 	DeleteAnyItem = `DELETE from any WHERE id = ?`
 )
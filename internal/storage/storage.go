@@ -427,7 +427,11 @@ func (s *Storage) GetAuditLog(id int64) ([]AuditLog, error) {
 
 // any item prefix
 const (
-	PREFIX_CLIENT_CONFIG = 0x01
+	PREFIX_CLIENT_CONFIG  = 0x01
+	PREFIX_WARMUP_TASK    = 0x02
+	PREFIX_QUOTA_TEMPLATE = 0x03
+	PREFIX_QUOTA_WARN     = 0x04
+	PREFIX_AUTH_TOKEN     = 0x05
 )
 
 func (s *Storage) realId(prefix int, id string) string {
@@ -465,6 +469,99 @@ func (s *Storage) DeleteClientConfig(id string) error {
 	return s.write(DeleteAnyItem, id)
 }
 
+// warmup task, id is the path passed to client
+func (s *Storage) SetWarmupTask(id, data string) error {
+	id = s.realId(PREFIX_WARMUP_TASK, id)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) GetWarmupTasks() ([]Any, error) {
+	result, err := s.db.Query(SelectAnyItemsByPrefix, s.realId(PREFIX_WARMUP_TASK, ""))
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	items := []Any{}
+	var item Any
+	for result.Next() {
+		err = result.Scan(&item.Id, &item.Data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *Storage) DeleteWarmupTask(id string) error {
+	id = s.realId(PREFIX_WARMUP_TASK, id)
+	return s.write(DeleteAnyItem, id)
+}
+
+// quota template of directory, id is fsid and path of the directory
+func (s *Storage) SetQuotaTemplate(id, data string) error {
+	id = s.realId(PREFIX_QUOTA_TEMPLATE, id)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) GetQuotaTemplates() ([]Any, error) {
+	result, err := s.db.Query(SelectAnyItemsByPrefix, s.realId(PREFIX_QUOTA_TEMPLATE, ""))
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	items := []Any{}
+	var item Any
+	for result.Next() {
+		err = result.Scan(&item.Id, &item.Data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *Storage) DeleteQuotaTemplate(id string) error {
+	id = s.realId(PREFIX_QUOTA_TEMPLATE, id)
+	return s.write(DeleteAnyItem, id)
+}
+
+// warning threshold of directory quota, id is fsid and inode id of the directory
+func (s *Storage) SetQuotaWarn(id, data string) error {
+	id = s.realId(PREFIX_QUOTA_WARN, id)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) GetQuotaWarns() ([]Any, error) {
+	result, err := s.db.Query(SelectAnyItemsByPrefix, s.realId(PREFIX_QUOTA_WARN, ""))
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	items := []Any{}
+	var item Any
+	for result.Next() {
+		err = result.Scan(&item.Id, &item.Data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *Storage) DeleteQuotaWarn(id string) error {
+	id = s.realId(PREFIX_QUOTA_WARN, id)
+	return s.write(DeleteAnyItem, id)
+}
+
 func (s *Storage) GetMonitor(clusterId int) (Monitor, error) {
 	monitor := Monitor{
 		ClusterId: clusterId,
@@ -499,3 +596,34 @@ func (s *Storage) DeleteMonitor(clusterId int) error {
 func (s *Storage) ReplaceMonitor(m Monitor) error {
 	return s.write(ReplaceMonitor, m.ClusterId, m.Monitor)
 }
+
+// token attached to rpc requests, id is name of the context
+func (s *Storage) SetAuthToken(id, data string) error {
+	id = s.realId(PREFIX_AUTH_TOKEN, id)
+	return s.write(ReplaceAnyItem, id, data)
+}
+
+func (s *Storage) GetAuthTokens() ([]Any, error) {
+	result, err := s.db.Query(SelectAnyItemsByPrefix, s.realId(PREFIX_AUTH_TOKEN, ""))
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	items := []Any{}
+	var item Any
+	for result.Next() {
+		err = result.Scan(&item.Id, &item.Data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (s *Storage) DeleteAuthToken(id string) error {
+	id = s.realId(PREFIX_AUTH_TOKEN, id)
+	return s.write(DeleteAnyItem, id)
+}
@@ -20,11 +20,17 @@ import (
 	"slices"
 	"sort"
 
+	"github.com/dingodb/dingocli/internal/output"
+	"github.com/dingodb/dingocli/internal/utils"
 	"github.com/olekukonko/tablewriter"
 )
 
 var (
 	table *tablewriter.Table = tablewriter.NewWriter(os.Stdout)
+
+	// header and rows are kept to render in the format of --format
+	header []string
+	rows   [][]string
 )
 
 func init() {
@@ -34,28 +40,56 @@ func init() {
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
 }
 
-func SetHeader(header []string) {
-	table.SetHeader(header)
+func SetHeader(h []string) {
+	header = h
 }
 
 func SetAutoMergeCellsByColumnIndex(cols []int) {
 	table.SetAutoMergeCellsByColumnIndex(cols)
 }
 
-func AppendBulk(rows [][]string) {
-	table.AppendBulk(rows)
+func AppendBulk(r [][]string) {
+	rows = append(rows, r...)
 }
 
 func Append(row []string) {
-	table.Append(row)
+	rows = append(rows, row)
+}
+
+// clear header and rows, so table can be rendered again, e.g. in watch mode
+func Reset() {
+	header, rows = nil, nil
+	table.ClearRows()
 }
 
 func RenderWithNoData(prompt string) {
-	if table.NumLines() != 0 {
-		table.Render()
-	} else {
+	rows, err := output.ProcessRows(header, rows)
+	utils.CheckErr(err)
+
+	format := output.GetOptions().Format
+	// csv and tsv are read by programs, only header is printed if no data
+	if format == output.FORMAT_CSV || format == output.FORMAT_TSV {
+		utils.CheckErr(output.RenderRows(os.Stdout, header, rows))
+		return
+	}
+
+	if len(rows) == 0 {
 		fmt.Println(prompt)
+		return
+	}
+
+	if format == output.FORMAT_TABLE {
+		utils.CheckErr(output.RenderRows(os.Stdout, header, rows))
+		return
+	}
+
+	selectedHeader, selectedRows, err := output.SelectColumns(header, rows, output.GetOptions().Columns)
+	utils.CheckErr(err)
+	if !output.GetOptions().NoHeaders {
+		table.SetHeader(selectedHeader)
 	}
+	table.AppendBulk(selectedRows)
+	table.Render()
 }
 
 func ListMap2ListSortByKeys(rows []map[string]string, headers []string, keys []string) [][]string {
@@ -106,6 +106,13 @@ type (
 		module.ExecOptions
 	}
 
+	UploadFile struct {
+		LocalPath    string
+		HostDestPath string
+		Mode         string // e.g. 0755, not changed if empty
+		module.ExecOptions
+	}
+
 	CreateAndUploadDir struct {
 		HostDirName       string
 		ContainerDestId   *string
@@ -365,6 +372,39 @@ func (s *DownloadFile) Execute(ctx *context.Context) error {
 	return ctx.Module().File().Download(s.RemotePath, s.LocalPath)
 }
 
+// file is uploaded to a temporary path first, then renamed with the privilege of exec options
+func (s *UploadFile) Execute(ctx *context.Context) error {
+	remotePath := utils.RandFilename(TEMP_DIR)
+	if !s.ExecInLocal {
+		err := ctx.Module().File().Upload(s.LocalPath, remotePath)
+		if err != nil {
+			return errno.ERR_UPLOAD_FILE_TO_REMOTE_BY_SSH_FAILED.E(err)
+		}
+	} else {
+		cmd := ctx.Module().Shell().Copy(s.LocalPath, remotePath)
+		_, err := cmd.Execute(module.ExecOptions{
+			ExecWithSudo:  false, // NOTE: file owner is me
+			ExecInLocal:   s.ExecInLocal,
+			ExecSudoAlias: s.ExecSudoAlias,
+		})
+		if err != nil {
+			return errno.ERR_COPY_FILES_AND_DIRECTORIES_FAILED.E(err)
+		}
+	}
+
+	cmd := ctx.Module().Shell().Rename(remotePath, s.HostDestPath)
+	if _, err := cmd.Execute(s.ExecOptions); err != nil {
+		return errno.ERR_RENAME_FILE_OR_DIRECTORY_FAILED.E(err)
+	}
+	if len(s.Mode) > 0 {
+		cmd = ctx.Module().Shell().Chmod(s.Mode, s.HostDestPath)
+		if _, err := cmd.Execute(s.ExecOptions); err != nil {
+			return errno.ERR_CHANGE_FILE_MODE_FAILED.E(err)
+		}
+	}
+	return nil
+}
+
 func (s *TrySyncFile) Execute(ctx *context.Context) error {
 	var input string
 	step := &ReadFile{
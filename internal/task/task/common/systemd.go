@@ -0,0 +1,417 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/cli/cli"
+	comm "github.com/dingodb/dingocli/internal/common"
+	compmgr "github.com/dingodb/dingocli/internal/component"
+	"github.com/dingodb/dingocli/internal/configure/topology"
+	"github.com/dingodb/dingocli/internal/errno"
+	"github.com/dingodb/dingocli/internal/task/context"
+	"github.com/dingodb/dingocli/internal/task/step"
+	"github.com/dingodb/dingocli/internal/task/task"
+)
+
+// services deployed by systemd live in <deploy_dir>/<role>-<service id> of the host:
+//
+//	bin/dingo-mds        binary copied from the local component repository
+//	conf/mds.conf        gflags rendered from the topology
+//	logs, data           unless log_dir and data_dir are set in the topology
+//
+// and are managed by the unit /etc/systemd/system/dingo-<role>-<service id>.service
+const (
+	SYSTEMD_UNIT_DIR         = "/etc/systemd/system"
+	SYSTEMD_UNIT_PREFIX      = "dingo"
+	SYSTEMD_BINARY_MODE      = "0755"
+	SYSTEMD_START_WAIT       = 3 // seconds
+	SYSTEMD_SERVICE_ACTIVE   = "active"
+	SYSTEMD_CONF_FILE_SUFFIX = ".conf"
+
+	SYSTEMD_HEALTH_CHECK_TIMES    = 20
+	SYSTEMD_HEALTH_CHECK_INTERVAL = 3 // seconds
+
+	MDS_STORAGE_ENGINE_TIKV = "tikv" // meta tables are created only in dingo-store
+
+	TEMPLATE_SYSTEMD_UNIT = `[Unit]
+Description=Dingo %s (cluster %s, service %s)
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+Type=simple
+ExecStart=%s
+Restart=on-failure
+RestartSec=5
+LimitNOFILE=1048576
+LimitCORE=infinity
+
+[Install]
+WantedBy=multi-user.target
+`
+)
+
+// component which serves the role, roles out of it can't be deployed by systemd
+var SYSTEMD_ROLE_COMPONENTS = map[string]string{
+	topology.ROLE_FS_MDS:     compmgr.DINGO_MDS,
+	topology.ROLE_FS_MDS_CLI: compmgr.DINGO_MDS_CLIENT,
+}
+
+// key of the local binary path in KEY_COMPONENT_BINARIES
+func ComponentBinaryKey(name, version string) string {
+	return fmt.Sprintf("%s:%s", name, version)
+}
+
+// version given by upgrade takes precedence over component_version of the topology
+func SystemdComponentVersion(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	if version, ok := dingocli.MemStorage().Get(comm.KEY_COMPONENT_VERSION).(string); ok && len(version) > 0 {
+		return version
+	}
+	return dc.GetComponentVersion()
+}
+
+func SystemdUnitName(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	return fmt.Sprintf("%s-%s-%s.service", SYSTEMD_UNIT_PREFIX, dc.GetRole(), serviceId)
+}
+
+func SystemdServiceDir(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	serviceId := dingocli.GetServiceId(dc.GetId())
+	return path.Join(dc.GetDeployDir(), fmt.Sprintf("%s-%s", dc.GetRole(), serviceId))
+}
+
+func systemdBinaryPath(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	return path.Join(SystemdServiceDir(dingocli, dc), "bin", SYSTEMD_ROLE_COMPONENTS[dc.GetRole()])
+}
+
+func systemdConfPath(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	return path.Join(SystemdServiceDir(dingocli, dc), "conf", dc.GetRole()+SYSTEMD_CONF_FILE_SUFFIX)
+}
+
+func systemdLogDir(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	if len(dc.GetLogDir()) > 0 {
+		return dc.GetLogDir()
+	}
+	return path.Join(SystemdServiceDir(dingocli, dc), "logs")
+}
+
+func systemdDataDir(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	if len(dc.GetDataDir()) > 0 {
+		return dc.GetDataDir()
+	}
+	return path.Join(SystemdServiceDir(dingocli, dc), "data")
+}
+
+func systemdCoordinatorAddr(dc *topology.DeployConfig) string {
+	addr := dc.GetDingoStoreCoordinatorAddr()
+	if len(addr) == 1 { // "-" if not set
+		addr, _ = dc.GetVariables().Get("coordinator_addr")
+	}
+	return addr
+}
+
+// gflags of mds, service configs without '.' are passed through, e.g. mds_log_level
+func newSystemdMdsConf(dingocli *cli.DingoCli, dc *topology.DeployConfig) (string, error) {
+	storageUrl := dc.GetMdsStorageUrl()
+	if len(storageUrl) == 0 && dc.GetMdsStorageEngine() != MDS_STORAGE_ENGINE_TIKV {
+		storageUrl = "list://" + systemdCoordinatorAddr(dc)
+	}
+	lines := []string{
+		fmt.Sprintf("--id=%d", dc.GetDingoInstanceId()),
+		fmt.Sprintf("--port=%d", dc.GetDingoServerPort()),
+		fmt.Sprintf("--log_dir=%s", systemdLogDir(dingocli, dc)),
+		fmt.Sprintf("--cluster_id=%d", dc.GetDingoClusterId()),
+	}
+	if len(dc.GetMdsStorageEngine()) > 0 {
+		lines = append(lines, fmt.Sprintf("--mds_storage_engine=%s", dc.GetMdsStorageEngine()))
+	}
+	lines = append(lines, fmt.Sprintf("--storage_url=%s", storageUrl))
+
+	rendered := map[string]bool{}
+	for _, line := range lines {
+		rendered[strings.SplitN(strings.TrimPrefix(line, comm.MDSV2_CONFIG_PREFIX), "=", 2)[0]] = true
+	}
+	serviceConfig := dc.GetServiceConfig()
+	keys := []string{}
+	for key := range serviceConfig {
+		if !strings.Contains(key, ".") && !rendered[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, err := dc.GetVariables().Rendering(serviceConfig[key])
+		if err != nil {
+			return "", errno.ERR_RENDERING_VARIABLE_FAILED.E(err)
+		}
+		lines = append(lines, fmt.Sprintf("%s%s=%s", comm.MDSV2_CONFIG_PREFIX, key, value))
+	}
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+func newSystemdUnit(dingocli *cli.DingoCli, dc *topology.DeployConfig) string {
+	execStart := fmt.Sprintf("%s --conf=%s", systemdBinaryPath(dingocli, dc), systemdConfPath(dingocli, dc))
+	return fmt.Sprintf(TEMPLATE_SYSTEMD_UNIT, dc.GetRole(), dingocli.ClusterName(),
+		dingocli.GetServiceId(dc.GetId()), execStart)
+}
+
+// NewInstallBinaryTask copy binary of the component from local component repository to the host
+func NewInstallBinaryTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	name, ok := SYSTEMD_ROLE_COMPONENTS[dc.GetRole()]
+	if !ok {
+		return nil, errno.ERR_ROLE_NOT_SUPPORTED_BY_SYSTEMD.F("role: %s", dc.GetRole())
+	}
+	version := SystemdComponentVersion(dingocli, dc)
+	binaries, _ := dingocli.MemStorage().Get(comm.KEY_COMPONENT_BINARIES).(map[string]string)
+	localPath, ok := binaries[ComponentBinaryKey(name, version)]
+	if !ok {
+		return nil, errno.ERR_INSTALL_COMPONENT_BINARY_FAILED.
+			F("%s:%s is not installed", name, version)
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s version=%s", dc.GetHost(), dc.GetRole(), version)
+	t := task.NewTask("Install Binary", subname, hc.GetSSHConfig())
+
+	// add step to task
+	serviceDir := SystemdServiceDir(dingocli, dc)
+	t.AddStep(&step.CreateDirectory{
+		Paths: []string{
+			path.Join(serviceDir, "bin"),
+			path.Join(serviceDir, "conf"),
+			systemdLogDir(dingocli, dc),
+			systemdDataDir(dingocli, dc),
+		},
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.UploadFile{
+		LocalPath:    localPath,
+		HostDestPath: systemdBinaryPath(dingocli, dc),
+		Mode:         SYSTEMD_BINARY_MODE,
+		ExecOptions:  dingocli.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+// NewSyncSystemdConfigTask render config and unit of the service on the host
+func NewSyncSystemdConfigTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	conf, err := newSystemdMdsConf(dingocli, dc)
+	if err != nil {
+		return nil, err
+	}
+	unit := newSystemdUnit(dingocli, dc)
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s unit=%s", dc.GetHost(), dc.GetRole(), SystemdUnitName(dingocli, dc))
+	t := task.NewTask("Sync Config", subname, hc.GetSSHConfig())
+
+	// add step to task
+	t.AddStep(&step.InstallFile{
+		Content:      &conf,
+		HostDestPath: systemdConfPath(dingocli, dc),
+		ExecOptions:  dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.InstallFile{
+		Content:      &unit,
+		HostDestPath: path.Join(SYSTEMD_UNIT_DIR, SystemdUnitName(dingocli, dc)),
+		ExecOptions:  dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Command{
+		Command:     "systemctl daemon-reload",
+		ExecOptions: dingocli.ExecOptions(),
+	})
+
+	return t, nil
+}
+
+func checkSystemdServiceActive(unit string, out *string) step.LambdaType {
+	return func(ctx *context.Context) error {
+		if strings.TrimSpace(*out) != SYSTEMD_SERVICE_ACTIVE {
+			return errno.ERR_START_SYSTEMD_SERVICE_FAILED.
+				F("unit %s is %s, see journalctl -u %s", unit, strings.TrimSpace(*out), unit)
+		}
+		return nil
+	}
+}
+
+// NewStartSystemdServiceTask enable and (re)start the unit, the service is restarted if it is running
+func NewStartSystemdServiceTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	unit := SystemdUnitName(dingocli, dc)
+	subname := fmt.Sprintf("host=%s role=%s unit=%s", dc.GetHost(), dc.GetRole(), unit)
+	t := task.NewTask("Start Service", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var active bool
+	var out string
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("systemctl enable %s", unit),
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("systemctl restart %s", unit),
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: func(ctx *context.Context) error {
+			time.Sleep(SYSTEMD_START_WAIT * time.Second)
+			return nil
+		},
+	})
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf("systemctl is-active %s", unit),
+		Success:     &active, // inactive or failed exits non-zero, checked by out
+		Out:         &out,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkSystemdServiceActive(unit, &out),
+	})
+
+	return t, nil
+}
+
+// NewCreateSystemdMetaTablesTask create meta tables in dingo-store by dingo-mds-client of the host
+func NewCreateSystemdMetaTablesTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	if dc.GetMdsStorageEngine() == MDS_STORAGE_ENGINE_TIKV {
+		return nil, nil
+	}
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	t := task.NewTask("Create Meta Tables", "Create Meta Tables", hc.GetSSHConfig())
+
+	// add step to task
+	var success bool
+	var out string
+	t.AddStep(&step.Command{
+		Command: fmt.Sprintf("%s --cmd=CreateAllTable --coor_addr=list://%s --cluster_id=%d",
+			systemdBinaryPath(dingocli, dc), systemdCoordinatorAddr(dc), dc.GetDingoClusterId()),
+		Success:     &success,
+		Out:         &out,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: checkCreateTableSuccess(&success, &out),
+	})
+
+	return t, nil
+}
+
+func systemdMetricUrl(dc *topology.DeployConfig) string {
+	return fmt.Sprintf(URL_DINGOFS_METRIC_LEADER, dc.GetListenIp(), dc.GetDingoServerPort())
+}
+
+// NewGetSystemdLeaderTask save id of the service into KEY_MDS_LEADER if it is the leader of mds,
+// service which is down is not treated as error
+func NewGetSystemdLeaderTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s unit=%s", dc.GetHost(), dc.GetRole(), SystemdUnitName(dingocli, dc))
+	t := task.NewTask("Get Leader", subname, hc.GetSSHConfig())
+
+	// add step to task
+	var success bool
+	var out string
+	t.AddStep(&step.Command{
+		Command:     fmt.Sprintf(COMMAND_CURL_MDS, systemdMetricUrl(dc)),
+		Success:     &success,
+		Out:         &out,
+		ExecOptions: dingocli.ExecOptions(),
+	})
+	t.AddStep(&step.Lambda{
+		Lambda: func(ctx *context.Context) error {
+			if success && strings.Contains(out, SIGNATURE_LEADER) {
+				dingocli.MemStorage().Set(comm.KEY_MDS_LEADER, dc.GetId())
+			}
+			return nil
+		},
+	})
+
+	return t, nil
+}
+
+func waitSystemdServiceHealthy(dingocli *cli.DingoCli, dc *topology.DeployConfig) step.LambdaType {
+	unit := SystemdUnitName(dingocli, dc)
+	return func(ctx *context.Context) error {
+		var status string
+		for i := 0; i < SYSTEMD_HEALTH_CHECK_TIMES; i++ {
+			if i > 0 {
+				time.Sleep(SYSTEMD_HEALTH_CHECK_INTERVAL * time.Second)
+			}
+			out, _ := ctx.Module().Shell().Command(fmt.Sprintf("systemctl is-active %s", unit)).
+				Execute(dingocli.ExecOptions())
+			if status = strings.TrimSpace(out); status != SYSTEMD_SERVICE_ACTIVE {
+				continue
+			}
+			_, err := ctx.Module().Shell().Command(fmt.Sprintf(COMMAND_CURL_MDS, systemdMetricUrl(dc))).
+				Execute(dingocli.ExecOptions())
+			if err == nil {
+				return nil
+			}
+			status = "not serving"
+		}
+		return errno.ERR_SYSTEMD_SERVICE_UNHEALTHY.
+			F("unit %s is %s after %d checks, see journalctl -u %s", unit, status, SYSTEMD_HEALTH_CHECK_TIMES, unit)
+	}
+}
+
+// NewCheckSystemdServiceHealthTask wait until the unit is active and mds serves its metrics
+func NewCheckSystemdServiceHealthTask(dingocli *cli.DingoCli, dc *topology.DeployConfig) (*task.Task, error) {
+	hc, err := dingocli.GetHost(dc.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	// new task
+	subname := fmt.Sprintf("host=%s role=%s unit=%s", dc.GetHost(), dc.GetRole(), SystemdUnitName(dingocli, dc))
+	t := task.NewTask("Check Service Health", subname, hc.GetSSHConfig())
+
+	// add step to task
+	t.AddStep(&step.Lambda{
+		Lambda: waitSystemdServiceHealthy(dingocli, dc),
+	})
+
+	return t, nil
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/pkg/logger"
+)
+
+// OTLP/HTTP with json encoding, so no otel sdk is needed
+const (
+	OTLP_TRACES_PATH    = "/v1/traces"
+	OTLP_EXPORT_TIMEOUT = 3 * time.Second
+)
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceId           string          `json:"traceId"`
+	SpanId            string          `json:"spanId"`
+	ParentSpanId      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+func attribute(key string, value interface{}) otlpAttribute {
+	attr := otlpAttribute{Key: key}
+	switch v := value.(type) {
+	case string:
+		attr.Value.StringValue = &v
+	case int, int32, int64, uint, uint32, uint64:
+		s := fmt.Sprintf("%d", v)
+		attr.Value.IntValue = &s
+	case float64:
+		attr.Value.DoubleValue = &v
+	case bool:
+		attr.Value.BoolValue = &v
+	default:
+		s := fmt.Sprintf("%v", v)
+		attr.Value.StringValue = &s
+	}
+	return attr
+}
+
+func (span *Span) otlp() otlpSpan {
+	span.mux.Lock()
+	defer span.mux.Unlock()
+
+	s := otlpSpan{
+		TraceId:           hex.EncodeToString(span.traceId[:]),
+		SpanId:            hex.EncodeToString(span.spanId[:]),
+		Name:              span.name,
+		Kind:              span.kind,
+		StartTimeUnixNano: fmt.Sprintf("%d", span.start.UnixNano()),
+		EndTimeUnixNano:   fmt.Sprintf("%d", span.end.UnixNano()),
+		Status:            otlpStatus{Code: STATUS_CODE_OK},
+	}
+	if span.parentId != [8]byte{} {
+		s.ParentSpanId = hex.EncodeToString(span.parentId[:])
+	}
+	for key, value := range span.attrs {
+		s.Attributes = append(s.Attributes, attribute(key, value))
+	}
+	if span.err != nil {
+		s.Status = otlpStatus{Code: STATUS_CODE_ERROR, Message: logger.Redact(span.err.Error())}
+	}
+	return s
+}
+
+// endpoint may be host:port, or url with or without the traces path
+func tracesUrl(endpoint string) string {
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "http://" + endpoint
+	}
+	if !strings.HasSuffix(endpoint, OTLP_TRACES_PATH) {
+		endpoint = strings.TrimSuffix(endpoint, "/") + OTLP_TRACES_PATH
+	}
+	return endpoint
+}
+
+// failures are only logged, tracing never fails a command
+func export(endpoint string, spans []*Span) {
+	if len(spans) == 0 {
+		return
+	}
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, span := range spans {
+		otlpSpans = append(otlpSpans, span.otlp())
+	}
+	request := map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": []otlpAttribute{
+						attribute("service.name", SERVICE_NAME),
+						attribute("service.version", global.version),
+					},
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": SCOPE_NAME},
+						"spans": otlpSpans,
+					},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(request)
+	if err != nil {
+		logger.Warnf("marshal spans failed: %v", err)
+		return
+	}
+
+	client := &http.Client{Timeout: OTLP_EXPORT_TIMEOUT}
+	response, err := client.Post(tracesUrl(endpoint), "application/json", bytes.NewReader(data))
+	if err != nil {
+		logger.Warnf("export %d spans to %s failed: %v", len(spans), endpoint, err)
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		logger.Warnf("export %d spans to %s failed: %s", len(spans), endpoint, response.Status)
+	}
+}
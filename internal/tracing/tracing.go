@@ -0,0 +1,216 @@
+/*
+ * Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/utils"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/metadata"
+)
+
+// spans of a command and its rpc requests are exported by OTLP/HTTP when
+// global.otel.endpoint is set, e.g. http://otel-collector:4318,
+// trace context is sent to mds by the w3c traceparent header
+const (
+	SERVICE_NAME = "dingo"
+	SCOPE_NAME   = "github.com/dingodb/dingocli"
+
+	TRACEPARENT_KEY = "traceparent"
+
+	SPAN_KIND_INTERNAL = 1
+	SPAN_KIND_CLIENT   = 3
+
+	STATUS_CODE_OK    = 1
+	STATUS_CODE_ERROR = 2
+
+	MAX_SPANS   = 10000 // spans of a long command are dropped above it
+	BATCH_SPANS = 512   // spans are exported in background every batch
+)
+
+type Span struct {
+	traceId  [16]byte
+	spanId   [8]byte
+	parentId [8]byte
+	name     string
+	kind     int
+	start    time.Time
+	end      time.Time
+	attrs    map[string]interface{}
+	err      error
+	mux      sync.Mutex
+}
+
+type tracer struct {
+	mux      sync.Mutex
+	once     sync.Once
+	endpoint string
+	version  string
+	root     *Span
+	spans    []*Span
+	dropped  int
+	pending  sync.WaitGroup
+}
+
+var global = &tracer{}
+
+func randomBytes(b []byte) {
+	if _, err := rand.Read(b); err != nil {
+		// ids must not be all zero
+		now := time.Now().UnixNano()
+		for i := range b {
+			b[i] = byte(now >> (8 * (i % 8)))
+		}
+	}
+}
+
+// configuration is read by commands, so the endpoint is loaded on first use after that
+func (t *tracer) enabled() bool {
+	t.once.Do(func() {
+		t.endpoint = viper.GetString(utils.VIPER_GLOBALE_OTEL_ENDPOINT)
+	})
+	return t.endpoint != ""
+}
+
+// StartCommand starts the root span of the invocation, it is exported by Finish
+func StartCommand(name, version string) *Span {
+	span := &Span{name: name, kind: SPAN_KIND_INTERNAL, start: time.Now(), attrs: map[string]interface{}{}}
+	randomBytes(span.traceId[:])
+	randomBytes(span.spanId[:])
+	global.mux.Lock()
+	global.root, global.version = span, version
+	global.mux.Unlock()
+	return span
+}
+
+// Command is the root span, nil if no command is started
+func Command() *Span {
+	global.mux.Lock()
+	defer global.mux.Unlock()
+	return global.root
+}
+
+// StartRpc starts a client span as a child of the command, nil if tracing is disabled
+func StartRpc(name string) *Span {
+	global.mux.Lock()
+	root := global.root
+	global.mux.Unlock()
+	if root == nil || !global.enabled() {
+		return nil
+	}
+	span := &Span{name: name, kind: SPAN_KIND_CLIENT, start: time.Now(), attrs: map[string]interface{}{}}
+	span.traceId, span.parentId = root.traceId, root.spanId
+	randomBytes(span.spanId[:])
+	return span
+}
+
+// methods are no-op on nil span, so callers do not check whether tracing is enabled
+func (span *Span) SetAttribute(key string, value interface{}) {
+	if span == nil {
+		return
+	}
+	span.mux.Lock()
+	defer span.mux.Unlock()
+	span.attrs[key] = value
+}
+
+// bytes of a command, e.g. data read by warmup, are added up
+func (span *Span) AddBytes(key string, n int64) {
+	if span == nil {
+		return
+	}
+	span.mux.Lock()
+	defer span.mux.Unlock()
+	value, _ := span.attrs[key].(int64)
+	span.attrs[key] = value + n
+}
+
+func (span *Span) SetError(err error) {
+	if span == nil {
+		return
+	}
+	span.mux.Lock()
+	defer span.mux.Unlock()
+	span.err = err
+}
+
+// Inject adds traceparent of the span to outgoing grpc metadata
+func (span *Span) Inject(ctx context.Context) context.Context {
+	if span == nil {
+		return ctx
+	}
+	traceparent := fmt.Sprintf("00-%s-%s-01", hex.EncodeToString(span.traceId[:]), hex.EncodeToString(span.spanId[:]))
+	return metadata.AppendToOutgoingContext(ctx, TRACEPARENT_KEY, traceparent)
+}
+
+func (span *Span) End() {
+	if span == nil {
+		return
+	}
+	span.mux.Lock()
+	span.end = time.Now()
+	span.mux.Unlock()
+	global.record(span)
+}
+
+func (t *tracer) record(span *Span) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	if len(t.spans) >= MAX_SPANS {
+		t.dropped++
+		return
+	}
+	t.spans = append(t.spans, span)
+	if len(t.spans) >= BATCH_SPANS && span != t.root {
+		batch := t.spans
+		t.spans = nil
+		t.pending.Add(1)
+		go func() {
+			defer t.pending.Done()
+			export(t.endpoint, batch)
+		}()
+	}
+}
+
+// Finish ends the root span and exports spans not exported yet
+func Finish(err error) {
+	global.mux.Lock()
+	root := global.root
+	global.mux.Unlock()
+	if root == nil || !global.enabled() {
+		return
+	}
+
+	root.SetError(err)
+	root.End()
+	global.pending.Wait()
+
+	global.mux.Lock()
+	spans, dropped := global.spans, global.dropped
+	global.spans = nil
+	global.mux.Unlock()
+	if dropped > 0 {
+		root.SetAttribute("dingo.dropped_spans", int64(dropped))
+	}
+	export(global.endpoint, spans)
+}
@@ -150,6 +150,10 @@ func prompt(prompt string) string {
 }
 
 func ConfirmYes(format string, a ...interface{}) bool {
+	if utils.AssumeYes() {
+		return true
+	}
+
 	ans := prompt(fmt.Sprintf(format, a...) + " [yes/no]: (default=no)")
 	switch strings.TrimSpace(ans) {
 	case "yes":
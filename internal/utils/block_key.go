@@ -15,12 +15,24 @@
  */
 package utils
 
-import "fmt"
+import (
+	"fmt"
+	"path/filepath"
+)
 
 // kBlockStoreDir is the top-level directory under which file blocks are stored.
 // Mirrors BlockKey::kStoreDir in the C++ code (src/common/block/block_key.h).
 const kBlockStoreDir = "blocks"
 
+// state of a block in local cache dirs of client, a staged block is written by
+// client but not uploaded yet. Blocks are kept under the cache and stage dirs of
+// a cache disk, which may be nested in a sub dir named by the disk id.
+const (
+	BLOCK_CACHE_STATE_CACHED = "cached"
+	BLOCK_CACHE_STATE_STAGED = "staged"
+	BLOCK_CACHE_STATE_NONE   = "-"
+)
+
 // BlockObject is a single object (block) in the backend object store, as
 // computed client-side from a slice. The mds only stores slice ids; object
 // keys are derived on the client.
@@ -63,3 +75,20 @@ func EnumerateBlockKeys(sliceId uint64, slicePos uint32, sliceSize uint32, chunk
 
 	return objects
 }
+
+// BlockCacheState looks for the block of store key in local cache dirs.
+func BlockCacheState(cacheDirs []string, key string) string {
+	for _, state := range []struct{ dir, name string }{{"stage", BLOCK_CACHE_STATE_STAGED}, {"cache", BLOCK_CACHE_STATE_CACHED}} {
+		for _, cacheDir := range cacheDirs {
+			for _, pattern := range []string{
+				filepath.Join(cacheDir, state.dir, key),
+				filepath.Join(cacheDir, "*", state.dir, key),
+			} {
+				if matches, _ := filepath.Glob(pattern); len(matches) > 0 {
+					return state.name
+				}
+			}
+		}
+	}
+	return BLOCK_CACHE_STATE_NONE
+}
@@ -0,0 +1,104 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/profile"
+)
+
+// gflags of brpc servers of client and cache member, served by /flags in text as
+// "name | value | description | defined in", value of a modified flag is "value (default:old)"
+const (
+	FLAGS_PATH = "/flags"
+
+	flagDefaultPrefix = "(default:"
+)
+
+type BrpcFlag struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Default string `json:"default,omitempty"`
+}
+
+func ScrapeFlags(addr string, timeout time.Duration) ([]*BrpcFlag, error) {
+	defer profile.Start(profile.PHASE_HTTP, addr+FLAGS_PATH)()
+	client := &http.Client{Timeout: timeout}
+	response, err := client.Get(fmt.Sprintf("http://%s%s", addr, FLAGS_PATH))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get flags of %s: %s", addr, response.Status)
+	}
+	return ParseFlags(response.Body)
+}
+
+func ParseFlags(reader io.Reader) ([]*BrpcFlag, error) {
+	flags := []*BrpcFlag{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "|")
+		if len(fields) < 2 {
+			continue
+		}
+		name, value := strings.TrimSpace(fields[0]), strings.TrimSpace(fields[1])
+		if name == "" || name == "Name" {
+			continue
+		}
+		flag := &BrpcFlag{Name: name, Value: value}
+		if i := strings.LastIndex(value, flagDefaultPrefix); i >= 0 && strings.HasSuffix(value, ")") {
+			flag.Value = strings.TrimSpace(value[:i])
+			flag.Default = value[i+len(flagDefaultPrefix) : len(value)-1]
+		}
+		flags = append(flags, flag)
+	}
+	return flags, scanner.Err()
+}
+
+// flagfile of gflags, "--name=value" per line, "name=value" is accepted too
+func ReadFlagFile(filename string) (map[string]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	flags := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(strings.TrimLeft(line, "-"), "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: invalid flag '%s', should be --name=value", filename, lineno, line)
+		}
+		flags[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return flags, scanner.Err()
+}
@@ -20,7 +20,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sync"
 
+	"github.com/dingodb/dingocli/pkg/logger"
 	"github.com/docker/cli/cli"
 	"github.com/docker/cli/cli/command"
 	"github.com/moby/term"
@@ -32,15 +34,69 @@ const (
 )
 
 var (
-	NoArgs            = cli.NoArgs
-	RequiresMinArgs   = cli.RequiresMinArgs
-	RequiresMaxArgs   = cli.RequiresMaxArgs
-	RequiresRangeArgs = cli.RequiresRangeArgs
-	ExactArgs         = cli.ExactArgs
+	NoArgs          = usageArgs(cli.NoArgs)
+	RequiresMinArgs = func(min int) cobra.PositionalArgs {
+		return usageArgs(cli.RequiresMinArgs(min))
+	}
+	RequiresMaxArgs = func(max int) cobra.PositionalArgs {
+		return usageArgs(cli.RequiresMaxArgs(max))
+	}
+	RequiresRangeArgs = func(min int, max int) cobra.PositionalArgs {
+		return usageArgs(cli.RequiresRangeArgs(min, max))
+	}
+	ExactArgs = func(number int) cobra.PositionalArgs {
+		return usageArgs(cli.ExactArgs(number))
+	}
 
 	ShowHelp = command.ShowHelp
 )
 
+var (
+	exitHookMtx sync.Mutex
+	exitHooks   []func()
+)
+
+// hooks are run by CheckErr before exiting, e.g. to give the terminal back from pager
+func AddExitHook(hook func()) {
+	exitHookMtx.Lock()
+	defer exitHookMtx.Unlock()
+	exitHooks = append(exitHooks, hook)
+}
+
+// same as cobra.CheckErr, which exits without returning to Execute, but runs exit hooks first
+func CheckErr(err error) {
+	if err == nil {
+		return
+	}
+
+	exitHookMtx.Lock()
+	hooks := exitHooks
+	exitHooks = nil
+	exitHookMtx.Unlock()
+	for _, hook := range hooks {
+		hook()
+	}
+	cobra.CheckErr(err)
+}
+
+// error of invalid command line, e.g. unknown flag or wrong number of arguments
+type UsageError struct {
+	error
+}
+
+func (e *UsageError) Unwrap() error {
+	return e.error
+}
+
+func usageArgs(args cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, a []string) error {
+		if err := args(cmd, a); err != nil {
+			return &UsageError{err}
+		}
+		return nil
+	}
+}
+
 const (
 	usageTemplate = `Usage:
 {{- if not .HasSubCommands}}  {{.UseLine}}{{end}}
@@ -148,7 +204,7 @@ func SetFlagErrorFunc(cmd *cobra.Command) {
 			return nil
 		}
 
-		return errors.New(fmt.Sprintf("%s\nSee '%s --help'.", err, cmd.CommandPath()))
+		return &UsageError{errors.New(fmt.Sprintf("%s\nSee '%s --help'.", err, cmd.CommandPath()))}
 	})
 }
 
@@ -168,5 +224,5 @@ func SetUsageTemplate(cmd *cobra.Command) {
 }
 
 func SetErr(cmd *cobra.Command, writer io.Writer) {
-	cmd.SetErr(writer)
+	cmd.SetErr(logger.NewRedactWriter(writer))
 }
@@ -0,0 +1,54 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// complete local dingofs mountpoints, files are completed once the path is inside a mountpoint
+func CompleteMountPoints(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	mountpoints, err := GetDingoFSMountPoints()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+
+	completions := []string{}
+	for _, mountpoint := range mountpoints {
+		if strings.HasPrefix(toComplete, mountpoint.MountPoint+"/") {
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+		if strings.HasPrefix(mountpoint.MountPoint, toComplete) {
+			completions = append(completions, mountpoint.MountPoint)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+type CompletionFunc func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// only complete the first positional argument
+func CompleteFirstArg(complete CompletionFunc) CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(cmd, args, toComplete)
+	}
+}
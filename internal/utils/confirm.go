@@ -0,0 +1,65 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// global --yes, all confirmation prompts are answered with yes
+const (
+	ASSUME_YES = "yes"
+)
+
+var (
+	assumeYes = false
+)
+
+func SetAssumeYes(yes bool) { assumeYes = yes }
+func AssumeYes() bool       { return assumeYes }
+
+// interactive y/N prompt, default is no
+func Confirm(format string, a ...interface{}) bool {
+	if assumeYes {
+		return true
+	}
+
+	ans := prompt(fmt.Sprintf(format, a...) + " [y/N]:")
+	switch strings.ToLower(strings.TrimSpace(ans)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// typed-name confirmation for very destructive operation, e.g. deleting filesystem
+func AskConfirmation(promptStr string, confirm string) bool {
+	if assumeYes {
+		return true
+	}
+
+	promptStr = promptStr + fmt.Sprintf("\nplease input [%s] to confirm:", confirm)
+	ans := prompt(promptStr)
+	switch strings.TrimSpace(ans) {
+	case confirm:
+		return true
+	default:
+		return false
+	}
+}
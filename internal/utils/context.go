@@ -0,0 +1,155 @@
+/*
+ * 	Copyright (c) 2025 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package utils
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// context (named profile) in configuration file:
+//
+//	current-context: prod
+//	contexts:
+//	  prod:
+//	    dingofs:
+//	      mdsaddr: 10.0.0.1:7400
+//	  staging:
+//	    dingofs:
+//	      mdsaddr: 10.0.1.1:7400
+//
+// the selected context section is merged over the top-level settings.
+const (
+	CONTEXT               = "context"
+	ENV_DINGO_CONTEXT     = "DINGO_CONTEXT"
+	VIPER_CURRENT_CONTEXT = "current-context"
+	VIPER_CONTEXTS        = "contexts"
+)
+
+// context priority:
+// command line (--context prod) > environment variables(DINGO_CONTEXT=prod) > current-context in configuration file
+func GetContextName(cmd *cobra.Command) string {
+	if flag := cmd.Flag(CONTEXT); flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	if value := os.Getenv(ENV_DINGO_CONTEXT); value != "" {
+		return value
+	}
+	return viper.GetString(VIPER_CURRENT_CONTEXT)
+}
+
+func ListContexts() []string {
+	contexts := []string{}
+	for name := range viper.GetStringMap(VIPER_CONTEXTS) {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+	return contexts
+}
+
+func ApplyContext(name string) error {
+	return applyContext(viper.GetViper(), name)
+}
+
+func applyContext(v *viper.Viper, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s.%s", VIPER_CONTEXTS, name)
+	if !v.IsSet(key) {
+		return fmt.Errorf("context '%s' not found in %s", name, v.ConfigFileUsed())
+	}
+
+	return v.MergeConfigMap(v.GetStringMap(key))
+}
+
+// persist current-context into configuration file
+func SetCurrentContext(confFile, name string) error {
+	parser := viper.New()
+	parser.SetConfigFile(confFile)
+	parser.SetConfigType(GetConfigType(confFile))
+	if err := parser.ReadInConfig(); err != nil {
+		return err
+	}
+	if !parser.IsSet(fmt.Sprintf("%s.%s", VIPER_CONTEXTS, name)) {
+		return fmt.Errorf("context '%s' not found in %s", name, confFile)
+	}
+
+	if parser.GetString(VIPER_CURRENT_CONTEXT) == name {
+		return nil
+	}
+	if GetConfigType(confFile) == CONFIG_TYPE_YAML {
+		return setYamlCurrentContext(confFile, name)
+	}
+	parser.Set(VIPER_CURRENT_CONTEXT, name)
+	return parser.WriteConfig()
+}
+
+// only the line of current-context is changed, or appended if it is not set,
+// so comments and layout of the file are kept
+func setYamlCurrentContext(confFile, name string) error {
+	data, err := os.ReadFile(confFile)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode || doc.Content[0].Style&yaml.FlowStyle != 0 {
+		return fmt.Errorf("%s: top level is not a block mapping", confFile)
+	}
+	value, err := yaml.Marshal(name)
+	if err != nil {
+		return err
+	}
+
+	content := string(data)
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, node := root.Content[i], root.Content[i+1]
+		if key.Value != VIPER_CURRENT_CONTEXT {
+			continue
+		}
+		if node.Kind != yaml.ScalarNode || node.Line != key.Line {
+			return fmt.Errorf("%s:%d: %s is not a plain value", confFile, key.Line, VIPER_CURRENT_CONTEXT)
+		}
+		lines := strings.SplitAfter(content, "\n")
+		line := lines[node.Line-1][:node.Column-1] + strings.TrimSpace(string(value))
+		if node.LineComment != "" {
+			line += " " + node.LineComment
+		}
+		if strings.HasSuffix(lines[node.Line-1], "\n") {
+			line += "\n"
+		}
+		lines[node.Line-1] = line
+		content = strings.Join(lines, "")
+		return WriteFile(confFile, content, GetFilePermissions(confFile))
+	}
+
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	content += fmt.Sprintf("%s: %s", VIPER_CURRENT_CONTEXT, value)
+	return WriteFile(confFile, content, GetFilePermissions(confFile))
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCurrentContext(t *testing.T) {
+	assert := assert.New(t)
+	confFile := filepath.Join(t.TempDir(), "dingo.yaml")
+
+	// comments and layout are kept
+	assert.NoError(os.WriteFile(confFile, []byte("# clusters\ncurrent-context: prod # selected\ncontexts:\n  prod: {}\n  staging: {}\n"), 0600))
+	assert.NoError(SetCurrentContext(confFile, "staging"))
+	data, err := os.ReadFile(confFile)
+	assert.NoError(err)
+	assert.Equal("# clusters\ncurrent-context: staging # selected\ncontexts:\n  prod: {}\n  staging: {}\n", string(data))
+
+	// appended if not set
+	assert.NoError(os.WriteFile(confFile, []byte("contexts:\n  prod: {}"), 0600))
+	assert.NoError(SetCurrentContext(confFile, "prod"))
+	data, err = os.ReadFile(confFile)
+	assert.NoError(err)
+	assert.Equal("contexts:\n  prod: {}\ncurrent-context: prod\n", string(data))
+
+	assert.Error(SetCurrentContext(confFile, "dev"))
+}
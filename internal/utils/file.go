@@ -25,8 +25,6 @@ import (
 	"os"
 	"path/filepath"
 	"time"
-
-	"github.com/schollz/progressbar/v3"
 )
 
 type VariantName struct {
@@ -140,7 +138,13 @@ func AddExecutePermission(filepath string) error {
 	return os.Chmod(filepath, newMode)
 }
 
-func DownloadFileWithProgress(url, destination, filename string) error {
+// progress of downloading, total is -1 if content length is unknown
+type ProgressWriter interface {
+	io.Writer
+	Finish()
+}
+
+func DownloadFileWithProgress(url, destination, filename string, newProgress func(total int64) ProgressWriter) error {
 	// resp, err := http.Get(url)
 	// if err != nil {
 	// 	return "", err
@@ -191,27 +195,10 @@ func DownloadFileWithProgress(url, destination, filename string) error {
 	}
 	defer out.Close()
 
-	bar := progressbar.NewOptions64(
-		resp.ContentLength,
-		progressbar.OptionSetDescription(fmt.Sprintf("[cyan]Downloading[reset] %s...", filename)),
-		progressbar.OptionSetWriter(os.Stderr),
-		progressbar.OptionShowBytes(true),
-		progressbar.OptionSetWidth(30),
-		progressbar.OptionShowCount(),
-		progressbar.OptionOnCompletion(func() {
-			fmt.Fprint(os.Stderr, "\n")
-		}),
-		progressbar.OptionEnableColorCodes(true),
-		progressbar.OptionSetTheme(progressbar.Theme{
-			Saucer:        "[green]=[reset]",
-			SaucerHead:    "[green]>[reset]",
-			SaucerPadding: " ",
-			BarStart:      "[",
-			BarEnd:        "]",
-		}),
-	)
-
-	_, err = io.Copy(io.MultiWriter(out, bar), resp.Body)
+	progress := newProgress(resp.ContentLength)
+	defer progress.Finish()
+
+	_, err = io.Copy(io.MultiWriter(out, progress), resp.Body)
 	if err != nil {
 		os.Remove(filePath)
 		return err
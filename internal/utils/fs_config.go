@@ -18,49 +18,111 @@ package utils
 import (
 	"fmt"
 	"log"
+	"net"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/fatih/color"
+	"github.com/dingodb/dingocli/internal/profile"
+	"github.com/dingodb/dingocli/pkg/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 const (
-	IP_PORT_REGEX = "((\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5])\\.(\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5])\\.(\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5])\\.(\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5]):([0-9]|[1-9]\\d{1,3}|[1-5]\\d{4}|6[0-4]\\d{4}|65[0-4]\\d{2}|655[0-2]\\d|6553[0-5]))|(\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5])\\.(\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5])\\.(\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5])\\.(\\d|[1-9]\\d|1\\d{2}|2[0-4]\\d|25[0-5])"
+	// RFC 1123 hostname, e.g. mds1.internal
+	HOSTNAME_REGEX = `^([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])(\.([a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\-]{0,61}[a-zA-Z0-9]))*$`
+)
+
+// environment variables
+// every key in FLAG2VIPER can be set by DINGO_<KEY>, e.g.
+// dingofs.mdsaddr -> DINGO_DINGOFS_MDSADDR, global.rpctimeout -> DINGO_GLOBAL_RPCTIMEOUT
+const (
+	ENV_PREFIX = "DINGO"
+)
+
+var (
+	envKeyReplacer = strings.NewReplacer(".", "_", "-", "_")
+)
+
+// configure file type, detected from file extension
+const (
+	CONFIG_TYPE_YAML = "yaml"
+	CONFIG_TYPE_JSON = "json"
+	CONFIG_TYPE_TOML = "toml"
+)
+
+var (
+	CONFIG_EXTENSIONS = []string{"yaml", "yml", CONFIG_TYPE_JSON, CONFIG_TYPE_TOML}
 )
 
 // format
 const (
 	FORMAT_JSON  = "json"
 	FORMAT_PLAIN = "plain"
+	FORMAT_TABLE = "table"
+	FORMAT_CSV   = "csv"
+	FORMAT_TSV   = "tsv"
 	FORMAT_NOOUT = "noout"
 )
 
 const (
-	RPCTIMEOUT                  = "rpctimeout"
-	VIPER_GLOBALE_RPCTIMEOUT    = "global.rpctimeout"
-	DEFAULT_RPCTIMEOUT          = 30000 * time.Millisecond
-	RPCRETRYTIMES               = "rpcretrytimes"
-	VIPER_GLOBALE_RPCRETRYTIMES = "global.rpcretrytimes"
-	DEFAULT_RPCRETRYTIMES       = uint32(5)
-	RPCRETRYDElAY               = "rpcretrydelay"
-	VIPER_GLOBALE_RPCRETRYDELAY = "global.rpcretrydelay"
-	DEFAULT_RPCRETRYDELAY       = 200 * time.Millisecond
-	VERBOSE                     = "verbose"
-	VIPER_GLOBALE_VERBOSE       = "global.verbose"
-	DEFAULT_VERBOSE             = false
-	FORMAT                      = "format"
+	RPCTIMEOUT                   = "rpctimeout"
+	VIPER_GLOBALE_RPCTIMEOUT     = "global.rpctimeout"
+	DEFAULT_RPCTIMEOUT           = 30000 * time.Millisecond
+	RPCRETRYTIMES                = "rpcretrytimes"
+	VIPER_GLOBALE_RPCRETRYTIMES  = "global.rpcretrytimes"
+	DEFAULT_RPCRETRYTIMES        = uint32(5)
+	RPCRETRYDElAY                = "rpcretrydelay"
+	VIPER_GLOBALE_RPCRETRYDELAY  = "global.rpcretrydelay"
+	DEFAULT_RPCRETRYDELAY        = 200 * time.Millisecond
+	RPCCONCURRENCY               = "rpcconcurrency"
+	VIPER_GLOBALE_RPCCONCURRENCY = "global.rpcconcurrency"
+	DEFAULT_RPCCONCURRENCY       = uint32(16)
+	VERBOSE                      = "verbose"
+	VIPER_GLOBALE_VERBOSE        = "global.verbose"
+	DEFAULT_VERBOSE              = false
+	LOGLEVEL                     = "loglevel"
+	VIPER_GLOBALE_LOGLEVEL       = "global.loglevel"
+	DEFAULT_LOGLEVEL             = "info"
+	LOGFORMAT                    = "logformat"
+	VIPER_GLOBALE_LOGFORMAT      = "global.logformat"
+	DEFAULT_LOGFORMAT            = "text"
+	PAGER                        = "pager"
+	VIPER_GLOBALE_PAGER          = "global.pager"
+	DEFAULT_PAGER                = ""
+	OTEL_ENDPOINT                = "otel.endpoint"
+	VIPER_GLOBALE_OTEL_ENDPOINT  = "global.otel.endpoint"
+	DEFAULT_OTEL_ENDPOINT        = ""
+	FORMAT                       = "format"
+	COLUMNS                      = "columns"
+	NO_HEADERS                   = "no-headers"
+	FILTER                       = "filter"
+	SORT_BY                      = "sort-by"
+	LIMIT                        = "limit"
+	ANNOTATION_LIST_LIMIT        = "list-limit"
 
 	// dingofs
 	DINGOFS_MDSADDR         = "mdsaddr"
 	VIPER_DINGOFS_MDSADDR   = "dingofs.mdsaddr"
 	DEFAULT_DINGOFS_MDSADDR = "127.0.0.1:7400"
-	DINGOFS_FSID            = "fsid"
-	VIPER_DINGOFS_FSID      = "dingofs.fsid"
-	DEFAULT_DINGOFS_FSID    = uint32(0)
+
+	// check mds hostname can be resolved
+	DINGOFS_RESOLVE_MDSADDR         = "resolvemdsaddr"
+	VIPER_DINGOFS_RESOLVE_MDSADDR   = "dingofs.resolvemdsaddr"
+	DINGOFS_DEFAULT_RESOLVE_MDSADDR = false
+
+	// cache time of mds addresses discovered from dns srv record
+	DINGOFS_MDSADDR_CACHETTL         = "mdsaddrcachettl"
+	VIPER_DINGOFS_MDSADDR_CACHETTL   = "dingofs.mdsaddrcachettl"
+	DINGOFS_DEFAULT_MDSADDR_CACHETTL = 60 * time.Second
+
+	DINGOFS_FSID         = "fsid"
+	VIPER_DINGOFS_FSID   = "dingofs.fsid"
+	DEFAULT_DINGOFS_FSID = uint32(0)
 
 	DINGOFS_FSNAME              = "fsname"
 	VIPER_DINGOFS_FSNAME        = "dingofs.fsname"
@@ -207,20 +269,27 @@ const (
 
 var (
 	FLAG2VIPER = map[string]string{
-		RPCTIMEOUT:             VIPER_GLOBALE_RPCTIMEOUT,
-		RPCRETRYTIMES:          VIPER_GLOBALE_RPCRETRYTIMES,
-		RPCRETRYDElAY:          VIPER_GLOBALE_RPCRETRYDELAY,
-		VERBOSE:                VIPER_GLOBALE_VERBOSE,
-		DINGOFS_MDSADDR:        VIPER_DINGOFS_MDSADDR,
-		DINGOFS_FSID:           VIPER_DINGOFS_FSID,
-		DINGOFS_FSNAME:         VIPER_DINGOFS_FSNAME,
-		DINGOFS_NOCONFIRM:      VIPER_DINGOFS_NOCONFIRM,
-		DINGOFS_BLOCKSIZE:      VIPER_DINGOFS_BLOCKSIZE,
-		DINGOFS_CHUNKSIZE:      VIPER_DINGOFS_CHUNKSIZE,
-		DINGOFS_STORAGETYPE:    VIPER_DINGOFS_STORAGETYPE,
-		DINGOFS_THREADS:        VIPER_DINGOFS_THREADS,
-		DINGOFS_PARTITION_TYPE: VIPER_DINGOFS_PARTITION_TYPE,
-		DINGOFS_HUMANIZE:       VIPER_DINGOFS_HUMANIZE,
+		RPCTIMEOUT:               VIPER_GLOBALE_RPCTIMEOUT,
+		RPCRETRYTIMES:            VIPER_GLOBALE_RPCRETRYTIMES,
+		RPCRETRYDElAY:            VIPER_GLOBALE_RPCRETRYDELAY,
+		RPCCONCURRENCY:           VIPER_GLOBALE_RPCCONCURRENCY,
+		VERBOSE:                  VIPER_GLOBALE_VERBOSE,
+		LOGLEVEL:                 VIPER_GLOBALE_LOGLEVEL,
+		LOGFORMAT:                VIPER_GLOBALE_LOGFORMAT,
+		PAGER:                    VIPER_GLOBALE_PAGER,
+		OTEL_ENDPOINT:            VIPER_GLOBALE_OTEL_ENDPOINT,
+		DINGOFS_MDSADDR:          VIPER_DINGOFS_MDSADDR,
+		DINGOFS_RESOLVE_MDSADDR:  VIPER_DINGOFS_RESOLVE_MDSADDR,
+		DINGOFS_MDSADDR_CACHETTL: VIPER_DINGOFS_MDSADDR_CACHETTL,
+		DINGOFS_FSID:             VIPER_DINGOFS_FSID,
+		DINGOFS_FSNAME:           VIPER_DINGOFS_FSNAME,
+		DINGOFS_NOCONFIRM:        VIPER_DINGOFS_NOCONFIRM,
+		DINGOFS_BLOCKSIZE:        VIPER_DINGOFS_BLOCKSIZE,
+		DINGOFS_CHUNKSIZE:        VIPER_DINGOFS_CHUNKSIZE,
+		DINGOFS_STORAGETYPE:      VIPER_DINGOFS_STORAGETYPE,
+		DINGOFS_THREADS:          VIPER_DINGOFS_THREADS,
+		DINGOFS_PARTITION_TYPE:   VIPER_DINGOFS_PARTITION_TYPE,
+		DINGOFS_HUMANIZE:         VIPER_DINGOFS_HUMANIZE,
 
 		// S3
 		DINGOFS_S3_AK:         VIPER_DINGOFS_S3_AK,
@@ -271,18 +340,25 @@ var (
 	}
 	FLAG2DEFAULT = map[string]interface{}{
 		// rpc
-		RPCTIMEOUT:    DEFAULT_RPCTIMEOUT,
-		RPCRETRYTIMES: DEFAULT_RPCRETRYTIMES,
-		RPCRETRYDElAY: DEFAULT_RPCRETRYDELAY,
-		VERBOSE:       DEFAULT_VERBOSE,
-
-		DINGOFS_FSID:           DEFAULT_DINGOFS_FSID,
-		DINGOFS_MDSADDR:        DEFAULT_DINGOFS_MDSADDR,
-		DINGOFS_THREADS:        DINGOFS_DEFAULT_THREADS,
-		DINGOFS_BLOCKSIZE:      DINGOFS_DEFAULT_BLOCKSIZE,
-		DINGOFS_CHUNKSIZE:      DINGOFS_DEFAULT_CHUNKSIZE,
-		DINGOFS_PARTITION_TYPE: DINGOFS_DEFAULT_PARTITION_TYPE,
-		DINGOFS_HUMANIZE:       DINGOFS_DEFAULT_HUMANIZE,
+		RPCTIMEOUT:     DEFAULT_RPCTIMEOUT,
+		RPCRETRYTIMES:  DEFAULT_RPCRETRYTIMES,
+		RPCRETRYDElAY:  DEFAULT_RPCRETRYDELAY,
+		RPCCONCURRENCY: DEFAULT_RPCCONCURRENCY,
+		VERBOSE:        DEFAULT_VERBOSE,
+		LOGLEVEL:       DEFAULT_LOGLEVEL,
+		LOGFORMAT:      DEFAULT_LOGFORMAT,
+		PAGER:          DEFAULT_PAGER,
+		OTEL_ENDPOINT:  DEFAULT_OTEL_ENDPOINT,
+
+		DINGOFS_FSID:             DEFAULT_DINGOFS_FSID,
+		DINGOFS_MDSADDR:          DEFAULT_DINGOFS_MDSADDR,
+		DINGOFS_RESOLVE_MDSADDR:  DINGOFS_DEFAULT_RESOLVE_MDSADDR,
+		DINGOFS_MDSADDR_CACHETTL: DINGOFS_DEFAULT_MDSADDR_CACHETTL,
+		DINGOFS_THREADS:          DINGOFS_DEFAULT_THREADS,
+		DINGOFS_BLOCKSIZE:        DINGOFS_DEFAULT_BLOCKSIZE,
+		DINGOFS_CHUNKSIZE:        DINGOFS_DEFAULT_CHUNKSIZE,
+		DINGOFS_PARTITION_TYPE:   DINGOFS_DEFAULT_PARTITION_TYPE,
+		DINGOFS_HUMANIZE:         DINGOFS_DEFAULT_HUMANIZE,
 
 		// S3
 		DINGOFS_S3_AK:         DINGOFS_DEFAULT_S3_AK,
@@ -334,165 +410,63 @@ var (
 )
 
 func AddStringFlag(cmd *cobra.Command, name string, usage string) {
-	defaultValue := FLAG2DEFAULT[name]
-	if defaultValue == nil {
-		defaultValue = ""
-	}
-	cmd.Flags().String(name, defaultValue.(string), usage)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddFlag[string](cmd, name, usage)
 }
 
 func AddStringRequiredFlag(cmd *cobra.Command, name string, usage string) {
-	cmd.Flags().String(name, "", usage+color.RedString("[required]"))
-	cmd.MarkFlagRequired(name)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddRequiredFlag[string](cmd, name, usage)
 }
 
 func GetStringFlag(cmd *cobra.Command, flagName string) string {
-	var value string
-	if cmd.Flag(flagName).Changed {
-		value = cmd.Flag(flagName).Value.String()
-	} else {
-		value = viper.GetString(FLAG2VIPER[flagName])
-	}
-	return value
+	return GetFlag[string](cmd, flagName)
 }
 
 func AddBoolFlag(cmd *cobra.Command, name string, usage string) {
-	defaultValue := FLAG2DEFAULT[name]
-	if defaultValue == nil {
-		defaultValue = false
-	}
-	cmd.Flags().Bool(name, defaultValue.(bool), usage)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddFlag[bool](cmd, name, usage)
 }
 
 func GetBoolFlag(cmd *cobra.Command, flagName string) bool {
-	var value bool
-	flag := cmd.Flag(flagName)
-	if flag == nil {
-		return false
-	}
-	if flag.Changed {
-		value, _ = cmd.Flags().GetBool(flagName)
-	} else {
-		value = viper.GetBool(FLAG2VIPER[flagName])
-	}
-	return value
+	return GetFlag[bool](cmd, flagName)
 }
 
 func AddUint64Flag(cmd *cobra.Command, name string, usage string) {
-	defaultValue := FLAG2DEFAULT[name]
-	if defaultValue == nil {
-		defaultValue = 0
-	}
-	cmd.Flags().Uint64(name, defaultValue.(uint64), usage)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddFlag[uint64](cmd, name, usage)
 }
 
 func GetUint64Flag(cmd *cobra.Command, flagName string) uint64 {
-	value, err := cmd.Flags().GetUint64(flagName)
-	if err != nil {
-		return 0
-	}
-	return value
+	return GetFlag[uint64](cmd, flagName)
 }
 
 func AddUint32Flag(cmd *cobra.Command, name string, usage string) {
-	defaultValue := FLAG2DEFAULT[name]
-	if defaultValue == nil {
-		defaultValue = 0
-	}
-	cmd.Flags().Uint32(name, defaultValue.(uint32), usage)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddFlag[uint32](cmd, name, usage)
 }
 
 func AddUint32RequiredFlag(cmd *cobra.Command, name string, usage string) {
-	cmd.Flags().Uint32(name, uint32(0), usage+color.RedString("[required]"))
-	cmd.MarkFlagRequired(name)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddRequiredFlag[uint32](cmd, name, usage)
 }
 
 func GetUint32Flag(cmd *cobra.Command, flagName string) uint32 {
-	var value uint32
-	if cmd.Flag(flagName).Changed {
-		value, _ = cmd.Flags().GetUint32(flagName)
-	} else {
-		value = viper.GetUint32(FLAG2VIPER[flagName])
-	}
-	return value
+	return GetFlag[uint32](cmd, flagName)
 }
 
 func AddDurationFlag(cmd *cobra.Command, name string, usage string) {
-	defaultValue := FLAG2DEFAULT[name]
-	if defaultValue == nil {
-		defaultValue = 0
-	}
-	cmd.Flags().Duration(name, defaultValue.(time.Duration), usage)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddFlag[time.Duration](cmd, name, usage)
 }
 
 func GetDurationFlag(cmd *cobra.Command, flagName string) time.Duration {
-	var value time.Duration
-	if cmd.Flag(flagName).Changed {
-		value, _ = cmd.Flags().GetDuration(flagName)
-	} else {
-		value = viper.GetDuration(FLAG2VIPER[flagName])
-	}
-	return value
+	return GetFlag[time.Duration](cmd, flagName)
 }
 
 func AddInt32Flag(cmd *cobra.Command, name string, usage string) {
-	defaultValue := FLAG2DEFAULT[name]
-	if defaultValue == nil {
-		defaultValue = int32(0)
-	}
-	cmd.Flags().Int32(name, defaultValue.(int32), usage)
-	err := viper.BindPFlag(FLAG2VIPER[name], cmd.Flags().Lookup(name))
-	if err != nil {
-		cobra.CheckErr(err)
-	}
+	AddFlag[int32](cmd, name, usage)
 }
 
 func GetInt32Flag(cmd *cobra.Command, flagName string) int32 {
-	var value int32
-	if cmd.Flag(flagName).Changed {
-		value, _ = cmd.Flags().GetInt32(flagName)
-	} else {
-		value = viper.GetInt32(FLAG2VIPER[flagName])
-	}
-	return value
+	return GetFlag[int32](cmd, flagName)
 }
 
 func GetStringSliceFlag(cmd *cobra.Command, flagName string) []string {
-	var value []string
-	if cmd.Flag(flagName).Changed {
-		value, _ = cmd.Flags().GetStringSlice(flagName)
-	} else {
-		value = viper.GetStringSlice(FLAG2VIPER[flagName])
-	}
-	return value
+	return GetFlag[[]string](cmd, flagName)
 }
 
 func AddConfigFileFlag(cmd *cobra.Command) {
@@ -500,22 +474,62 @@ func AddConfigFileFlag(cmd *cobra.Command) {
 }
 
 func AddFormatFlag(cmd *cobra.Command) {
-	cmd.Flags().StringP(FORMAT, "", FORMAT_PLAIN, "output format (json|plain)")
+	cmd.Flags().StringP(FORMAT, "", FORMAT_PLAIN, "output format (json|plain|table|csv|tsv|go-template=...|jsonpath=...)")
 	err := viper.BindPFlag(FORMAT, cmd.Flags().Lookup(FORMAT))
 	if err != nil {
-		cobra.CheckErr(err)
+		CheckErr(err)
+	}
+	cmd.Flags().StringSlice(COLUMNS, nil, "Only show the specified columns, e.g. fsId,fsName")
+	cmd.Flags().Bool(NO_HEADERS, false, "Do not print table header")
+}
+
+// add --filter, --sort-by and --limit for list commands, which are applied to rows of
+// plain, table, csv and tsv output, --limit is skipped if command has its own
+func AddListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray(FILTER, nil, "Only show rows matching key=value or key!=value, value can be a shell pattern")
+	cmd.Flags().String(SORT_BY, "", "Sort rows by columns, '-' prefix for descending, e.g. -size,name")
+	if cmd.Flags().Lookup(LIMIT) == nil {
+		cmd.Flags().Int(LIMIT, 0, "Only show the first N rows")
+		if cmd.Annotations == nil {
+			cmd.Annotations = map[string]string{}
+		}
+		cmd.Annotations[ANNOTATION_LIST_LIMIT] = "true"
 	}
 }
 
+// get configure type from file extension, yaml is used for unknown extension
+func GetConfigType(filename string) string {
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(filename), ".")) {
+	case CONFIG_TYPE_JSON:
+		return CONFIG_TYPE_JSON
+	case CONFIG_TYPE_TOML:
+		return CONFIG_TYPE_TOML
+	default:
+		return CONFIG_TYPE_YAML
+	}
+}
+
+// find $HOME/.dingo/dingo.{yaml,yml,json,toml}, dingo.yaml is returned if none exists
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	CheckErr(err)
+
+	dir := filepath.Join(home, ".dingo")
+	for _, ext := range CONFIG_EXTENSIONS {
+		filename := filepath.Join(dir, "dingo."+ext)
+		if IsFileExists(filename) {
+			return filename
+		}
+	}
+	return filepath.Join(dir, "dingo.yaml")
+}
+
 func GetConfigFile(cmd *cobra.Command) string {
 	var value string
-	if cmd.Flag("conf").Changed {
-		value = cmd.Flag("conf").Value.String()
-	} else {
-		// using $HOME/.dingo/dingo.yaml as default configuration file path
-		home, err := os.UserHomeDir()
-		cobra.CheckErr(err)
-		value = fmt.Sprintf("%s/.dingo/dingo.yaml", home)
+	if flag := cmd.Flag("conf"); flag != nil && flag.Changed {
+		value = flag.Value.String()
+	} else if value = os.Getenv("CONF"); value == "" {
+		value = defaultConfigFile()
 	}
 
 	return value
@@ -524,54 +538,108 @@ func GetConfigFile(cmd *cobra.Command) string {
 func ReadCommandConfig(cmd *cobra.Command) {
 	// configure file priority
 	// command line (--conf dingo.yaml) > environment variables(CONF=/opt/dingo.yaml) > default (~/.dingo/dingo.yaml)
-	var value string
-	if cmd.Flag("conf").Changed {
-		value = cmd.Flag("conf").Value.String()
-	} else {
-		value = os.Getenv("CONF") //check environment variable
-	}
-
-	if value != "" {
-		viper.SetConfigFile(value)
-	} else { // use default
-		home, err := os.UserHomeDir()
-		cobra.CheckErr(err)
-		viper.AddConfigPath(home + "/.dingo")
-		viper.SetConfigType("yaml")
-		viper.SetConfigName("dingo")
-	}
+	value := GetConfigFile(cmd)
+	defer profile.Start(profile.PHASE_CONFIG, "read "+value)()
+	viper.SetConfigFile(value)
+	viper.SetConfigType(GetConfigType(value))
+
+	// value priority:
+	// command line (--mdsaddr) > environment variables(DINGO_DINGOFS_MDSADDR) > configure file > default
+	viper.SetEnvPrefix(ENV_PREFIX)
+	viper.SetEnvKeyReplacer(envKeyReplacer)
 	viper.AutomaticEnv()
-	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+	// missing default configuration file is allowed
+	flag := cmd.Flag("conf")
+	explicit := (flag != nil && flag.Changed) || os.Getenv("CONF") != ""
+	if explicit || IsFileExists(value) {
+		if err := viper.ReadInConfig(); err != nil {
 			log.Printf("config file name: %v", viper.ConfigFileUsed())
-			cobra.CheckErr(err)
+			CheckErr(err)
 		}
 	}
-}
 
-func isIpAddrValid(addr string) bool {
-	matched, err := regexp.MatchString(IP_PORT_REGEX, addr)
-	if err != nil || !matched {
-		return false
+	// merge selected context over top-level settings
+	CheckErr(ApplyContext(GetContextName(cmd)))
+
+	applyLogLevel(cmd)
+	if version := viper.GetInt(VIPER_CONFIG_VERSION); IsFileExists(viper.ConfigFileUsed()) && version < CONFIG_VERSION {
+		logger.Warnf("configuration file %s version %d is outdated, run 'dingo config migrate' to upgrade", viper.ConfigFileUsed(), version)
 	}
+}
 
-	return true
+// get environment variable name of viper key
+func EnvKey(viperKey string) string {
+	return fmt.Sprintf("%s_%s", ENV_PREFIX, strings.ToUpper(envKeyReplacer.Replace(viperKey)))
 }
 
-// get mdsaddr slice
-func GetMDSAddrSlice(cmd *cobra.Command) ([]string, error) {
-	addrsStr := GetStringFlag(cmd, DINGOFS_MDSADDR)
+// addr maybe:
+// 10.0.0.1:7400
+// mds1.internal:7400
+// [::1]:7400
+func ValidateHostPort(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid address %s, expect host:port or [ipv6]:port", addr)
+	}
+
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return fmt.Errorf("invalid port in address %s", addr)
+	}
 
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+	if strings.Contains(host, ":") {
+		return fmt.Errorf("invalid ipv6 address %s", addr)
+	}
+	if matched, _ := regexp.MatchString(HOSTNAME_REGEX, host); !matched {
+		return fmt.Errorf("invalid hostname in address %s", addr)
+	}
+
+	return nil
+}
+
+// parse comma separated mds addresses, hostnames are looked up if resolve is true
+func ParseMDSAddrs(addrsStr string, resolve bool) ([]string, error) {
 	addrslice := strings.Split(addrsStr, ",")
-	for _, addr := range addrslice {
-		if !isIpAddrValid(addr) {
-			return nil, fmt.Errorf("invalid address: %s", addr)
+	for i, addr := range addrslice {
+		addr = strings.TrimSpace(addr)
+		if err := ValidateHostPort(addr); err != nil {
+			return nil, err
+		}
+		if resolve {
+			host, _, _ := net.SplitHostPort(addr)
+			if net.ParseIP(host) == nil {
+				if _, err := net.LookupHost(host); err != nil {
+					return nil, fmt.Errorf("resolve mds address %s failed: %v", addr, err)
+				}
+			}
 		}
+		addrslice[i] = addr
 	}
 
 	return addrslice, nil
 }
 
+// get mdsaddr slice, srv://name is resolved from dns srv record,
+// local mountpoint is used if mdsaddr is not configured
+func GetMDSAddrSlice(cmd *cobra.Command) ([]string, error) {
+	addrsStr := GetStringFlag(cmd, DINGOFS_MDSADDR)
+	flag := cmd.Flag(DINGOFS_MDSADDR)
+	cfg := config()
+	if (flag == nil || !flag.Changed) && !cfg.IsSet(VIPER_DINGOFS_MDSADDR) {
+		if discovered, err := DiscoverMountPointMDSAddr(); err == nil {
+			addrsStr = discovered
+		} else {
+			logger.Debugf("discover mds address from mountpoint failed: %v", err)
+		}
+	}
+	if IsSrvMDSAddr(addrsStr) {
+		return ResolveSrvMDSAddr(addrsStr, cfg.GetDuration(VIPER_DINGOFS_MDSADDR_CACHETTL))
+	}
+	return ParseMDSAddrs(addrsStr, cfg.GetBool(VIPER_DINGOFS_RESOLVE_MDSADDR))
+}
+
 // check fsid and fsname
 func GetFsInfoFlagValue(cmd *cobra.Command) (uint32, string, error) {
 	var fsId uint32
@@ -0,0 +1,231 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// per-command overrides in configuration file, the section is the command path without "dingo":
+//
+//	fs:
+//	  warmup:
+//	    daemon: true    # default of --daemon for all "dingo fs warmup ..." commands
+//	component:
+//	  install:
+//	    parallel: 4     # default of --parallel for "dingo component install"
+//
+// value priority:
+// command line > environment variables > per-command section (most specific first) > top-level settings > default
+
+var (
+	// flags which can not be overridden by configuration file
+	skipCommandConfigFlags = map[string]bool{
+		"conf":     true,
+		"help":     true,
+		"version":  true,
+		CONTEXT:    true,
+		"dry-run":  true,
+		ASSUME_YES: true,
+		NO_PAGER:   true,
+		// only from command line, a configured file would be overwritten by every command
+		"output-file": true,
+	}
+)
+
+// get configuration sections of command, most specific first, e.g.
+// dingo fs warmup add -> [fs.warmup.add fs.warmup fs]
+func GetCommandConfigSections(cmd *cobra.Command) []string {
+	names := []string{}
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		names = append([]string{c.Name()}, names...)
+	}
+
+	sections := []string{}
+	for i := len(names); i > 0; i-- {
+		sections = append(sections, strings.Join(names[:i], "."))
+	}
+	return sections
+}
+
+// read configuration file with selected context merged, it is used before ReadCommandConfig,
+// nil is returned if configuration file not exists
+func readCommandConfigFile(cmd *cobra.Command) (*viper.Viper, error) {
+	confFile := GetConfigFile(cmd)
+	if !IsFileExists(confFile) {
+		return nil, nil
+	}
+
+	parser := viper.New()
+	parser.SetConfigFile(confFile)
+	parser.SetConfigType(GetConfigType(confFile))
+	if err := parser.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	// sections in selected context are merged over top-level sections
+	contextName := os.Getenv(ENV_DINGO_CONTEXT)
+	if flag := cmd.Flag(CONTEXT); flag != nil && flag.Changed {
+		contextName = flag.Value.String()
+	} else if contextName == "" {
+		contextName = parser.GetString(VIPER_CURRENT_CONTEXT)
+	}
+	if contextName != "" {
+		key := fmt.Sprintf("%s.%s", VIPER_CONTEXTS, contextName)
+		if err := parser.MergeConfigMap(parser.GetStringMap(key)); err != nil {
+			return nil, err
+		}
+	}
+	return parser, nil
+}
+
+// configuration file read by LoadEarlyConfig, nil if it does not exist or is broken
+var (
+	earlyConfig       *viper.Viper
+	earlyConfigLoaded bool
+)
+
+// parse configuration file once before the command runs, for per-command sections
+// and settings used before ReadCommandConfig, e.g. log level and pager
+func LoadEarlyConfig(cmd *cobra.Command) error {
+	parser, err := readCommandConfigFile(cmd)
+	earlyConfig, earlyConfigLoaded = parser, true
+	return err
+}
+
+func getEarlyConfig(cmd *cobra.Command) (*viper.Viper, error) {
+	if earlyConfigLoaded {
+		return earlyConfig, nil
+	}
+	return readCommandConfigFile(cmd)
+}
+
+// get value of configuration key before ReadCommandConfig, environment variable wins
+func GetEarlyConfigString(cmd *cobra.Command, key string) string {
+	if value, ok := os.LookupEnv(EnvKey(key)); ok {
+		return value
+	}
+	parser, err := getEarlyConfig(cmd)
+	if err != nil || parser == nil {
+		return ""
+	}
+	return parser.GetString(key)
+}
+
+// set flags which are not specified in command line from per-command sections,
+// flags are not marked as changed: values of flags in FLAG2VIPER are set into viper,
+// so they win over top-level settings, other flags get the value as their default
+func ApplyCommandConfig(cmd *cobra.Command) error {
+	parser, err := getEarlyConfig(cmd)
+	if err != nil || parser == nil {
+		return err
+	}
+	return applyCommandSections(cmd, parser, viper.GetViper(), true)
+}
+
+// flags of the command are only set by ApplyCommandConfig, a reloaded configuration only gets viper keys
+func applyCommandSections(cmd *cobra.Command, parser, target *viper.Viper, setFlags bool) error {
+	var err error
+	confFile := parser.ConfigFileUsed()
+	sections := GetCommandConfigSections(cmd)
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if err != nil || flag.Changed || skipCommandConfigFlags[flag.Name] {
+			return
+		}
+		viperKey, mapped := FLAG2VIPER[flag.Name]
+		if mapped {
+			if _, ok := os.LookupEnv(EnvKey(viperKey)); ok {
+				return
+			}
+		}
+
+		for _, section := range sections {
+			key := section + "." + flag.Name
+			if !parser.IsSet(key) {
+				continue
+			}
+			value := commandConfigValue(parser.Get(key))
+			switch {
+			case mapped:
+				if validateErr := validateConfigValue(flag.Name, value); validateErr != nil {
+					err = fmt.Errorf("invalid value of %s in %s: %v", key, confFile, validateErr)
+					return
+				}
+				target.Set(viperKey, parser.Get(key))
+			case setFlags:
+				if setErr := flag.Value.Set(value); setErr != nil {
+					err = fmt.Errorf("invalid value of %s in %s: %v", key, confFile, setErr)
+				}
+			}
+			return
+		}
+	})
+
+	return err
+}
+
+func commandConfigValue(value interface{}) string {
+	if values, ok := value.([]interface{}); ok {
+		items := []string{}
+		for _, item := range values {
+			items = append(items, fmt.Sprint(item))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(value)
+}
+
+// find flag of per-command configuration key, e.g. fs.warmup.daemon
+func LookupCommandConfigFlag(root *cobra.Command, key string) (*pflag.Flag, bool) {
+	if root == nil {
+		return nil, false
+	}
+
+	segments := strings.Split(key, ".")
+	cmd := root
+	for i, segment := range segments {
+		var child *cobra.Command
+		for _, c := range cmd.Commands() {
+			if c.Name() == segment {
+				child = c
+				break
+			}
+		}
+		if child != nil {
+			cmd = child
+			continue
+		}
+
+		// the last segment is flag name and it must be under a subcommand
+		if i != len(segments)-1 || cmd == root {
+			return nil, false
+		}
+		flag := cmd.Flags().Lookup(segment)
+		if flag == nil {
+			flag = cmd.InheritedFlags().Lookup(segment)
+		}
+		return flag, flag != nil && !skipCommandConfigFlags[segment]
+	}
+
+	return nil, false
+}
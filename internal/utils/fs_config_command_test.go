@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyCommandSections(t *testing.T) {
+	assert := assert.New(t)
+	confFile := filepath.Join(t.TempDir(), "dingo.yaml")
+	assert.NoError(os.WriteFile(confFile, []byte("global:\n  rpctimeout: 10s\nfs:\n  rpctimeout: 20s\n  list:\n    daemon: true\n"), 0600))
+	parser := viper.New()
+	parser.SetConfigFile(confFile)
+	assert.NoError(parser.ReadInConfig())
+
+	root := &cobra.Command{Use: "dingo"}
+	fs := &cobra.Command{Use: "fs"}
+	list := &cobra.Command{Use: "list"}
+	root.AddCommand(fs)
+	fs.AddCommand(list)
+	list.Flags().Duration(RPCTIMEOUT, DEFAULT_RPCTIMEOUT, "")
+	list.Flags().Bool("daemon", false, "")
+
+	// flags are not marked as changed, per-command section wins over top-level settings
+	target := viper.New()
+	assert.NoError(applyCommandSections(list, parser, target, true))
+	assert.False(list.Flag(RPCTIMEOUT).Changed)
+	assert.False(list.Flag("daemon").Changed)
+	daemon, _ := list.Flags().GetBool("daemon")
+	assert.True(daemon)
+	timeout, err := ParseDuration(target.GetString(VIPER_GLOBALE_RPCTIMEOUT))
+	assert.NoError(err)
+	assert.Equal(20*time.Second, timeout)
+
+	// command line wins
+	list.Flags().Set(RPCTIMEOUT, "5s")
+	target = viper.New()
+	assert.NoError(applyCommandSections(list, parser, target, true))
+	assert.False(target.IsSet(VIPER_GLOBALE_RPCTIMEOUT))
+}
@@ -0,0 +1,339 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// schema version of configuration file, configuration file without version is version 0
+const (
+	VIPER_CONFIG_VERSION = "version"
+	CONFIG_VERSION       = 1
+)
+
+// yaml files are migrated by editing the node tree in place, so comments and
+// order of keys are kept, json and toml files are migrated by settings
+type configMigration struct {
+	version     int // schema version after migration
+	migrate     func(settings map[string]interface{}) []string
+	migrateNode func(section *yaml.Node) []string
+}
+
+var (
+	configMigrations = []configMigration{
+		{version: 1, migrate: migrateConfigV1, migrateNode: migrateConfigNodeV1},
+	}
+)
+
+type ConfigMigrateResult struct {
+	FromVersion int
+	ToVersion   int
+	Changes     []string
+	Original    string
+	Migrated    string
+}
+
+func GetConfigVersion(settings map[string]interface{}) int {
+	value, ok := settings[VIPER_CONFIG_VERSION]
+	if !ok {
+		return 0
+	}
+	version, _ := strconv.Atoi(fmt.Sprint(value))
+	return version
+}
+
+// upgrade configuration file to current schema, the file is not written
+func MigrateConfigFile(filename string) (*ConfigMigrateResult, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	configType := GetConfigType(filename)
+	parser := viper.New()
+	parser.SetConfigType(configType)
+	if err := parser.ReadConfig(strings.NewReader(string(data))); err != nil {
+		return nil, err
+	}
+
+	settings := parser.AllSettings()
+	result := &ConfigMigrateResult{
+		FromVersion: GetConfigVersion(settings),
+		ToVersion:   CONFIG_VERSION,
+		Original:    string(data),
+		Migrated:    string(data),
+	}
+	if result.FromVersion > CONFIG_VERSION {
+		return nil, fmt.Errorf("configuration file version %d is newer than supported version %d, please upgrade dingo",
+			result.FromVersion, CONFIG_VERSION)
+	}
+	if result.FromVersion == CONFIG_VERSION {
+		return result, nil
+	}
+
+	if configType == CONFIG_TYPE_YAML {
+		result.Migrated, result.Changes, err = migrateYamlConfig(data, result.FromVersion)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	for _, migration := range configMigrations {
+		if migration.version <= result.FromVersion {
+			continue
+		}
+		result.Changes = append(result.Changes, migration.migrate(settings)...)
+		// contexts have the same layout as top-level settings
+		contexts, _ := settings[VIPER_CONTEXTS].(map[string]interface{})
+		for name, context := range contexts {
+			if section, ok := context.(map[string]interface{}); ok {
+				for _, change := range migration.migrate(section) {
+					result.Changes = append(result.Changes, fmt.Sprintf("%s.%s: %s", VIPER_CONTEXTS, name, change))
+				}
+			}
+		}
+	}
+	settings[VIPER_CONFIG_VERSION] = CONFIG_VERSION
+	result.Changes = append(result.Changes, fmt.Sprintf("set %s to %d", VIPER_CONFIG_VERSION, CONFIG_VERSION))
+
+	migrated, err := marshalConfig(settings, configType)
+	if err != nil {
+		return nil, err
+	}
+	result.Migrated = migrated
+
+	return result, nil
+}
+
+func migrateYamlConfig(data []byte, fromVersion int) (string, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return "", nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return "", nil, fmt.Errorf("top level of configuration file is not a mapping")
+	}
+	root := doc.Content[0]
+
+	changes := []string{}
+	for _, migration := range configMigrations {
+		if migration.version <= fromVersion {
+			continue
+		}
+		changes = append(changes, migration.migrateNode(root)...)
+		// contexts have the same layout as top-level settings
+		_, contexts := yamlMappingGet(root, VIPER_CONTEXTS)
+		if contexts == nil || contexts.Kind != yaml.MappingNode {
+			continue
+		}
+		for i := 0; i+1 < len(contexts.Content); i += 2 {
+			name, section := contexts.Content[i].Value, contexts.Content[i+1]
+			if section.Kind != yaml.MappingNode {
+				continue
+			}
+			for _, change := range migration.migrateNode(section) {
+				changes = append(changes, fmt.Sprintf("%s.%s: %s", VIPER_CONTEXTS, name, change))
+			}
+		}
+	}
+
+	version := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(CONFIG_VERSION)}
+	if key, _ := yamlMappingGet(root, VIPER_CONFIG_VERSION); key != nil {
+		yamlMappingSet(root, key.Value, version)
+	} else {
+		// version goes first, comment of the file stays on top
+		key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: VIPER_CONFIG_VERSION}
+		if len(root.Content) > 0 {
+			key.HeadComment, root.Content[0].HeadComment = root.Content[0].HeadComment, ""
+		}
+		root.Content = append([]*yaml.Node{key, version}, root.Content...)
+	}
+	changes = append(changes, fmt.Sprintf("set %s to %d", VIPER_CONFIG_VERSION, CONFIG_VERSION))
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return "", nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), changes, nil
+}
+
+// key and value of mapping node, keys are case insensitive as viper
+func yamlMappingGet(mapping *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// value of key is replaced, or key is appended to the mapping
+func yamlMappingSet(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+func yamlMappingDelete(mapping *yaml.Node, key string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if strings.EqualFold(mapping.Content[i].Value, key) {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
+
+func marshalConfig(settings map[string]interface{}, configType string) (string, error) {
+	var data []byte
+	var err error
+	switch configType {
+	case CONFIG_TYPE_JSON:
+		data, err = json.MarshalIndent(settings, "", "  ")
+		data = append(data, '\n')
+	case CONFIG_TYPE_TOML:
+		data, err = toml.Marshal(settings)
+	default:
+		data, err = yaml.Marshal(settings)
+	}
+	return string(data), err
+}
+
+// version 0 -> 1:
+//
+//	curvefs.*                             -> dingofs.*
+//	dingofs.s3.blocksize/chunksize        -> dingofs.blocksize/chunksize
+//	dingofs.rados.blocksize/chunksize     -> dingofs.blocksize/chunksize
+func migrateConfigV1(settings map[string]interface{}) []string {
+	changes := []string{}
+	if curvefs, ok := settings["curvefs"].(map[string]interface{}); ok {
+		dingofs, _ := settings["dingofs"].(map[string]interface{})
+		if dingofs == nil {
+			dingofs = map[string]interface{}{}
+		}
+		for key, value := range curvefs {
+			if _, ok := dingofs[key]; !ok {
+				dingofs[key] = value
+			}
+		}
+		settings["dingofs"] = dingofs
+		delete(settings, "curvefs")
+		changes = append(changes, "rename section curvefs to dingofs")
+	}
+
+	dingofs, ok := settings["dingofs"].(map[string]interface{})
+	if !ok {
+		return changes
+	}
+
+	// the section of storage type in use wins
+	storages := []string{"s3", "rados"}
+	if fmt.Sprint(dingofs[DINGOFS_STORAGETYPE]) == "rados" {
+		storages = []string{"rados", "s3"}
+	}
+	for _, key := range []string{DINGOFS_BLOCKSIZE, DINGOFS_CHUNKSIZE} {
+		for _, storage := range storages {
+			section, ok := dingofs[storage].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value, ok := section[key]
+			if !ok {
+				continue
+			}
+			delete(section, key)
+			if _, exist := dingofs[key]; exist {
+				changes = append(changes, fmt.Sprintf("remove dingofs.%s.%s, dingofs.%s is used", storage, key, key))
+				continue
+			}
+			dingofs[key] = value
+			changes = append(changes, fmt.Sprintf("move dingofs.%s.%s to dingofs.%s", storage, key, key))
+		}
+	}
+
+	return changes
+}
+
+// same as migrateConfigV1 on yaml nodes, keys are moved with their comments
+func migrateConfigNodeV1(section *yaml.Node) []string {
+	changes := []string{}
+	if curvefsKey, curvefs := yamlMappingGet(section, "curvefs"); curvefs != nil && curvefs.Kind == yaml.MappingNode {
+		if _, dingofs := yamlMappingGet(section, "dingofs"); dingofs == nil || dingofs.Kind != yaml.MappingNode {
+			yamlMappingDelete(section, "dingofs")
+			curvefsKey.Value = "dingofs"
+		} else {
+			for i := 0; i+1 < len(curvefs.Content); i += 2 {
+				if key, _ := yamlMappingGet(dingofs, curvefs.Content[i].Value); key == nil {
+					dingofs.Content = append(dingofs.Content, curvefs.Content[i], curvefs.Content[i+1])
+				}
+			}
+			yamlMappingDelete(section, "curvefs")
+		}
+		changes = append(changes, "rename section curvefs to dingofs")
+	}
+
+	_, dingofs := yamlMappingGet(section, "dingofs")
+	if dingofs == nil || dingofs.Kind != yaml.MappingNode {
+		return changes
+	}
+
+	// the section of storage type in use wins
+	storages := []string{"s3", "rados"}
+	if _, storageType := yamlMappingGet(dingofs, DINGOFS_STORAGETYPE); storageType != nil && storageType.Value == "rados" {
+		storages = []string{"rados", "s3"}
+	}
+	for _, key := range []string{DINGOFS_BLOCKSIZE, DINGOFS_CHUNKSIZE} {
+		for _, storage := range storages {
+			_, storageSection := yamlMappingGet(dingofs, storage)
+			if storageSection == nil || storageSection.Kind != yaml.MappingNode {
+				continue
+			}
+			keyNode, value := yamlMappingGet(storageSection, key)
+			if keyNode == nil {
+				continue
+			}
+			yamlMappingDelete(storageSection, key)
+			if exist, _ := yamlMappingGet(dingofs, key); exist != nil {
+				changes = append(changes, fmt.Sprintf("remove dingofs.%s.%s, dingofs.%s is used", storage, key, key))
+				continue
+			}
+			dingofs.Content = append(dingofs.Content, keyNode, value)
+			changes = append(changes, fmt.Sprintf("move dingofs.%s.%s to dingofs.%s", storage, key, key))
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrateYamlConfigKeepsComments(t *testing.T) {
+	assert := assert.New(t)
+	confFile := filepath.Join(t.TempDir(), "dingo.yaml")
+	assert.NoError(os.WriteFile(confFile, []byte(`# dingo configuration
+global:
+  rpctimeout: 30s  # timeout of rpc
+curvefs:
+  mdsaddr: 127.0.0.1:6700  # mds
+  s3:
+    ak: ak
+    blocksize: 4 mib  # block
+`), 0600))
+
+	result, err := MigrateConfigFile(confFile)
+	assert.NoError(err)
+	assert.Equal(0, result.FromVersion)
+	assert.Equal([]string{
+		"rename section curvefs to dingofs",
+		"move dingofs.s3.blocksize to dingofs.blocksize",
+		"set version to 1",
+	}, result.Changes)
+	assert.Equal(`# dingo configuration
+version: 1
+global:
+  rpctimeout: 30s # timeout of rpc
+dingofs:
+  mdsaddr: 127.0.0.1:6700 # mds
+  s3:
+    ak: ak
+  blocksize: 4 mib # block
+`, result.Migrated)
+}
@@ -0,0 +1,270 @@
+/*
+ * 	Copyright (c) 2025 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	CONFIG_PROBLEM_ERROR   = "error"
+	CONFIG_PROBLEM_WARNING = "warning"
+)
+
+type ConfigProblem struct {
+	Line    int    `json:"line"`
+	Key     string `json:"key"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+type configEntry struct {
+	key   string
+	value string
+	line  int
+}
+
+// validate configuration file and report all problems with line number,
+// root is used to check per-command sections, e.g. fs.warmup.daemon
+func ValidateConfigFile(filename string, root *cobra.Command) ([]ConfigProblem, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	// json is a subset of yaml, so line number is available for both of them
+	var doc yaml.Node
+	err = yaml.Unmarshal(data, &doc)
+	if err == nil {
+		entries := []configEntry{}
+		if len(doc.Content) > 0 {
+			entries = flattenConfigNode(doc.Content[0], "", entries)
+		}
+		return validateConfigEntries(entries, root), nil
+	}
+
+	configType := GetConfigType(filename)
+	if configType == CONFIG_TYPE_YAML {
+		return []ConfigProblem{{Level: CONFIG_PROBLEM_ERROR, Message: err.Error()}}, nil
+	}
+
+	// toml (or json yaml can not parse), no line number
+	parser := viper.New()
+	parser.SetConfigType(configType)
+	if err := parser.ReadConfig(bytes.NewReader(data)); err != nil {
+		return []ConfigProblem{{Level: CONFIG_PROBLEM_ERROR, Message: err.Error()}}, nil
+	}
+
+	return validateConfigEntries(flattenConfigMap(parser.AllSettings(), "", []configEntry{}), root), nil
+}
+
+func flattenConfigMap(settings map[string]interface{}, prefix string, entries []configEntry) []configEntry {
+	keys := []string{}
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name := strings.ToLower(key)
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		switch value := settings[key].(type) {
+		case map[string]interface{}:
+			entries = flattenConfigMap(value, name, entries)
+		case []interface{}:
+			values := []string{}
+			for _, item := range value {
+				values = append(values, fmt.Sprint(item))
+			}
+			entries = append(entries, configEntry{name, strings.Join(values, ","), 0})
+		default:
+			entries = append(entries, configEntry{name, fmt.Sprint(value), 0})
+		}
+	}
+	return entries
+}
+
+func flattenConfigNode(node *yaml.Node, prefix string, entries []configEntry) []configEntry {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := strings.ToLower(node.Content[i].Value)
+			if prefix != "" {
+				key = prefix + "." + key
+			}
+			entries = flattenConfigNode(node.Content[i+1], key, entries)
+		}
+	case yaml.SequenceNode:
+		values := []string{}
+		for _, item := range node.Content {
+			values = append(values, item.Value)
+		}
+		entries = append(entries, configEntry{prefix, strings.Join(values, ","), node.Line})
+	default:
+		entries = append(entries, configEntry{prefix, node.Value, node.Line})
+	}
+	return entries
+}
+
+func validateConfigEntries(entries []configEntry, root *cobra.Command) []ConfigProblem {
+	problems := []ConfigProblem{}
+	report := func(entry configEntry, level, format string, a ...interface{}) {
+		problems = append(problems, ConfigProblem{
+			Line:    entry.line,
+			Key:     entry.key,
+			Level:   level,
+			Message: fmt.Sprintf(format, a...),
+		})
+	}
+
+	knownKeys := map[string]string{} // viper key -> flag name
+	for flag, key := range FLAG2VIPER {
+		knownKeys[key] = flag
+	}
+
+	contexts := map[string]bool{}
+	var currentContext *configEntry
+	for i, entry := range entries {
+		key := entry.key
+		if key == VIPER_CURRENT_CONTEXT {
+			currentContext = &entries[i]
+			continue
+		}
+		if key == VIPER_CONFIG_VERSION {
+			version, err := strconv.Atoi(entry.value)
+			switch {
+			case err != nil || version < 0:
+				report(entry, CONFIG_PROBLEM_ERROR, "invalid version '%s'", entry.value)
+			case version > CONFIG_VERSION:
+				report(entry, CONFIG_PROBLEM_ERROR, "version %d is newer than supported version %d", version, CONFIG_VERSION)
+			case version < CONFIG_VERSION:
+				report(entry, CONFIG_PROBLEM_WARNING, "outdated version %d, run 'dingo config migrate' to upgrade", version)
+			}
+			continue
+		}
+
+		// contexts.<name>.<key> is validated as <key>
+		if strings.HasPrefix(key, VIPER_CONTEXTS+".") {
+			parts := strings.SplitN(key, ".", 3)
+			if len(parts) < 3 {
+				report(entry, CONFIG_PROBLEM_ERROR, "context must be a mapping")
+				continue
+			}
+			contexts[parts[1]] = true
+			key = parts[2]
+		}
+
+		flag, known := knownKeys[key]
+		if !known {
+			if commandFlag, ok := LookupCommandConfigFlag(root, key); ok {
+				flag, known = commandFlag.Name, true
+			}
+		}
+		switch {
+		case strings.HasSuffix(key, "."+DINGOFS_BLOCKSIZE) || strings.HasSuffix(key, "."+DINGOFS_CHUNKSIZE):
+			if _, err := ParseSize(entry.value); err != nil {
+				report(entry, CONFIG_PROBLEM_ERROR, "%v", err)
+			}
+			continue
+		case !known:
+			report(entry, CONFIG_PROBLEM_WARNING, "unknown key, it will be ignored")
+			continue
+		}
+
+		if IsEncryptedSecret(entry.value) {
+			if !FLAG2SENSITIVE[flag] {
+				report(entry, CONFIG_PROBLEM_ERROR, "only secrets are decrypted, store the value in plain text")
+			} else if _, err := ResolveSecret(entry.value); err != nil {
+				report(entry, CONFIG_PROBLEM_ERROR, "%v", err)
+			}
+			continue
+		}
+
+		if err := validateConfigValue(flag, entry.value); err != nil {
+			report(entry, CONFIG_PROBLEM_ERROR, "%v", err)
+		}
+	}
+
+	if currentContext != nil && currentContext.value != "" && !contexts[currentContext.value] {
+		report(*currentContext, CONFIG_PROBLEM_ERROR, "context '%s' not found in contexts", currentContext.value)
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Line < problems[j].Line
+	})
+	return problems
+}
+
+func validateConfigValue(flag, value string) error {
+	switch flag {
+	case DINGOFS_MDSADDR:
+		if IsSrvMDSAddr(value) {
+			_, err := ParseSrvMDSAddr(value)
+			return err
+		}
+		_, err := ParseMDSAddrs(value, false)
+		return err
+	case DINGOFS_RADOS_MON:
+		for _, mon := range strings.Split(value, ",") {
+			mon = strings.TrimSpace(mon)
+			if net.ParseIP(mon) == nil && ValidateHostPort(mon) != nil {
+				return fmt.Errorf("invalid mon address '%s', expect ip or host:port", mon)
+			}
+		}
+		return nil
+	case DINGOFS_S3_ENDPOINT:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid endpoint '%s', expect http(s)://host[:port]", value)
+		}
+		return nil
+	case DINGOFS_STORAGETYPE:
+		if value != "s3" && value != "rados" {
+			return fmt.Errorf("invalid storage type '%s', expect s3 or rados", value)
+		}
+		return nil
+	}
+
+	switch FLAG2DEFAULT[flag].(type) {
+	case time.Duration:
+		if _, err := ParseDuration(value); err != nil {
+			return err
+		}
+	case uint32:
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			return fmt.Errorf("invalid unsigned integer '%s'", value)
+		}
+	case bool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("invalid boolean '%s', expect true or false", value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"sync"
+
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+var (
+	watchOnce     sync.Once
+	reloadMtx     sync.Mutex
+	reloadHandler []func()
+
+	// configuration reloaded from file, it is read into a new viper and swapped in,
+	// so the global viper is never changed while flags are read from other goroutines
+	configMtx      sync.RWMutex
+	reloadedConfig *viper.Viper
+)
+
+// configuration flags are read from, the global viper until configure file reloaded
+func config() *viper.Viper {
+	configMtx.RLock()
+	defer configMtx.RUnlock()
+	if reloadedConfig != nil {
+		return reloadedConfig
+	}
+	return viper.GetViper()
+}
+
+// watch configure file loaded by ReadCommandConfig, used by long-running commands,
+// rpc timeouts, verbosity and log level changed in dingo.yaml take effect without restarting.
+// onReload is called after configure file reloaded, values set by command line still win.
+func WatchCommandConfig(cmd *cobra.Command, onReload func()) {
+	if onReload != nil {
+		reloadMtx.Lock()
+		reloadHandler = append(reloadHandler, onReload)
+		reloadMtx.Unlock()
+	}
+	confFile := viper.ConfigFileUsed()
+	if !IsFileExists(confFile) {
+		return
+	}
+
+	watchOnce.Do(func() {
+		contextName := GetContextName(cmd)
+		// the watcher is only used to get notified, it is never read by commands
+		watcher := viper.New()
+		watcher.SetConfigFile(confFile)
+		watcher.SetConfigType(GetConfigType(confFile))
+		watcher.OnConfigChange(func(e fsnotify.Event) {
+			reloaded, err := newCommandConfig(cmd, confFile, contextName)
+			if err != nil {
+				logger.Warnf("reload configure file %s failed: %v", e.Name, err)
+				return
+			}
+			configMtx.Lock()
+			reloadedConfig = reloaded
+			configMtx.Unlock()
+			logger.Infof("configure file %s reloaded", e.Name)
+			applyLogLevel(cmd)
+
+			reloadMtx.Lock()
+			handlers := append([]func(){}, reloadHandler...)
+			reloadMtx.Unlock()
+			for _, handler := range handlers {
+				handler()
+			}
+		})
+		watcher.WatchConfig()
+	})
+}
+
+// read configure file into a new viper the same way as ReadCommandConfig and
+// ApplyCommandConfig, flags of the command are bound, so their defaults are kept
+func newCommandConfig(cmd *cobra.Command, confFile, contextName string) (*viper.Viper, error) {
+	v := viper.New()
+	v.SetConfigFile(confFile)
+	v.SetConfigType(GetConfigType(confFile))
+	v.SetEnvPrefix(ENV_PREFIX)
+	v.SetEnvKeyReplacer(envKeyReplacer)
+	v.AutomaticEnv()
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	if err := applyContext(v, contextName); err != nil {
+		return nil, err
+	}
+	if err := applyCommandSections(cmd, v, v, false); err != nil {
+		return nil, err
+	}
+
+	var err error
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		key, ok := FLAG2VIPER[flag.Name]
+		if flag.Name == FORMAT {
+			key, ok = FORMAT, true
+		}
+		if ok && err == nil {
+			err = v.BindPFlag(key, flag)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,165 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"time"
+
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// value types supported by command flags
+type FlagType interface {
+	string | bool | uint32 | uint64 | int32 | time.Duration | []string
+}
+
+var (
+	// flags of secrets, e.g. s3 sk and rados key
+	FLAG2SENSITIVE = map[string]bool{
+		DINGOFS_S3_SK:     true,
+		DINGOFS_RADOS_KEY: true,
+	}
+)
+
+func init() {
+	for name := range FLAG2SENSITIVE {
+		addSensitiveKeys(name)
+	}
+}
+
+// only the flag name and viper key are sensitive keys, a bare segment like "key"
+// would mask unrelated fields of json output, values are redacted by AddSecretValue
+func addSensitiveKeys(name string) {
+	logger.AddSensitiveKeys(name, FLAG2VIPER[name])
+}
+
+func addFlag[T FlagType](cmd *cobra.Command, name string, defaultValue T, usage string) {
+	flags := cmd.Flags()
+	switch v := any(defaultValue).(type) {
+	case string:
+		flags.String(name, v, usage)
+	case bool:
+		flags.Bool(name, v, usage)
+	case uint32:
+		flags.Uint32(name, v, usage)
+	case uint64:
+		flags.Uint64(name, v, usage)
+	case int32:
+		flags.Int32(name, v, usage)
+	case time.Duration:
+		flags.Var(newDurationValue(v), name, usage)
+	case []string:
+		flags.StringSlice(name, v, usage)
+	}
+
+	err := viper.BindPFlag(FLAG2VIPER[name], flags.Lookup(name))
+	if err != nil {
+		CheckErr(err)
+	}
+}
+
+// add flag with default value in FLAG2DEFAULT
+func AddFlag[T FlagType](cmd *cobra.Command, name string, usage string) {
+	var defaultValue T
+	if value, ok := FLAG2DEFAULT[name].(T); ok {
+		defaultValue = value
+	}
+	addFlag(cmd, name, defaultValue, usage)
+}
+
+func AddRequiredFlag[T FlagType](cmd *cobra.Command, name string, usage string) {
+	var defaultValue T
+	addFlag(cmd, name, defaultValue, usage+color.RedString("[required]"))
+	cmd.MarkFlagRequired(name)
+}
+
+// value priority:
+// command line > environment variables > configure file > default
+func GetFlag[T FlagType](cmd *cobra.Command, name string) T {
+	var value T
+	var result interface{}
+	flags := cmd.Flags()
+	key := FLAG2VIPER[name]
+	cfg := config()
+
+	// flag not added by the command is only read from viper, flag without
+	// viper key is only read from command line and per-command sections
+	flag := cmd.Flag(name)
+	changed := flag != nil && (flag.Changed || key == "")
+	switch any(value).(type) {
+	case string:
+		var v string
+		if changed {
+			v = flag.Value.String()
+		} else {
+			v = cfg.GetString(key)
+		}
+		// transparently decrypt "enc:" value of secrets
+		if FLAG2SENSITIVE[name] {
+			secret, err := ResolveSecret(v)
+			CheckErr(err)
+			logger.AddSecretValue(secret)
+			v = secret
+		}
+		result = v
+	case bool:
+		if changed {
+			result, _ = flags.GetBool(name)
+		} else {
+			result = cfg.GetBool(key)
+		}
+	case uint32:
+		if changed {
+			result, _ = flags.GetUint32(name)
+		} else {
+			result = cfg.GetUint32(key)
+		}
+	case uint64:
+		if changed {
+			result, _ = flags.GetUint64(name)
+		} else {
+			result = cfg.GetUint64(key)
+		}
+	case int32:
+		if changed {
+			result, _ = flags.GetInt32(name)
+		} else {
+			result = cfg.GetInt32(key)
+		}
+	case time.Duration:
+		if changed {
+			result, _ = flags.GetDuration(name)
+		} else {
+			// viper treats number without unit as nanoseconds
+			v, err := ParseDuration(cfg.GetString(key))
+			CheckErr(err)
+			result = v
+		}
+	case []string:
+		if changed {
+			result, _ = flags.GetStringSlice(name)
+		} else {
+			result = cfg.GetStringSlice(key)
+		}
+	}
+
+	value = result.(T)
+	return value
+}
@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddFlag(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(VIPER_GLOBALE_RPCCONCURRENCY, FLAG2VIPER[RPCCONCURRENCY])
+	assert.Equal(DEFAULT_RPCCONCURRENCY, FLAG2DEFAULT[RPCCONCURRENCY])
+
+	cmd := &cobra.Command{}
+	AddUint32Flag(cmd, RPCCONCURRENCY, "Number of rpc requests sent at once")
+	assert.Equal(DEFAULT_RPCCONCURRENCY, GetUint32Flag(cmd, RPCCONCURRENCY))
+
+	cmd.Flags().Set(RPCCONCURRENCY, "32")
+	assert.Equal(uint32(32), GetUint32Flag(cmd, RPCCONCURRENCY))
+}
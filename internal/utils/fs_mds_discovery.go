@@ -0,0 +1,209 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/pkg/xattr"
+)
+
+// mds addresses discovered from dns srv record, e.g.
+//
+//	dingofs:
+//	  mdsaddr: srv://_dingo-mds._tcp.example.com
+//	  mdsaddrcachettl: 60s
+//
+// resolved addresses are cached in $HOME/.dingo/cache/mdsaddr.json,
+// the expired cache is still used if dns lookup failed.
+//
+// if mdsaddr is not configured at all, it is discovered from local dingofs mountpoints:
+// the mount source (mds://addr1,addr2/fsname) or the xattr dingofs.mdsaddr of mountpoint.
+
+const (
+	MDSADDR_SRV_SCHEME   = "srv://"
+	MDSADDR_CACHE_FILE   = "mdsaddr.json"
+	SRV_NAME_LABEL_REGEX = `^_?[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?$`
+
+	MOUNTPOINT_MDSADDR_SCHEME = "mds://"
+	MOUNTPOINT_MDSADDR_XATTR  = "dingofs.mdsaddr"
+)
+
+type mdsAddrCacheEntry struct {
+	Addrs    []string  `json:"addrs"`
+	ExpireAt time.Time `json:"expire_at"`
+}
+
+var (
+	mdsAddrCache   = map[string]mdsAddrCacheEntry{}
+	mdsAddrCacheMu sync.Mutex
+)
+
+func IsSrvMDSAddr(addrsStr string) bool {
+	return strings.HasPrefix(strings.TrimSpace(addrsStr), MDSADDR_SRV_SCHEME)
+}
+
+// get srv record name from srv://name
+func ParseSrvMDSAddr(addrsStr string) (string, error) {
+	name := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(addrsStr), MDSADDR_SRV_SCHEME), ".")
+	if name == "" {
+		return "", fmt.Errorf("invalid mds address '%s', expect srv://_service._proto.domain", addrsStr)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if matched, _ := regexp.MatchString(SRV_NAME_LABEL_REGEX, label); !matched {
+			return "", fmt.Errorf("invalid mds address '%s', expect srv://_service._proto.domain", addrsStr)
+		}
+	}
+	return name, nil
+}
+
+// resolve mds addresses from dns srv record, the records are ordered by priority and weight
+func ResolveSrvMDSAddr(addrsStr string, ttl time.Duration) ([]string, error) {
+	name, err := ParseSrvMDSAddr(addrsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	mdsAddrCacheMu.Lock()
+	defer mdsAddrCacheMu.Unlock()
+
+	if len(mdsAddrCache) == 0 {
+		loadMDSAddrCache()
+	}
+	entry, cached := mdsAddrCache[name]
+	if cached && ttl > 0 && time.Now().Before(entry.ExpireAt) {
+		return entry.Addrs, nil
+	}
+
+	addrs, err := lookupSrvMDSAddr(name)
+	if err != nil {
+		if cached {
+			logger.Warnf("resolve mds address %s failed, use expired cache: %v", addrsStr, err)
+			return entry.Addrs, nil
+		}
+		return nil, fmt.Errorf("resolve mds address %s failed: %v", addrsStr, err)
+	}
+
+	if ttl > 0 {
+		mdsAddrCache[name] = mdsAddrCacheEntry{Addrs: addrs, ExpireAt: time.Now().Add(ttl)}
+		saveMDSAddrCache()
+	}
+	return addrs, nil
+}
+
+func lookupSrvMDSAddr(name string) ([]string, error) {
+	// empty service and proto, name is looked up directly
+	_, records, err := net.LookupSRV("", "", name)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no srv record found")
+	}
+
+	addrs := []string{}
+	for _, record := range records {
+		host := strings.TrimSuffix(record.Target, ".")
+		addrs = append(addrs, net.JoinHostPort(host, strconv.Itoa(int(record.Port))))
+	}
+	return addrs, nil
+}
+
+func mdsAddrCacheFile() string {
+	return filepath.Join(GetCurrentHomeDir(), ".dingo", "cache", MDSADDR_CACHE_FILE)
+}
+
+// cache is best effort, errors are ignored
+func loadMDSAddrCache() {
+	data, err := os.ReadFile(mdsAddrCacheFile())
+	if err != nil {
+		return
+	}
+	json.Unmarshal(data, &mdsAddrCache)
+}
+
+func saveMDSAddrCache() {
+	data, err := json.Marshal(mdsAddrCache)
+	if err != nil {
+		return
+	}
+	filename := mdsAddrCacheFile()
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return
+	}
+	os.WriteFile(filename, data, 0644)
+}
+
+// discover mds address from local mountpoints, the mountpoint contains current directory wins,
+// error is returned if mountpoints belong to different clusters
+func DiscoverMountPointMDSAddr() (string, error) {
+	mountpoints, err := GetDingoFSMountPoints()
+	if err != nil {
+		return "", err
+	}
+
+	cwd, _ := os.Getwd()
+	found := []string{}
+	for _, mountpoint := range mountpoints {
+		addrsStr := mountPointMDSAddr(mountpoint)
+		if addrsStr == "" {
+			continue
+		}
+		if cwd == mountpoint.MountPoint || strings.HasPrefix(cwd, mountpoint.MountPoint+"/") {
+			logger.Infof("use mds address %s of mountpoint %s", addrsStr, mountpoint.MountPoint)
+			return addrsStr, nil
+		}
+		if !Contains(found, addrsStr) {
+			found = append(found, addrsStr)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("no dingofs mountpoint found")
+	case 1:
+		logger.Infof("use mds address %s of local mountpoint", found[0])
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("dingofs mountpoints of different mds addresses found: %s", strings.Join(found, "; "))
+	}
+}
+
+func mountPointMDSAddr(mountpoint *mountinfo.MountInfo) string {
+	if strings.HasPrefix(mountpoint.MountSource, MOUNTPOINT_MDSADDR_SCHEME) {
+		addrsStr := strings.TrimPrefix(mountpoint.MountSource, MOUNTPOINT_MDSADDR_SCHEME)
+		addrsStr, _, _ = strings.Cut(addrsStr, "/")
+		return addrsStr
+	}
+
+	value, err := xattr.Get(mountpoint.MountPoint, MOUNTPOINT_MDSADDR_XATTR)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(value))
+}
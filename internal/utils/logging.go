@@ -0,0 +1,79 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"os"
+
+	"github.com/dingodb/dingocli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+// logs of dingo are written to ~/.dingo/logs/dingo.log, rotated by size:
+//
+//	global:
+//	  loglevel: info    # debug, info, warn, error
+//	  logformat: text   # text or json
+//
+// command line (--log-level) > environment variables (DINGO_GLOBAL_LOGLEVEL) > configure file > default
+const (
+	FLAG_LOG_LEVEL  = "log-level"
+	FLAG_LOG_FORMAT = "log-format"
+)
+
+func AddLogFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(FLAG_LOG_LEVEL, "", "Log level (debug|info|warn|error), default is global.loglevel of configuration file or info")
+	cmd.PersistentFlags().String(FLAG_LOG_FORMAT, "", "Log format (text|json), default is global.logformat of configuration file or text")
+}
+
+// value of command line, or value of configuration file before it is read by ReadCommandConfig
+func earlyLogSetting(cmd *cobra.Command, flagName, viperKey, defaultValue string) string {
+	if flag := cmd.Flag(flagName); flag != nil && flag.Changed {
+		return flag.Value.String()
+	}
+	if value := GetEarlyConfigString(cmd, viperKey); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// set up logger before the command runs, so logs of rpc and config loading have the level and format
+func SetupLogger(cmd *cobra.Command) error {
+	level, err := logger.ParseLevel(earlyLogSetting(cmd, FLAG_LOG_LEVEL, VIPER_GLOBALE_LOGLEVEL, DEFAULT_LOGLEVEL))
+	if err != nil {
+		return err
+	}
+	format, err := logger.ParseFormat(earlyLogSetting(cmd, FLAG_LOG_FORMAT, VIPER_GLOBALE_LOGFORMAT, DEFAULT_LOGFORMAT))
+	if err != nil {
+		return err
+	}
+	logger.SetFormat(format)
+	logger.SetLevel(level.String())
+	return nil
+}
+
+// level of configuration file, after it is read or reloaded, --log-level always wins
+func applyLogLevel(cmd *cobra.Command) {
+	if flag := cmd.Flag(FLAG_LOG_LEVEL); flag != nil && flag.Changed {
+		return
+	}
+	cfg := config()
+	if _, ok := os.LookupEnv(EnvKey(VIPER_GLOBALE_LOGLEVEL)); !ok && !cfg.IsSet(VIPER_GLOBALE_LOGLEVEL) {
+		return
+	}
+	logger.SetLevel(cfg.GetString(VIPER_GLOBALE_LOGLEVEL))
+}
@@ -0,0 +1,216 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/profile"
+)
+
+// metrics in prometheus text format exported by brpc servers of mds, client and cache member
+const (
+	METRICS_PATH = "/brpc_metrics"
+
+	METRIC_TYPE_COUNTER   = "counter"
+	METRIC_TYPE_GAUGE     = "gauge"
+	METRIC_TYPE_HISTOGRAM = "histogram"
+	METRIC_TYPE_SUMMARY   = "summary"
+	METRIC_TYPE_UNTYPED   = "untyped"
+)
+
+type MetricSample struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Type   string            `json:"type"`
+	Value  float64           `json:"value"`
+}
+
+// name{k1="v1",k2="v2"} with labels sorted, identifies the sample between scrapes
+func (s *MetricSample) Key() string {
+	if len(s.Labels) == 0 {
+		return s.Name
+	}
+	return s.Name + "{" + s.LabelString() + "}"
+}
+
+func (s *MetricSample) LabelString() string {
+	keys := make([]string, 0, len(s.Labels))
+	for key := range s.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", key, s.Labels[key]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// counters only increase, so they are shown as rate, so are _count and _sum of histogram and summary
+func (s *MetricSample) Cumulative() bool {
+	switch s.Type {
+	case METRIC_TYPE_COUNTER:
+		return true
+	case METRIC_TYPE_HISTOGRAM, METRIC_TYPE_SUMMARY:
+		return strings.HasSuffix(s.Name, "_count") || strings.HasSuffix(s.Name, "_sum") || strings.HasSuffix(s.Name, "_bucket")
+	}
+	return strings.HasSuffix(s.Name, "_total")
+}
+
+func ScrapeMetrics(addr, path string, timeout time.Duration) ([]*MetricSample, error) {
+	defer profile.Start(profile.PHASE_HTTP, addr+path)()
+	client := &http.Client{Timeout: timeout}
+	response, err := client.Get(fmt.Sprintf("http://%s%s", addr, path))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("scrape %s%s: %s", addr, path, response.Status)
+	}
+	return ParseMetrics(response.Body)
+}
+
+// text format of prometheus: "# TYPE name type" and "name{label="value",...} value [timestamp]"
+func ParseMetrics(reader io.Reader) ([]*MetricSample, error) {
+	types := map[string]string{}
+	samples := []*MetricSample{}
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			fields := strings.Fields(line)
+			if len(fields) >= 4 && fields[1] == "TYPE" {
+				types[fields[2]] = fields[3]
+			}
+			continue
+		}
+		sample, err := parseMetricSample(line)
+		if err != nil {
+			return nil, err
+		}
+		sample.Type = metricType(types, sample.Name)
+		samples = append(samples, sample)
+	}
+	return samples, scanner.Err()
+}
+
+// type of histogram and summary is declared for the name without suffix
+func metricType(types map[string]string, name string) string {
+	if t, ok := types[name]; ok {
+		return t
+	}
+	for _, suffix := range []string{"_bucket", "_count", "_sum"} {
+		if t, ok := types[strings.TrimSuffix(name, suffix)]; ok && strings.HasSuffix(name, suffix) {
+			return t
+		}
+	}
+	return METRIC_TYPE_UNTYPED
+}
+
+func parseMetricSample(line string) (*MetricSample, error) {
+	sample := &MetricSample{Labels: map[string]string{}}
+	index := strings.IndexAny(line, "{ \t")
+	if index == -1 {
+		return nil, fmt.Errorf("invalid metric line: %s", line)
+	}
+	sample.Name = line[:index]
+	rest := line[index:]
+
+	if strings.HasPrefix(rest, "{") {
+		end, err := parseMetricLabels(rest, sample.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metric line: %s: %v", line, err)
+		}
+		rest = rest[end:]
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("invalid metric line: %s", line)
+	}
+	value, err := parseMetricValue(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid metric line: %s: %v", line, err)
+	}
+	sample.Value = value
+	return sample, nil
+}
+
+// labels in braces, returns index after the closing brace
+func parseMetricLabels(s string, labels map[string]string) (int, error) {
+	i := 1
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == ',') {
+			i++
+		}
+		if i >= len(s) {
+			return 0, fmt.Errorf("unclosed labels")
+		}
+		if s[i] == '}' {
+			return i + 1, nil
+		}
+		eq := strings.IndexByte(s[i:], '=')
+		if eq == -1 || i+eq+1 >= len(s) || s[i+eq+1] != '"' {
+			return 0, fmt.Errorf("invalid label")
+		}
+		name := strings.TrimSpace(s[i : i+eq])
+		i += eq + 2
+
+		var value strings.Builder
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+				if s[i] == 'n' {
+					value.WriteByte('\n')
+					continue
+				}
+			}
+			value.WriteByte(s[i])
+		}
+		if i >= len(s) {
+			return 0, fmt.Errorf("unclosed label value")
+		}
+		labels[name] = value.String()
+		i++
+	}
+}
+
+func parseMetricValue(s string) (float64, error) {
+	switch s {
+	case "+Inf":
+		return math.Inf(1), nil
+	case "-Inf":
+		return math.Inf(-1), nil
+	case "NaN":
+		return math.NaN(), nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
@@ -0,0 +1,189 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/dingodb/dingocli/internal/profile"
+	"github.com/pkg/xattr"
+)
+
+// admin channel of the dingo-client serving a local mountpoint, a unix socket found by:
+//   - xattr dingofs.admin.socket of the mountpoint root, which is the socket path
+//   - /var/run/dingofs/dingo-client.<pid>.sock of the client process of the mountpoint
+//
+// every request is a line of json, answered by one or more lines of json with the same id,
+// all but the last one of a streamed answer (e.g. accesslog) have more set:
+//
+//	-> {"version":1,"id":1,"command":"cache.drop","args":{"path":"/dir1","scope":"local"}}
+//	<- {"id":1,"code":0,"data":{}}
+//	<- {"id":2,"code":22,"error":"invalid scope"}
+const (
+	MOUNTPOINT_ADMIN_SOCKET_XATTR = "dingofs.admin.socket"
+	ADMIN_SOCKET_DIR              = "/var/run/dingofs"
+	ADMIN_PROTOCOL_VERSION        = 1
+	ADMIN_TIMEOUT                 = 5 * time.Second
+
+	ADMIN_COMMAND_STATS         = "stats"
+	ADMIN_COMMAND_CACHE_DROP    = "cache.drop"
+	ADMIN_COMMAND_ACCESSLOG     = "accesslog"
+	ADMIN_COMMAND_CONFIG_RELOAD = "config.reload"
+	ADMIN_COMMAND_SLOWLOG       = "slowlog"
+)
+
+// clients before the admin channel have no socket, callers fall back to xattrs and virtual files
+var ErrAdminSocketNotFound = errors.New("admin socket of client not found")
+
+type AdminRequest struct {
+	Version int               `json:"version"`
+	Id      uint64            `json:"id"`
+	Command string            `json:"command"`
+	Args    map[string]string `json:"args,omitempty"`
+}
+
+// code is an errno of the client, 0 is ok
+type AdminResponse struct {
+	Id    uint64          `json:"id"`
+	Code  int             `json:"code"`
+	Error string          `json:"error,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	More  bool            `json:"more,omitempty"`
+}
+
+type AdminClient struct {
+	socket string
+	conn   net.Conn
+	reader *bufio.Reader
+	nextId uint64
+}
+
+// socket path of the client serving the mountpoint
+func FindAdminSocket(mountpoint string) (string, error) {
+	if value, err := xattr.Get(mountpoint, MOUNTPOINT_ADMIN_SOCKET_XATTR); err == nil {
+		if socket := strings.TrimSpace(string(value)); isSocket(socket) {
+			return socket, nil
+		}
+	}
+	if pid, err := FindMountPointClientPid(mountpoint); err == nil {
+		socket := filepath.Join(ADMIN_SOCKET_DIR, fmt.Sprintf("%s.%d.sock", DINGOFS_CLIENT_PROCESS, pid))
+		if isSocket(socket) {
+			return socket, nil
+		}
+	}
+	return "", ErrAdminSocketNotFound
+}
+
+func isSocket(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode()&os.ModeSocket != 0
+}
+
+// path is any path in a dingofs mountpoint
+func DialAdmin(path string) (*AdminClient, error) {
+	mountpoint, err := FindDingoFSMountPoint(path)
+	if err != nil {
+		return nil, err
+	}
+	socket, err := FindAdminSocket(mountpoint.MountPoint)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.DialTimeout("unix", socket, ADMIN_TIMEOUT)
+	if err != nil {
+		return nil, fmt.Errorf("connect to admin socket %s failed: %v", socket, err)
+	}
+	return &AdminClient{socket: socket, conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+func (c *AdminClient) Socket() string {
+	return c.socket
+}
+
+func (c *AdminClient) Close() error {
+	return c.conn.Close()
+}
+
+// Call sends the command and decodes data of the answer into result, result can be nil
+func (c *AdminClient) Call(command string, args map[string]string, result any) error {
+	return c.Stream(command, args, 0, func(data json.RawMessage) error {
+		if result == nil || len(data) == 0 {
+			return nil
+		}
+		return json.Unmarshal(data, result)
+	})
+}
+
+// Stream sends the command and calls handle for every answer until the last one,
+// timeout is for every answer, 0 is ADMIN_TIMEOUT, negative waits forever
+func (c *AdminClient) Stream(command string, args map[string]string, timeout time.Duration, handle func(data json.RawMessage) error) error {
+	if timeout == 0 {
+		timeout = ADMIN_TIMEOUT
+	}
+	defer profile.Start(profile.PHASE_IO, "admin "+command)()
+	request := &AdminRequest{
+		Version: ADMIN_PROTOCOL_VERSION,
+		Id:      atomic.AddUint64(&c.nextId, 1),
+		Command: command,
+		Args:    args,
+	}
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	c.conn.SetWriteDeadline(time.Now().Add(ADMIN_TIMEOUT))
+	if _, err := c.conn.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("send %s to %s failed: %v", command, c.socket, err)
+	}
+
+	for {
+		if timeout > 0 {
+			c.conn.SetReadDeadline(time.Now().Add(timeout))
+		} else {
+			c.conn.SetReadDeadline(time.Time{})
+		}
+		line, err := c.reader.ReadBytes('\n')
+		if err != nil {
+			return fmt.Errorf("receive %s from %s failed: %v", command, c.socket, err)
+		}
+		response := &AdminResponse{}
+		if err := json.Unmarshal(line, response); err != nil {
+			return fmt.Errorf("invalid answer of %s from %s: %v", command, c.socket, err)
+		}
+		if response.Id != request.Id {
+			continue
+		}
+		if response.Code != 0 {
+			return fmt.Errorf("%s: %s (code %d)", command, response.Error, response.Code)
+		}
+		if err := handle(response.Data); err != nil {
+			return err
+		}
+		if !response.More {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/dingodb/dingocli/internal/profile"
+	"github.com/pkg/xattr"
+)
+
+// status of the dingo-client serving a local mountpoint, gathered without mds:
+//   - fsname from mount source (mds://addrs/fsname), fsid from xattr dingofs.fsid
+//   - pid, start time and executable of the client from /proc
+//   - health by statfs and xattr probes, a hung client never answers them
+const (
+	MOUNTPOINT_FSID_XATTR    = "dingofs.fsid"
+	MOUNTPOINT_PROBE_TIMEOUT = 2 * time.Second
+	DINGOFS_CLIENT_PROCESS   = "dingo-client"
+
+	MOUNTPOINT_HEALTH_OK           = "ok"
+	MOUNTPOINT_HEALTH_HUNG         = "hung"
+	MOUNTPOINT_HEALTH_DISCONNECTED = "disconnected"
+	MOUNTPOINT_HEALTH_ERROR        = "error"
+
+	// USER_HZ, which is 100 on all supported platforms
+	CLOCK_TICKS_PER_SECOND = 100
+)
+
+type MountPointClient struct {
+	MountPoint string    `json:"mountpoint"`
+	FsName     string    `json:"fs_name"`
+	FsId       string    `json:"fs_id"`
+	Version    string    `json:"version"`
+	Pid        int       `json:"pid"`
+	StartTime  time.Time `json:"start_time"`
+	Health     string    `json:"health"`
+	Error      string    `json:"error,omitempty"`
+}
+
+func (c *MountPointClient) Uptime() time.Duration {
+	if c.StartTime.IsZero() {
+		return 0
+	}
+	return time.Since(c.StartTime).Truncate(time.Second)
+}
+
+func GetMountPointClient(mountpoint *mountinfo.MountInfo) *MountPointClient {
+	client := &MountPointClient{
+		MountPoint: mountpoint.MountPoint,
+		FsName:     MountPointFsName(mountpoint),
+	}
+
+	client.Health, client.Error = ProbeMountPoint(mountpoint.MountPoint, MOUNTPOINT_PROBE_TIMEOUT)
+	if client.Health == MOUNTPOINT_HEALTH_OK {
+		if value, err := xattr.Get(mountpoint.MountPoint, MOUNTPOINT_FSID_XATTR); err == nil {
+			client.FsId = strings.TrimSpace(string(value))
+		}
+	}
+
+	if pid, err := FindMountPointClientPid(mountpoint.MountPoint); err == nil {
+		client.Pid = pid
+		client.StartTime, _ = GetProcessStartTime(pid)
+		client.Version = GetClientVersion(pid)
+	}
+	return client
+}
+
+// fsname is the last part of mount source, e.g. mds://10.0.0.1:7400/dingofs -> dingofs
+func MountPointFsName(mountpoint *mountinfo.MountInfo) string {
+	source := mountpoint.MountSource
+	if _, rest, ok := strings.Cut(source, "://"); ok {
+		source = rest
+	}
+	if index := strings.LastIndex(source, "/"); index != -1 {
+		source = source[index+1:]
+	}
+	return source
+}
+
+// statfs and getxattr are answered by client, xattr not supported or not exists is fine
+func ProbeMountPoint(mountpoint string, timeout time.Duration) (string, string) {
+	defer profile.Start(profile.PHASE_IO, "statfs "+mountpoint)()
+	done := make(chan error, 1)
+	go func() {
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(mountpoint, &stat); err != nil {
+			done <- err
+			return
+		}
+		_, err := xattr.Get(mountpoint, MOUNTPOINT_MDSADDR_XATTR)
+		if err != nil && !errors.Is(err, xattr.ENOATTR) && !errors.Is(err, syscall.ENOTSUP) {
+			done <- err
+			return
+		}
+		done <- nil
+	}()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			return MOUNTPOINT_HEALTH_OK, ""
+		} else if errors.Is(err, syscall.ENOTCONN) {
+			return MOUNTPOINT_HEALTH_DISCONNECTED, err.Error()
+		}
+		return MOUNTPOINT_HEALTH_ERROR, err.Error()
+	case <-time.After(timeout):
+		return MOUNTPOINT_HEALTH_HUNG, fmt.Sprintf("no response in %s", timeout)
+	}
+}
+
+// find dingo-client process whose arguments contain the mountpoint
+func FindMountPointClientPid(mountpoint string) (int, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return 0, err
+	}
+
+	mountpoint = filepath.Clean(mountpoint)
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		args, err := GetProcessArgs(pid)
+		if err != nil {
+			continue
+		}
+		if !strings.Contains(filepath.Base(args[0]), DINGOFS_CLIENT_PROCESS) {
+			continue
+		}
+		for _, arg := range args[1:] {
+			if filepath.IsAbs(arg) && filepath.Clean(arg) == mountpoint {
+				return pid, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no %s process found for mountpoint %s", DINGOFS_CLIENT_PROCESS, mountpoint)
+}
+
+// start time from /proc/<pid>/stat (field 22, in clock ticks since boot) and boot time in /proc/stat
+func GetProcessStartTime(pid int) (time.Time, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return time.Time{}, err
+	}
+	// command name in parentheses may contain spaces
+	index := bytes.LastIndexByte(data, ')')
+	if index == -1 {
+		return time.Time{}, fmt.Errorf("invalid stat of process %d", pid)
+	}
+	fields := strings.Fields(string(data[index+1:]))
+	if len(fields) < 20 {
+		return time.Time{}, fmt.Errorf("invalid stat of process %d", pid)
+	}
+	ticks, err := strconv.ParseInt(fields[19], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	stat, err := os.ReadFile("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, line := range strings.Split(string(stat), "\n") {
+		if value, ok := strings.CutPrefix(line, "btime "); ok {
+			btime, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.Unix(btime+ticks/CLOCK_TICKS_PER_SECOND, 0), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("boot time not found in /proc/stat")
+}
+
+// version of client installed by component manager: <root>/dingo-client/<version>/dingo-client,
+// empty if the client is not installed by component manager
+func GetClientVersion(pid int) string {
+	exe, err := os.Readlink(fmt.Sprintf("/proc/%d/exe", pid))
+	if err != nil {
+		return ""
+	}
+	exe = strings.TrimSuffix(exe, " (deleted)")
+	versionDir := filepath.Dir(exe)
+	if filepath.Base(filepath.Dir(versionDir)) != DINGOFS_CLIENT_PROCESS {
+		return ""
+	}
+	return filepath.Base(versionDir)
+}
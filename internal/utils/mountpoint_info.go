@@ -0,0 +1,217 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+	"github.com/pkg/xattr"
+)
+
+// details of a local mountpoint, gathered from mountinfo, xattrs of mountpoint and
+// arguments of the client process, so neither mds address nor fsname is required.
+// cache dirs are separated by ';' and may have a size suffix, e.g. /data1:10240;/data2
+const (
+	MOUNTPOINT_VERSION_XATTR  = "dingofs.version"
+	MOUNTPOINT_COMMIT_XATTR   = "dingofs.commit"
+	MOUNTPOINT_CACHEDIR_XATTR = "dingofs.cachedir"
+	CLIENT_CACHE_DIR_FLAG     = "--cache_dir"
+	CLIENT_LOG_DIR_FLAG       = "--log_dir"
+)
+
+type CacheDirUsage struct {
+	Dir       string `json:"dir"`
+	UsedBytes uint64 `json:"used_bytes"`
+	Files     uint64 `json:"files"`
+	DiskTotal uint64 `json:"disk_total"`
+	DiskFree  uint64 `json:"disk_free"`
+	Error     string `json:"error,omitempty"`
+}
+
+type MountPointInfo struct {
+	*MountPointClient
+	Commit       string           `json:"commit"`
+	MountOptions []string         `json:"mount_options"`
+	ClientArgs   []string         `json:"client_args"`
+	MDSAddrs     []string         `json:"mds_addrs"`
+	CacheDirs    []*CacheDirUsage `json:"cache_dirs"`
+}
+
+// the deepest dingofs mountpoint containing path
+func FindDingoFSMountPoint(path string) (*mountinfo.MountInfo, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	mountpoints, err := GetDingoFSMountPoints()
+	if err != nil {
+		return nil, err
+	}
+	var found *mountinfo.MountInfo
+	for _, mountpoint := range mountpoints {
+		mp := mountpoint.MountPoint
+		if absPath != mp && !strings.HasPrefix(absPath, strings.TrimSuffix(mp, "/")+"/") {
+			continue
+		}
+		if found == nil || len(mp) > len(found.MountPoint) {
+			found = mountpoint
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%s is not in a dingofs mountpoint", absPath)
+	}
+	return found, nil
+}
+
+func GetMountPointInfo(mountpoint *mountinfo.MountInfo) *MountPointInfo {
+	info := &MountPointInfo{
+		MountPointClient: GetMountPointClient(mountpoint),
+		MountOptions:     mountPointOptions(mountpoint),
+		ClientArgs:       []string{},
+		MDSAddrs:         []string{},
+		CacheDirs:        []*CacheDirUsage{},
+	}
+	if addrsStr := mountPointMDSAddr(mountpoint); addrsStr != "" {
+		info.MDSAddrs = strings.Split(addrsStr, ",")
+	}
+	if info.Pid > 0 {
+		if args, err := GetProcessArgs(info.Pid); err == nil {
+			info.ClientArgs = args[1:]
+		}
+	}
+
+	// xattrs are answered by client, never touch a hung mountpoint
+	cacheDirs := ""
+	if info.Health == MOUNTPOINT_HEALTH_OK {
+		if value, err := xattr.Get(mountpoint.MountPoint, MOUNTPOINT_VERSION_XATTR); err == nil {
+			info.Version = strings.TrimSpace(string(value))
+		}
+		if value, err := xattr.Get(mountpoint.MountPoint, MOUNTPOINT_COMMIT_XATTR); err == nil {
+			info.Commit = strings.TrimSpace(string(value))
+		}
+		if value, err := xattr.Get(mountpoint.MountPoint, MOUNTPOINT_CACHEDIR_XATTR); err == nil {
+			cacheDirs = strings.TrimSpace(string(value))
+		}
+	}
+	if cacheDirs == "" {
+		cacheDirs = clientFlagValue(info.ClientArgs, CLIENT_CACHE_DIR_FLAG)
+	}
+	for _, dir := range parseCacheDirs(cacheDirs) {
+		info.CacheDirs = append(info.CacheDirs, GetCacheDirUsage(dir))
+	}
+	return info
+}
+
+// log dir given to the client, empty if the client logs to its default dir
+func (info *MountPointInfo) LogDir() string {
+	return clientFlagValue(info.ClientArgs, CLIENT_LOG_DIR_FLAG)
+}
+
+// cache dirs of the client serving mountpoint, without usage of them
+func GetMountPointCacheDirs(mountpoint *mountinfo.MountInfo) []string {
+	health, _ := ProbeMountPoint(mountpoint.MountPoint, MOUNTPOINT_PROBE_TIMEOUT)
+	if health == MOUNTPOINT_HEALTH_OK {
+		if value, err := xattr.Get(mountpoint.MountPoint, MOUNTPOINT_CACHEDIR_XATTR); err == nil {
+			return parseCacheDirs(strings.TrimSpace(string(value)))
+		}
+	}
+	if pid, err := FindMountPointClientPid(mountpoint.MountPoint); err == nil {
+		if args, err := GetProcessArgs(pid); err == nil {
+			return parseCacheDirs(clientFlagValue(args[1:], CLIENT_CACHE_DIR_FLAG))
+		}
+	}
+	return []string{}
+}
+
+func parseCacheDirs(cacheDirs string) []string {
+	dirs := []string{}
+	for _, dir := range strings.Split(cacheDirs, ";") {
+		dir, _, _ = strings.Cut(strings.TrimSpace(dir), ":")
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// per mount and per superblock options, e.g. rw,nosuid,nodev,relatime,user_id=0,allow_other
+func mountPointOptions(mountpoint *mountinfo.MountInfo) []string {
+	options := []string{}
+	for _, option := range strings.Split(mountpoint.MountOptions+","+mountpoint.SuperOptions, ",") {
+		if option != "" && !Contains(options, option) {
+			options = append(options, option)
+		}
+	}
+	return options
+}
+
+// value of --flag=value or --flag value
+func clientFlagValue(args []string, flag string) string {
+	for i, arg := range args {
+		if value, ok := strings.CutPrefix(arg, flag+"="); ok {
+			return value
+		}
+		if arg == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+func GetProcessArgs(pid int) ([]string, error) {
+	cmdline, err := os.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return nil, err
+	}
+	if len(cmdline) == 0 {
+		return nil, fmt.Errorf("empty cmdline of process %d", pid)
+	}
+	return strings.Split(strings.TrimRight(string(cmdline), "\x00"), "\x00"), nil
+}
+
+// bytes and files under dir, and capacity of disk the dir is on
+func GetCacheDirUsage(dir string) *CacheDirUsage {
+	usage := &CacheDirUsage{Dir: dir}
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		usage.Error = err.Error()
+		return usage
+	}
+	usage.DiskTotal = stat.Blocks * uint64(stat.Bsize)
+	usage.DiskFree = stat.Bavail * uint64(stat.Bsize)
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return nil
+		}
+		if fileInfo, err := entry.Info(); err == nil {
+			usage.UsedBytes += uint64(fileInfo.Size())
+			usage.Files++
+		}
+		return nil
+	})
+	if err != nil {
+		usage.Error = err.Error()
+	}
+	return usage
+}
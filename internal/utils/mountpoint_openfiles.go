@@ -0,0 +1,162 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cilium/cilium/pkg/mountinfo"
+)
+
+// files opened under local mountpoints, found by fds and cwd of processes in /proc,
+// open time of a fd is not recorded by kernel, so start time of the process is used
+const (
+	OPEN_FILE_FD_CWD = "cwd"
+
+	OPEN_MODE_READ       = "r"
+	OPEN_MODE_WRITE      = "w"
+	OPEN_MODE_READ_WRITE = "rw"
+)
+
+type OpenFile struct {
+	MountPoint string    `json:"mountpoint"`
+	FsName     string    `json:"fs_name"`
+	ClientPid  int       `json:"client_pid"`
+	Pid        int       `json:"pid"`
+	Command    string    `json:"command"`
+	Fd         string    `json:"fd"`
+	Mode       string    `json:"mode"`
+	Path       string    `json:"path"`
+	StartTime  time.Time `json:"start_time"`
+}
+
+func (f *OpenFile) Age() time.Duration {
+	if f.StartTime.IsZero() {
+		return 0
+	}
+	return time.Since(f.StartTime).Truncate(time.Second)
+}
+
+func ListOpenFiles(mountpoints []*mountinfo.MountInfo) ([]*OpenFile, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	clientPids := map[string]int{}
+	for _, mountpoint := range mountpoints {
+		clientPids[mountpoint.MountPoint], _ = FindMountPointClientPid(mountpoint.MountPoint)
+	}
+
+	files := []*OpenFile{}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		// processes may exit or be unreadable without root
+		paths := map[string]string{}
+		if cwd, err := os.Readlink(fmt.Sprintf("/proc/%d/cwd", pid)); err == nil {
+			paths[OPEN_FILE_FD_CWD] = cwd
+		}
+		fds, _ := os.ReadDir(fmt.Sprintf("/proc/%d/fd", pid))
+		for _, fd := range fds {
+			if target, err := os.Readlink(fmt.Sprintf("/proc/%d/fd/%s", pid, fd.Name())); err == nil {
+				paths[fd.Name()] = target
+			}
+		}
+
+		var startTime time.Time
+		var command string
+		for fd, path := range paths {
+			mountpoint := findMountPointOfPath(path, mountpoints)
+			if mountpoint == nil {
+				continue
+			}
+			if startTime.IsZero() {
+				startTime, _ = GetProcessStartTime(pid)
+				command = processCommand(pid)
+			}
+			files = append(files, &OpenFile{
+				MountPoint: mountpoint.MountPoint,
+				FsName:     MountPointFsName(mountpoint),
+				ClientPid:  clientPids[mountpoint.MountPoint],
+				Pid:        pid,
+				Command:    command,
+				Fd:         fd,
+				Mode:       openFileMode(pid, fd),
+				Path:       Path2DingofsPath(path, mountpoint),
+				StartTime:  startTime,
+			})
+		}
+	}
+	return files, nil
+}
+
+func findMountPointOfPath(path string, mountpoints []*mountinfo.MountInfo) *mountinfo.MountInfo {
+	path = strings.TrimSuffix(path, " (deleted)")
+	for _, mountpoint := range mountpoints {
+		if path == mountpoint.MountPoint || strings.HasPrefix(path, filepath.Clean(mountpoint.MountPoint)+"/") {
+			return mountpoint
+		}
+	}
+	return nil
+}
+
+func processCommand(pid int) string {
+	comm, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", pid))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(comm))
+}
+
+// access mode from flags in /proc/<pid>/fdinfo/<fd>, in octal
+func openFileMode(pid int, fd string) string {
+	if fd == OPEN_FILE_FD_CWD {
+		return ""
+	}
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/fdinfo/%s", pid, fd))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		value, ok := strings.CutPrefix(line, "flags:")
+		if !ok {
+			continue
+		}
+		flags, err := strconv.ParseUint(strings.TrimSpace(value), 8, 32)
+		if err != nil {
+			return ""
+		}
+		switch flags & syscall.O_ACCMODE {
+		case syscall.O_WRONLY:
+			return OPEN_MODE_WRITE
+		case syscall.O_RDWR:
+			return OPEN_MODE_READ_WRITE
+		default:
+			return OPEN_MODE_READ
+		}
+	}
+	return ""
+}
@@ -0,0 +1,300 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dingodb/dingocli/proto/dingofs/proto/mds"
+)
+
+// backend object store of a filesystem, data blocks are stored under BLOCK_STORE_PREFIX
+const (
+	BLOCK_STORE_PREFIX = kBlockStoreDir + "/"
+
+	// max keys of a delete request, limited by s3 DeleteObjects
+	OBJECT_DELETE_BATCH = 1000
+
+	RADOS_BINARY  = "rados"
+	CEPH_BINARY   = "ceph"
+	CEPH_ARGS_ENV = "CEPH_ARGS" // extra arguments of rados and ceph
+
+	// output of rados stat, e.g. pool/key mtime 2026-10-16T10:20:01.000000+0800, size 4194304
+	RADOS_STAT_REGEX = `mtime (.+), size (\d+)`
+)
+
+var radosTimeLayouts = []string{
+	"2006-01-02T15:04:05.000000-0700",
+	"2006-01-02 15:04:05.000000",
+}
+
+type ObjectStore interface {
+	// e.g. http://endpoint/bucket, rados://cluster/pool
+	String() string
+	// call fn for every object whose key has the prefix, stop if fn returns error,
+	// mtime is zero if the store can not list it, see ObjectStater
+	List(prefix string, fn func(key string, size int64, mtime time.Time) error) error
+	// keys are deleted in batches
+	Delete(keys []string) error
+	Get(key string) ([]byte, error)
+	Put(key string, data []byte) error
+}
+
+// store which lists objects without mtime, e.g. rados
+type ObjectStater interface {
+	Stat(key string) (int64, time.Time, error)
+}
+
+func NewObjectStore(extra *mds.FsExtra) (ObjectStore, error) {
+	if s3Info := extra.GetS3Info(); s3Info != nil {
+		return NewS3Store(s3Info.GetEndpoint(), s3Info.GetBucketname(), s3Info.GetAk(), s3Info.GetSk()), nil
+	}
+	if radosInfo := extra.GetRadosInfo(); radosInfo != nil {
+		return NewRadosStore(radosInfo)
+	}
+	return nil, fmt.Errorf("unknown storage of filesystem")
+}
+
+// identity of the backend storage, block keys carry no fs id, so filesystems
+// with the same identity share objects under BLOCK_STORE_PREFIX
+func ObjectStoreId(extra *mds.FsExtra) string {
+	if s3Info := extra.GetS3Info(); s3Info != nil {
+		endpoint := strings.TrimSuffix(strings.ToLower(s3Info.GetEndpoint()), "/")
+		return fmt.Sprintf("s3://%s/%s", endpoint, s3Info.GetBucketname())
+	}
+	if radosInfo := extra.GetRadosInfo(); radosInfo != nil {
+		return fmt.Sprintf("rados://%s@%s/%s", radosInfo.GetClusterName(), radosInfo.GetMonHost(), radosInfo.GetPoolName())
+	}
+	return ""
+}
+
+// names of other filesystems which store blocks in the same bucket or pool as fsInfo
+func FsSharingObjectStore(fsInfos []*mds.FsInfo, fsInfo *mds.FsInfo) []string {
+	id := ObjectStoreId(fsInfo.GetExtra())
+	names := []string{}
+	for _, other := range fsInfos {
+		if other.GetFsId() == fsInfo.GetFsId() || id == "" {
+			continue
+		}
+		if ObjectStoreId(other.GetExtra()) == id {
+			names = append(names, other.GetFsName())
+		}
+	}
+	return names
+}
+
+// delete all objects with the prefix, returns number of deleted objects
+func PurgeObjects(store ObjectStore, prefix string, progress func(deleted int64)) (int64, error) {
+	deleted := int64(0)
+	batch := make([]string, 0, OBJECT_DELETE_BATCH)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := store.Delete(batch); err != nil {
+			return err
+		}
+		deleted += int64(len(batch))
+		batch = batch[:0]
+		if progress != nil {
+			progress(deleted)
+		}
+		return nil
+	}
+
+	err := store.List(prefix, func(key string, size int64, mtime time.Time) error {
+		batch = append(batch, key)
+		if len(batch) < OBJECT_DELETE_BATCH {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil {
+		err = flush()
+	}
+	return deleted, err
+}
+
+// rados store is accessed by rados command of ceph-common, so no librados is required
+type RadosStore struct {
+	info *mds.RadosInfo
+}
+
+func NewRadosStore(info *mds.RadosInfo) (*RadosStore, error) {
+	if _, err := exec.LookPath(RADOS_BINARY); err != nil {
+		return nil, fmt.Errorf("%s command not found, please install ceph-common", RADOS_BINARY)
+	}
+	return &RadosStore{info: info}, nil
+}
+
+func (s *RadosStore) String() string {
+	return fmt.Sprintf("rados://%s/%s", s.info.GetClusterName(), s.info.GetPoolName())
+}
+
+// error of rados or ceph command, stderr is kept to find the cause
+type RadosError struct {
+	Op     string
+	Err    error
+	Stderr string
+}
+
+func (e *RadosError) Error() string {
+	return fmt.Sprintf("rados %s failed: %v, %s", e.Op, e.Err, e.Stderr)
+}
+
+func (e *RadosError) Unwrap() error {
+	return e.Err
+}
+
+// arguments of cluster connection, which are also accepted by ceph command,
+// the key is passed by CEPH_ARGS, see command
+func (s *RadosStore) connectArgs() []string {
+	args := []string{"--id", s.info.GetUserName(), "-m", s.info.GetMonHost()}
+	if s.info.GetClusterName() != "" {
+		args = append(args, "--cluster", s.info.GetClusterName())
+	}
+	return args
+}
+
+// key is not in arguments of the command, which are visible to other users by ps
+func (s *RadosStore) command(binary string, args ...string) *exec.Cmd {
+	cmd := exec.Command(binary, args...)
+	cephArgs := strings.TrimSpace(os.Getenv(CEPH_ARGS_ENV) + " --key " + s.info.GetKey())
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", CEPH_ARGS_ENV, cephArgs))
+	return cmd
+}
+
+func (s *RadosStore) args(args ...string) []string {
+	base := append(s.connectArgs(), "-p", s.info.GetPoolName())
+	return append(base, args...)
+}
+
+func (s *RadosStore) run(args ...string) ([]byte, error) {
+	return s.runWithInput(nil, args...)
+}
+
+// input is written to stdin of rados, e.g. rados put KEY -
+func (s *RadosStore) runWithInput(input []byte, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := s.command(RADOS_BINARY, s.args(args...)...)
+	cmd.Stderr = &stderr
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, &RadosError{Op: args[0], Err: err, Stderr: strings.TrimSpace(stderr.String())}
+	}
+	return out, nil
+}
+
+// pools of the cluster, which also checks monitors and credential
+func (s *RadosStore) Pools(timeout time.Duration) ([]string, error) {
+	var stderr bytes.Buffer
+	args := append(s.connectArgs(), "--client_mount_timeout", fmt.Sprintf("%d", int(timeout.Seconds())), "lspools")
+	cmd := s.command(RADOS_BINARY, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, &RadosError{Op: "lspools", Err: err, Stderr: strings.TrimSpace(stderr.String())}
+	}
+	return strings.Fields(string(out)), nil
+}
+
+// output of ceph health, e.g. HEALTH_OK, HEALTH_WARN 1 pool(s) have no replicas configured
+func (s *RadosStore) Health(timeout time.Duration) (string, error) {
+	if _, err := exec.LookPath(CEPH_BINARY); err != nil {
+		return "", fmt.Errorf("%s command not found, please install ceph-common", CEPH_BINARY)
+	}
+	var stderr bytes.Buffer
+	args := append(s.connectArgs(), "--connect-timeout", fmt.Sprintf("%d", int(timeout.Seconds())), "health")
+	cmd := s.command(CEPH_BINARY, args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", &RadosError{Op: "health", Err: err, Stderr: strings.TrimSpace(stderr.String())}
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// rados ls does not report size and mtime, which are -1 and zero
+func (s *RadosStore) List(prefix string, fn func(key string, size int64, mtime time.Time) error) error {
+	out, err := s.run("ls")
+	if err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		key := scanner.Text()
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if err := fn(key, -1, time.Time{}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func (s *RadosStore) Stat(key string) (int64, time.Time, error) {
+	out, err := s.run("stat", key)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	matches := regexp.MustCompile(RADOS_STAT_REGEX).FindStringSubmatch(strings.TrimSpace(string(out)))
+	if matches == nil {
+		return 0, time.Time{}, fmt.Errorf("invalid output of rados stat %s: %s", key, strings.TrimSpace(string(out)))
+	}
+	size, _ := strconv.ParseInt(matches[2], 10, 64)
+	for _, layout := range radosTimeLayouts {
+		if mtime, err := time.ParseInLocation(layout, matches[1], time.Local); err == nil {
+			return size, mtime, nil
+		}
+	}
+	return 0, time.Time{}, fmt.Errorf("invalid mtime of rados stat %s: %s", key, matches[1])
+}
+
+func (s *RadosStore) Delete(keys []string) error {
+	for start := 0; start < len(keys); start += OBJECT_DELETE_BATCH {
+		end := start + OBJECT_DELETE_BATCH
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if _, err := s.run(append([]string{"rm"}, keys[start:end]...)...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "-" is stdout of rados get and stdin of rados put
+func (s *RadosStore) Get(key string) ([]byte, error) {
+	return s.run("get", key, "-")
+}
+
+func (s *RadosStore) Put(key string, data []byte) error {
+	_, err := s.runWithInput(data, "put", key, "-")
+	return err
+}
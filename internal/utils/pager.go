@@ -0,0 +1,69 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+// pager of long output, only for commands enabled by EnablePager:
+//
+//	global:
+//	  pager: less -FRX   # pager command, default is $PAGER or less; false disables pager
+const (
+	NO_PAGER              = "no-pager"
+	ANNOTATION_PAGER      = "pager"
+	DEFAULT_PAGER_COMMAND = "less"
+)
+
+// mark command whose output may be long, e.g. list commands
+func EnablePager(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[ANNOTATION_PAGER] = "true"
+}
+
+// get pager command of cmd, empty if pager is disabled or stdout is not a terminal
+func GetPagerCommand(cmd *cobra.Command) string {
+	if cmd.Annotations[ANNOTATION_PAGER] != "true" || !isatty.IsTerminal(os.Stdout.Fd()) {
+		return ""
+	}
+	if noPager, _ := cmd.Flags().GetBool(NO_PAGER); noPager {
+		return ""
+	}
+
+	pager := strings.TrimSpace(GetEarlyConfigString(cmd, VIPER_GLOBALE_PAGER))
+	if enabled, err := strconv.ParseBool(pager); err == nil {
+		if !enabled {
+			return ""
+		}
+		pager = ""
+	}
+	if pager == "" {
+		pager = os.Getenv("PAGER")
+	}
+	if pager == "" {
+		pager = DEFAULT_PAGER_COMMAND
+	}
+	return pager
+}
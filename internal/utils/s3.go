@@ -0,0 +1,356 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// minimal s3 client with path-style requests and signature v4,
+// which works with aws, minio, ceph rgw and most s3 compatible storages
+const (
+	S3_DEFAULT_REGION  = "us-east-1"
+	S3_REQUEST_TIMEOUT = 60 * time.Second
+
+	s3DateFormat     = "20060102"
+	s3DateTimeFormat = "20060102T150405Z"
+	s3SignAlgorithm  = "AWS4-HMAC-SHA256"
+)
+
+type S3Store struct {
+	endpoint string
+	bucket   string
+	ak       string
+	sk       string
+	region   string
+	client   *http.Client
+}
+
+type s3ListResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+type s3DeleteRequest struct {
+	XMLName xml.Name `xml:"Delete"`
+	Quiet   bool     `xml:"Quiet"`
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+type s3DeleteResult struct {
+	Errors []struct {
+		Key     string `xml:"Key"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+}
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+	// expected region of bucket, e.g. in AuthorizationHeaderMalformed of aws
+	Region string `xml:"Region"`
+}
+
+// error response of s3, code is empty if the response has no error body, e.g. HEAD
+type S3Error struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Status     string
+	Code       string
+	Message    string
+	Region     string
+}
+
+func (e *S3Error) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("s3 %s %s failed: %s %s", e.Method, e.Path, e.Code, e.Message)
+	}
+	return fmt.Sprintf("s3 %s %s failed: %s", e.Method, e.Path, e.Status)
+}
+
+// endpoint without scheme is http, e.g. 10.0.0.1:9000
+func NewS3Store(endpoint, bucket, ak, sk string) *S3Store {
+	endpoint = strings.TrimRight(endpoint, "/")
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	return &S3Store{
+		endpoint: endpoint,
+		bucket:   bucket,
+		ak:       ak,
+		sk:       sk,
+		region:   S3_DEFAULT_REGION,
+		client:   &http.Client{Timeout: S3_REQUEST_TIMEOUT},
+	}
+}
+
+func (s *S3Store) String() string {
+	return fmt.Sprintf("%s/%s", s.endpoint, s.bucket)
+}
+
+func (s *S3Store) List(prefix string, fn func(key string, size int64, mtime time.Time) error) error {
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		body, err := s.do(http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var result s3ListResult
+		if err := xml.Unmarshal(body, &result); err != nil {
+			return fmt.Errorf("parse list result of bucket %s failed: %v", s.bucket, err)
+		}
+		for _, object := range result.Contents {
+			if err := fn(object.Key, object.Size, object.LastModified); err != nil {
+				return err
+			}
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *S3Store) Delete(keys []string) error {
+	for start := 0; start < len(keys); start += OBJECT_DELETE_BATCH {
+		end := start + OBJECT_DELETE_BATCH
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		request := s3DeleteRequest{Quiet: true}
+		for _, key := range keys[start:end] {
+			request.Objects = append(request.Objects, struct {
+				Key string `xml:"Key"`
+			}{Key: key})
+		}
+		payload, err := xml.Marshal(request)
+		if err != nil {
+			return err
+		}
+		sum := md5.Sum(payload)
+		headers := map[string]string{
+			"Content-MD5":  base64.StdEncoding.EncodeToString(sum[:]),
+			"Content-Type": "application/xml",
+		}
+		body, err := s.do(http.MethodPost, "", url.Values{"delete": {""}}, headers, payload)
+		if err != nil {
+			return err
+		}
+
+		var result s3DeleteResult
+		if err := xml.Unmarshal(body, &result); err != nil {
+			return fmt.Errorf("parse delete result of bucket %s failed: %v", s.bucket, err)
+		}
+		if len(result.Errors) > 0 {
+			e := result.Errors[0]
+			return fmt.Errorf("delete %d objects failed, e.g. %s: %s %s", len(result.Errors), e.Key, e.Code, e.Message)
+		}
+	}
+	return nil
+}
+
+// bucket owned by the same user already is not an error
+func (s *S3Store) CreateBucket() error {
+	_, err := s.do(http.MethodPut, "", nil, nil, nil)
+	var s3err *S3Error
+	if errors.As(err, &s3err) && s3err.Code == "BucketAlreadyOwnedByYou" {
+		return nil
+	}
+	return err
+}
+
+func (s *S3Store) Get(key string) ([]byte, error) {
+	return s.do(http.MethodGet, key, nil, nil, nil)
+}
+
+func (s *S3Store) Put(key string, data []byte) error {
+	_, err := s.do(http.MethodPut, key, nil, nil, data)
+	return err
+}
+
+// size and etag of object, etag is md5 of content unless the object is uploaded by multipart
+func (s *S3Store) Head(key string) (int64, string, error) {
+	_, response, err := s.doWithResponse(http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return 0, "", err
+	}
+	return response.ContentLength, strings.Trim(response.Header.Get("ETag"), `"`), nil
+}
+
+func (s *S3Store) do(method, key string, query url.Values, headers map[string]string, payload []byte) ([]byte, error) {
+	body, _, err := s.doWithResponse(method, key, query, headers, payload)
+	return body, err
+}
+
+// body is read and closed, headers of the response are still available
+func (s *S3Store) doWithResponse(method, key string, query url.Values, headers map[string]string, payload []byte) ([]byte, *http.Response, error) {
+	path := "/" + s.bucket
+	if key != "" {
+		path += "/" + key
+	}
+	rawURL := s.endpoint + s3EscapePath(path)
+	if len(query) > 0 {
+		rawURL += "?" + s3CanonicalQuery(query)
+	}
+	request, err := http.NewRequest(method, rawURL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, value := range headers {
+		request.Header.Set(name, value)
+	}
+	s.sign(request, payload, time.Now().UTC())
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.StatusCode/100 != 2 {
+		s3err := &S3Error{Method: method, Path: path, StatusCode: response.StatusCode, Status: response.Status}
+		var e s3Error
+		if xml.Unmarshal(body, &e) == nil && e.Code != "" {
+			s3err.Code, s3err.Message, s3err.Region = e.Code, e.Message, e.Region
+		}
+		return nil, nil, s3err
+	}
+	return body, response, nil
+}
+
+// signature version 4, see https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *S3Store) sign(request *http.Request, payload []byte, now time.Time) {
+	payloadHash := sha256Hex(payload)
+	amzDate := now.Format(s3DateTimeFormat)
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	names := []string{"host"}
+	values := map[string]string{"host": request.URL.Host}
+	for name := range request.Header {
+		lower := strings.ToLower(name)
+		names = append(names, lower)
+		values[lower] = strings.TrimSpace(request.Header.Get(name))
+	}
+	sort.Strings(names)
+	canonicalHeaders := ""
+	for _, name := range names {
+		canonicalHeaders += name + ":" + values[name] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", now.Format(s3DateFormat), s.region)
+	stringToSign := strings.Join([]string{s3SignAlgorithm, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.sk), now.Format(s3DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SignAlgorithm, s.ak, scope, signedHeaders, signature))
+}
+
+// query sorted by key and escaped as rfc 3986, which is also the canonical query of signature
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := []string{}
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, s3Escape(key, true)+"="+s3Escape(value, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+func s3EscapePath(path string) string {
+	return s3Escape(path, false)
+}
+
+// escape all bytes except unreserved characters, and '/' if escapeSlash is false
+func s3Escape(s string, escapeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !escapeSlash) {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
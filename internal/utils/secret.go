@@ -0,0 +1,213 @@
+/*
+ * 	Copyright (c) 2025 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	crand "crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// sensitive values (s3.sk, rados.key...) can be stored as "enc:<base64>" in
+// configuration file, they are encrypted by AES-256-GCM with the key from:
+// environment variables(DINGO_SECRET=<passphrase>) > key file(DINGO_SECRET_FILE or $HOME/.dingo/secret.key)
+// the key of passphrase is derived by scrypt with a random salt stored in the value, "enc:scrypt:<base64>"
+const (
+	SECRET_PREFIX            = "enc:"
+	SECRET_PASSPHRASE_PREFIX = SECRET_PREFIX + "scrypt:"
+	ENV_DINGO_SECRET         = "DINGO_SECRET"
+	ENV_DINGO_SECRET_FILE    = "DINGO_SECRET_FILE"
+	DEFAULT_SECRET_KEYFILE   = "secret.key"
+	SECRET_KEY_SIZE          = 32
+	SECRET_SALT_SIZE         = 16
+
+	// recommended scrypt parameters for interactive use
+	SCRYPT_N = 1 << 15
+	SCRYPT_R = 8
+	SCRYPT_P = 1
+)
+
+// key of secrets, either the key of key file or a passphrase
+type SecretKey struct {
+	key        []byte
+	passphrase string
+}
+
+func NewSecretKey(key []byte) *SecretKey {
+	return &SecretKey{key: key}
+}
+
+func NewPassphraseSecretKey(passphrase string) *SecretKey {
+	return &SecretKey{passphrase: passphrase}
+}
+
+func IsEncryptedSecret(value string) bool {
+	return strings.HasPrefix(value, SECRET_PREFIX)
+}
+
+func GetSecretKeyFile() string {
+	if value := os.Getenv(ENV_DINGO_SECRET_FILE); value != "" {
+		return value
+	}
+	return filepath.Join(GetCurrentHomeDir(), ".dingo", DEFAULT_SECRET_KEYFILE)
+}
+
+func LoadSecretKey() (*SecretKey, error) {
+	if passphrase := os.Getenv(ENV_DINGO_SECRET); passphrase != "" {
+		return NewPassphraseSecretKey(passphrase), nil
+	}
+
+	keyFile := GetSecretKeyFile()
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load secret key failed, set %s or create key file %s: %v",
+			ENV_DINGO_SECRET, keyFile, err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != SECRET_KEY_SIZE {
+		return nil, fmt.Errorf("invalid secret key file %s, expect %d bytes hex string", keyFile, SECRET_KEY_SIZE)
+	}
+	return NewSecretKey(key), nil
+}
+
+// generate a random key file, existing key file is never overwritten
+func GenerateSecretKeyFile(keyFile string) error {
+	if IsFileExists(keyFile) {
+		return fmt.Errorf("secret key file %s already exists", keyFile)
+	}
+
+	key := make([]byte, SECRET_KEY_SIZE)
+	if _, err := io.ReadFull(crand.Reader, key); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(keyFile, []byte(hex.EncodeToString(key)+"\n"), 0600)
+}
+
+func deriveSecretKey(passphrase string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), salt, SCRYPT_N, SCRYPT_R, SCRYPT_P, SECRET_KEY_SIZE)
+}
+
+// value of passphrase is sealed as salt + nonce + ciphertext, of key file as nonce + ciphertext
+func EncryptSecret(plaintext string, key *SecretKey) (string, error) {
+	prefix, salt, aesKey := SECRET_PREFIX, []byte{}, key.key
+	if key.passphrase != "" {
+		salt = make([]byte, SECRET_SALT_SIZE)
+		if _, err := io.ReadFull(crand.Reader, salt); err != nil {
+			return "", err
+		}
+		derived, err := deriveSecretKey(key.passphrase, salt)
+		if err != nil {
+			return "", err
+		}
+		prefix, aesKey = SECRET_PASSPHRASE_PREFIX, derived
+	}
+
+	gcm, err := newSecretCipher(aesKey)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(crand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(append(salt, nonce...), nonce, []byte(plaintext), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func DecryptSecret(value string, key *SecretKey) (string, error) {
+	if !IsEncryptedSecret(value) {
+		return value, nil
+	}
+
+	byPassphrase := strings.HasPrefix(value, SECRET_PASSPHRASE_PREFIX)
+	if byPassphrase && key.passphrase == "" {
+		return "", fmt.Errorf("value is encrypted with passphrase, set %s", ENV_DINGO_SECRET)
+	} else if !byPassphrase && key.passphrase != "" {
+		return "", fmt.Errorf("value is encrypted with key file, unset %s", ENV_DINGO_SECRET)
+	}
+
+	encoded := strings.TrimPrefix(value, SECRET_PREFIX)
+	if byPassphrase {
+		encoded = strings.TrimPrefix(value, SECRET_PASSPHRASE_PREFIX)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("invalid encrypted value: %v", err)
+	}
+
+	aesKey := key.key
+	if byPassphrase {
+		if len(sealed) < SECRET_SALT_SIZE {
+			return "", fmt.Errorf("invalid encrypted value: too short")
+		}
+		if aesKey, err = deriveSecretKey(key.passphrase, sealed[:SECRET_SALT_SIZE]); err != nil {
+			return "", err
+		}
+		sealed = sealed[SECRET_SALT_SIZE:]
+	}
+
+	gcm, err := newSecretCipher(aesKey)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted value: too short")
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt value failed, maybe wrong secret key: %v", err)
+	}
+	return string(plaintext), nil
+}
+
+// decrypt "enc:" value with the configured key, plain value is returned as is
+func ResolveSecret(value string) (string, error) {
+	if !IsEncryptedSecret(value) {
+		return value, nil
+	}
+
+	key, err := LoadSecretKey()
+	if err != nil {
+		return "", err
+	}
+	return DecryptSecret(value, key)
+}
+
+func newSecretCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
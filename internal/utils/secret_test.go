@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptSecret(t *testing.T) {
+	assert := assert.New(t)
+	key := NewSecretKey(make([]byte, SECRET_KEY_SIZE))
+
+	encrypted, err := EncryptSecret("my-secret-key", key)
+	assert.NoError(err)
+	assert.True(IsEncryptedSecret(encrypted))
+
+	plaintext, err := DecryptSecret(encrypted, key)
+	assert.NoError(err)
+	assert.Equal("my-secret-key", plaintext)
+
+	// plain value is returned as is
+	plaintext, err = DecryptSecret("plain", key)
+	assert.NoError(err)
+	assert.Equal("plain", plaintext)
+
+	// wrong key
+	wrongKey := make([]byte, SECRET_KEY_SIZE)
+	wrongKey[0] = 1
+	_, err = DecryptSecret(encrypted, NewSecretKey(wrongKey))
+	assert.Error(err)
+
+	// value of key file can not be decrypted by passphrase
+	_, err = DecryptSecret(encrypted, NewPassphraseSecretKey("passphrase"))
+	assert.Error(err)
+}
+
+func TestEncryptSecretByPassphrase(t *testing.T) {
+	assert := assert.New(t)
+	key := NewPassphraseSecretKey("passphrase")
+
+	encrypted, err := EncryptSecret("my-secret-key", key)
+	assert.NoError(err)
+	assert.True(strings.HasPrefix(encrypted, SECRET_PASSPHRASE_PREFIX))
+
+	plaintext, err := DecryptSecret(encrypted, key)
+	assert.NoError(err)
+	assert.Equal("my-secret-key", plaintext)
+
+	// salt is random, the same plaintext is encrypted differently
+	again, err := EncryptSecret("my-secret-key", key)
+	assert.NoError(err)
+	assert.NotEqual(encrypted, again)
+
+	_, err = DecryptSecret(encrypted, NewPassphraseSecretKey("wrong"))
+	assert.Error(err)
+	_, err = DecryptSecret(encrypted, NewSecretKey(make([]byte, SECRET_KEY_SIZE)))
+	assert.Error(err)
+}
@@ -0,0 +1,312 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// probe of an object store by list/put/get/delete of a tiny object outside of BLOCK_STORE_PREFIX,
+// so the storage is checked before it is used by a filesystem
+const (
+	STORAGE_PROBE_PREFIX = "dingocli-probe/"
+	// timeout of connecting to rados cluster, which is 300s by default of ceph
+	RADOS_PROBE_TIMEOUT = 30 * time.Second
+
+	PROBE_STEP_CONNECT = "connect"
+	PROBE_STEP_POOL    = "pool"
+	PROBE_STEP_HEALTH  = "health"
+	PROBE_STEP_LIST    = "list"
+	PROBE_STEP_PUT     = "put"
+	PROBE_STEP_GET     = "get"
+	PROBE_STEP_DELETE  = "delete"
+
+	PROBE_STATUS_OK      = "ok"
+	PROBE_STATUS_WARN    = "warn"
+	PROBE_STATUS_FAILED  = "failed"
+	PROBE_STATUS_SKIPPED = "skipped"
+
+	PROBE_CAUSE_DNS        = "dns"
+	PROBE_CAUSE_TLS        = "tls"
+	PROBE_CAUSE_NETWORK    = "network"
+	PROBE_CAUSE_TIMEOUT    = "timeout"
+	PROBE_CAUSE_AUTH       = "auth"
+	PROBE_CAUSE_CLOCK      = "clock"
+	PROBE_CAUSE_REGION     = "region"
+	PROBE_CAUSE_BUCKET     = "bucket"
+	PROBE_CAUSE_POOL       = "pool"
+	PROBE_CAUSE_PERMISSION = "permission"
+	PROBE_CAUSE_DATA       = "data"
+	PROBE_CAUSE_UNKNOWN    = "unknown"
+
+	RADOS_HEALTH_OK = "HEALTH_OK"
+)
+
+var s3ProbeHints = map[string]string{
+	PROBE_CAUSE_DNS:        "endpoint host can not be resolved, check s3.endpoint and dns of this host",
+	PROBE_CAUSE_TLS:        "tls handshake failed, check scheme of s3.endpoint (http or https) and certificate of the server",
+	PROBE_CAUSE_NETWORK:    "endpoint is not reachable, check s3.endpoint and firewall",
+	PROBE_CAUSE_TIMEOUT:    "request timed out, check network between this host and the endpoint",
+	PROBE_CAUSE_AUTH:       "credential is rejected, check s3.ak and s3.sk",
+	PROBE_CAUSE_CLOCK:      "clock of this host differs too much from the server, check ntp",
+	PROBE_CAUSE_REGION:     "bucket is in another region or endpoint",
+	PROBE_CAUSE_BUCKET:     "bucket does not exist or name is invalid, check s3.bucketname",
+	PROBE_CAUSE_PERMISSION: "credential is valid but has no permission of the operation on the bucket",
+	PROBE_CAUSE_DATA:       "data read back differs from data written",
+}
+
+var radosProbeHints = map[string]string{
+	PROBE_CAUSE_NETWORK:    "monitors are not reachable, check rados.mon and firewall",
+	PROBE_CAUSE_TIMEOUT:    "connecting to monitors timed out, check rados.mon and rados.clustername",
+	PROBE_CAUSE_AUTH:       "credential is rejected, check rados.username and rados.key",
+	PROBE_CAUSE_CLOCK:      "clock of this host differs too much from monitors, check ntp",
+	PROBE_CAUSE_POOL:       "pool does not exist, check rados.poolname or create the pool",
+	PROBE_CAUSE_PERMISSION: "user has no permission of the operation on the pool, check caps of rados.username",
+	PROBE_CAUSE_DATA:       "data read back differs from data written",
+}
+
+type ProbeStep struct {
+	Step    string        `json:"step"`
+	Status  string        `json:"status"`
+	Latency time.Duration `json:"latency"`
+	Cause   string        `json:"cause,omitempty"`
+	Hint    string        `json:"hint,omitempty"`
+	Error   string        `json:"error,omitempty"`
+	// e.g. health of rados cluster
+	Detail string `json:"detail,omitempty"`
+}
+
+// get and delete are skipped if put fails, so no object is left when nothing is written
+func ProbeObjectStore(store ObjectStore) []*ProbeStep {
+	hints := s3ProbeHints
+	if _, ok := store.(*RadosStore); ok {
+		hints = radosProbeHints
+	}
+	hostname, _ := os.Hostname()
+	key := fmt.Sprintf("%s%s-%d", STORAGE_PROBE_PREFIX, hostname, time.Now().UnixNano())
+	data := []byte(fmt.Sprintf("dingocli probe of %s at %s", hostname, time.Now().Format(time.RFC3339)))
+
+	steps := []*ProbeStep{}
+	run := func(step string, fn func() error) bool {
+		start := time.Now()
+		err := fn()
+		steps = append(steps, newProbeStep(step, time.Since(start), err, hints))
+		return err == nil
+	}
+
+	run(PROBE_STEP_LIST, func() error {
+		return store.List(STORAGE_PROBE_PREFIX, func(key string, size int64, mtime time.Time) error {
+			return nil
+		})
+	})
+	if !run(PROBE_STEP_PUT, func() error { return store.Put(key, data) }) {
+		return append(steps, skipProbeSteps(PROBE_STEP_GET, PROBE_STEP_DELETE)...)
+	}
+	run(PROBE_STEP_GET, func() error {
+		got, err := store.Get(key)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(got, data) {
+			return &probeDataError{key: key, expected: len(data), got: len(got)}
+		}
+		return nil
+	})
+	run(PROBE_STEP_DELETE, func() error { return store.Delete([]string{key}) })
+	return steps
+}
+
+// connect to monitors and check the pool before objects are probed, health of cluster is
+// a warning only, as a degraded cluster still serves io
+func ProbeRadosStore(store *RadosStore) []*ProbeStep {
+	objectSteps := []string{PROBE_STEP_LIST, PROBE_STEP_PUT, PROBE_STEP_GET, PROBE_STEP_DELETE}
+
+	start := time.Now()
+	pools, err := store.Pools(RADOS_PROBE_TIMEOUT)
+	steps := []*ProbeStep{newProbeStep(PROBE_STEP_CONNECT, time.Since(start), err, radosProbeHints)}
+	if err != nil {
+		steps = append(steps, skipProbeSteps(PROBE_STEP_POOL, PROBE_STEP_HEALTH)...)
+		return append(steps, skipProbeSteps(objectSteps...)...)
+	}
+
+	start = time.Now()
+	health, err := store.Health(RADOS_PROBE_TIMEOUT)
+	step := newProbeStep(PROBE_STEP_HEALTH, time.Since(start), err, radosProbeHints)
+	if err == nil {
+		step.Detail = health
+		if !strings.HasPrefix(health, RADOS_HEALTH_OK) {
+			step.Status = PROBE_STATUS_WARN
+		}
+	} else {
+		step.Status = PROBE_STATUS_WARN
+	}
+
+	poolStep := &ProbeStep{Step: PROBE_STEP_POOL, Status: PROBE_STATUS_OK, Detail: store.info.GetPoolName()}
+	if !slices.Contains(pools, store.info.GetPoolName()) {
+		poolStep = newProbeStep(PROBE_STEP_POOL, 0, fmt.Errorf("pool %s not found in %d pools of cluster",
+			store.info.GetPoolName(), len(pools)), radosProbeHints)
+		poolStep.Cause, poolStep.Hint = PROBE_CAUSE_POOL, radosProbeHints[PROBE_CAUSE_POOL]
+		steps = append(steps, poolStep, step)
+		return append(steps, skipProbeSteps(objectSteps...)...)
+	}
+	steps = append(steps, poolStep, step)
+	return append(steps, ProbeObjectStore(store)...)
+}
+
+func skipProbeSteps(names ...string) []*ProbeStep {
+	steps := []*ProbeStep{}
+	for _, name := range names {
+		steps = append(steps, &ProbeStep{Step: name, Status: PROBE_STATUS_SKIPPED})
+	}
+	return steps
+}
+
+func ProbeFailed(steps []*ProbeStep) bool {
+	for _, step := range steps {
+		if step.Status == PROBE_STATUS_FAILED {
+			return true
+		}
+	}
+	return false
+}
+
+func newProbeStep(name string, latency time.Duration, err error, hints map[string]string) *ProbeStep {
+	step := &ProbeStep{Step: name, Status: PROBE_STATUS_OK, Latency: latency}
+	if err == nil {
+		return step
+	}
+	step.Status = PROBE_STATUS_FAILED
+	step.Error = err.Error()
+	step.Cause = ProbeErrorCause(err)
+	step.Hint = hints[step.Cause]
+	var s3err *S3Error
+	if step.Cause == PROBE_CAUSE_REGION && errors.As(err, &s3err) && s3err.Region != "" {
+		step.Hint = fmt.Sprintf("bucket is in region %s, use the endpoint of that region", s3err.Region)
+	}
+	return step
+}
+
+type probeDataError struct {
+	key      string
+	expected int
+	got      int
+}
+
+func (e *probeDataError) Error() string {
+	return fmt.Sprintf("data of %s mismatch, %d bytes written but %d bytes read", e.key, e.expected, e.got)
+}
+
+// cause of a failed request, network errors are checked before error responses of s3
+func ProbeErrorCause(err error) string {
+	var dnsErr *net.DNSError
+	var hostnameErr x509.HostnameError
+	var authorityErr x509.UnknownAuthorityError
+	var certErr x509.CertificateInvalidError
+	var verifyErr *tls.CertificateVerificationError
+	var recordErr tls.RecordHeaderError
+	var netErr net.Error
+	var s3err *S3Error
+	var radosErr *RadosError
+	var dataErr *probeDataError
+
+	switch {
+	case errors.As(err, &dataErr):
+		return PROBE_CAUSE_DATA
+	case errors.As(err, &dnsErr):
+		return PROBE_CAUSE_DNS
+	case errors.As(err, &hostnameErr), errors.As(err, &authorityErr), errors.As(err, &certErr),
+		errors.As(err, &verifyErr), errors.As(err, &recordErr):
+		return PROBE_CAUSE_TLS
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return PROBE_CAUSE_TIMEOUT
+	case errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.EHOSTUNREACH),
+		errors.Is(err, syscall.ENETUNREACH), errors.Is(err, syscall.ECONNRESET):
+		return PROBE_CAUSE_NETWORK
+	case errors.As(err, &s3err):
+		return s3ErrorCause(s3err)
+	case errors.As(err, &radosErr):
+		return radosErrorCause(radosErr)
+	}
+	return PROBE_CAUSE_UNKNOWN
+}
+
+// see https://docs.aws.amazon.com/AmazonS3/latest/API/ErrorResponses.html
+func s3ErrorCause(e *S3Error) string {
+	switch e.Code {
+	case "InvalidAccessKeyId", "SignatureDoesNotMatch", "InvalidToken", "ExpiredToken",
+		"InvalidSecurity":
+		return PROBE_CAUSE_AUTH
+	case "RequestTimeTooSkewed":
+		return PROBE_CAUSE_CLOCK
+	case "AuthorizationHeaderMalformed", "PermanentRedirect", "IllegalLocationConstraintException",
+		"InvalidRegion", "AuthorizationQueryParametersError":
+		return PROBE_CAUSE_REGION
+	case "NoSuchBucket", "InvalidBucketName":
+		return PROBE_CAUSE_BUCKET
+	case "AccessDenied", "AllAccessDisabled":
+		return PROBE_CAUSE_PERMISSION
+	case "":
+		// no error body, e.g. response of HEAD
+		switch e.StatusCode {
+		case http.StatusUnauthorized:
+			return PROBE_CAUSE_AUTH
+		case http.StatusForbidden:
+			return PROBE_CAUSE_PERMISSION
+		case http.StatusMovedPermanently:
+			return PROBE_CAUSE_REGION
+		case http.StatusNotFound:
+			return PROBE_CAUSE_BUCKET
+		}
+	}
+	return PROBE_CAUSE_UNKNOWN
+}
+
+// rados and ceph report errno and message in stderr, e.g. (13) Permission denied
+func radosErrorCause(e *RadosError) string {
+	stderr := strings.ToLower(e.Stderr)
+	switch {
+	case strings.Contains(stderr, "timed out"):
+		return PROBE_CAUSE_TIMEOUT
+	case strings.Contains(stderr, "clock skew"):
+		return PROBE_CAUSE_CLOCK
+	case strings.Contains(stderr, "auth") || strings.Contains(stderr, "(1) operation not permitted"):
+		return PROBE_CAUSE_AUTH
+	case strings.Contains(stderr, "permission denied"):
+		// denied when connecting is rejected credential, otherwise missing caps on the pool
+		if e.Op == "lspools" || e.Op == "health" {
+			return PROBE_CAUSE_AUTH
+		}
+		return PROBE_CAUSE_PERMISSION
+	case strings.Contains(stderr, "error opening pool") || strings.Contains(stderr, "no such file or directory"):
+		return PROBE_CAUSE_POOL
+	case strings.Contains(stderr, "connection refused") || strings.Contains(stderr, "no route to host") ||
+		strings.Contains(stderr, "error connecting to the cluster"):
+		return PROBE_CAUSE_NETWORK
+	}
+	return PROBE_CAUSE_UNKNOWN
+}
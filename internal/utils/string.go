@@ -74,17 +74,6 @@ func prompt(prompt string) string {
 	return strings.TrimSuffix(input, "\n")
 }
 
-func AskConfirmation(promptStr string, confirm string) bool {
-	promptStr = promptStr + fmt.Sprintf("\nplease input [%s] to confirm:", confirm)
-	ans := prompt(promptStr)
-	switch strings.TrimSpace(ans) {
-	case confirm:
-		return true
-	default:
-		return false
-	}
-}
-
 func IsValidPath(path string) bool {
 	match, _ := regexp.MatchString(PATH_REGEX, path)
 	return match
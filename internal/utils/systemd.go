@@ -0,0 +1,50 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"net"
+	"os"
+)
+
+// notify systemd of service state by $NOTIFY_SOCKET, e.g. READY=1 or WATCHDOG=1,
+// so long-running commands can be services of Type=notify with WatchdogSec
+const (
+	SD_NOTIFY_SOCKET   = "NOTIFY_SOCKET"
+	SD_NOTIFY_READY    = "READY=1"
+	SD_NOTIFY_WATCHDOG = "WATCHDOG=1"
+	SD_NOTIFY_STOPPING = "STOPPING=1"
+)
+
+// nothing is sent if not run by systemd
+func SdNotify(state string) error {
+	socket := os.Getenv(SD_NOTIFY_SOCKET)
+	if socket == "" {
+		return nil
+	}
+	// abstract socket
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte(state))
+	return err
+}
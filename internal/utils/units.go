@@ -0,0 +1,162 @@
+/*
+ * 	Copyright (c) 2026 dingodb.com Inc.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+// size:
+//
+//	4096, 4KiB, 4 KiB, 4k, 4K  -> binary units (1024)
+//	4KB, 4 kb                  -> decimal units (1000)
+//	1.5GiB                     -> fraction is allowed
+//
+// duration:
+//
+//	30s, 1.5h, 1h30m, 500ms    -> go syntax
+//	30                         -> seconds
+//	7d, 1d12h                  -> days
+const (
+	SIZE_REGEX     = `^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)$`
+	DURATION_REGEX = `^([0-9]+(?:\.[0-9]+)?)d(.*)$`
+
+	KiB = uint64(1) << 10
+	MiB = uint64(1) << 20
+	GiB = uint64(1) << 30
+
+	// range of filesystem block size and chunk size
+	MIN_BLOCKSIZE = 64 * KiB
+	MAX_BLOCKSIZE = 64 * MiB
+	MAX_CHUNKSIZE = 1 * GiB
+)
+
+var (
+	sizeUnits = map[string]uint64{
+		"":  1,
+		"b": 1,
+		"k": 1 << 10, "ki": 1 << 10, "kib": 1 << 10, "kb": 1000,
+		"m": 1 << 20, "mi": 1 << 20, "mib": 1 << 20, "mb": 1000 * 1000,
+		"g": 1 << 30, "gi": 1 << 30, "gib": 1 << 30, "gb": 1000 * 1000 * 1000,
+		"t": 1 << 40, "ti": 1 << 40, "tib": 1 << 40, "tb": 1000 * 1000 * 1000 * 1000,
+		"p": 1 << 50, "pi": 1 << 50, "pib": 1 << 50, "pb": 1000 * 1000 * 1000 * 1000 * 1000,
+	}
+)
+
+func ParseSize(value string) (uint64, error) {
+	matches := regexp.MustCompile(SIZE_REGEX).FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid size '%s', e.g. 4MiB, 64m", value)
+	}
+	unit, ok := sizeUnits[strings.ToLower(matches[2])]
+	if !ok {
+		return 0, fmt.Errorf("invalid size unit '%s' of '%s', e.g. KiB, MiB, GiB", matches[2], value)
+	}
+	number, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size '%s', e.g. 4MiB, 64m", value)
+	}
+
+	size := number * float64(unit)
+	if size >= math.MaxUint64 {
+		return 0, fmt.Errorf("size '%s' is too large", value)
+	}
+	return uint64(size), nil
+}
+
+// parse size and check it is in [min, max], max 0 means unlimited
+func ParseSizeInRange(value string, min, max uint64) (uint64, error) {
+	size, err := ParseSize(value)
+	if err != nil {
+		return 0, err
+	}
+	if size < min || (max > 0 && size > max) {
+		return 0, fmt.Errorf("size '%s' out of range [%s, %s]", value, humanize.IBytes(min), humanize.IBytes(max))
+	}
+	return size, nil
+}
+
+// block size must be power of 2, chunk size must be multiple of block size
+func ParseBlockChunkSize(blocksizeStr, chunksizeStr string) (uint64, uint64, error) {
+	blocksize, err := ParseSizeInRange(blocksizeStr, MIN_BLOCKSIZE, MAX_BLOCKSIZE)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid blocksize: %v", err)
+	}
+	if blocksize&(blocksize-1) != 0 {
+		return 0, 0, fmt.Errorf("invalid blocksize: %s is not power of 2", blocksizeStr)
+	}
+
+	chunksize, err := ParseSizeInRange(chunksizeStr, blocksize, MAX_CHUNKSIZE)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid chunksize: %v", err)
+	}
+	if chunksize%blocksize != 0 {
+		return 0, 0, fmt.Errorf("invalid chunksize: %s is not multiple of blocksize %s", chunksizeStr, blocksizeStr)
+	}
+	return blocksize, chunksize, nil
+}
+
+func ParseDuration(s string) (time.Duration, error) {
+	value := strings.TrimSpace(s)
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	var days time.Duration
+	if matches := regexp.MustCompile(DURATION_REGEX).FindStringSubmatch(value); matches != nil {
+		number, _ := strconv.ParseFloat(matches[1], 64)
+		days = time.Duration(number * float64(24*time.Hour))
+		if value = matches[2]; value == "" {
+			return days, nil
+		}
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration '%s', e.g. 30s, 1.5h, 7d", s)
+	}
+	return days + duration, nil
+}
+
+// duration flag accepts the same syntax as ParseDuration
+type durationValue time.Duration
+
+func newDurationValue(value time.Duration) *durationValue {
+	return (*durationValue)(&value)
+}
+
+func (d *durationValue) Set(s string) error {
+	value, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = durationValue(value)
+	return nil
+}
+
+func (d *durationValue) Type() string   { return "duration" }
+func (d *durationValue) String() string { return time.Duration(*d).String() }
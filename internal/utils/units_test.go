@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSize(t *testing.T) {
+	assert := assert.New(t)
+	for value, expect := range map[string]uint64{
+		"4096":   4096,
+		"4MiB":   4 * MiB,
+		"4 MiB":  4 * MiB,
+		"4 mib":  4 * MiB,
+		"64m":    64 * MiB,
+		"1.5GiB": 3 * GiB / 2,
+		"4KB":    4000,
+	} {
+		size, err := ParseSize(value)
+		assert.NoError(err, value)
+		assert.Equal(expect, size, value)
+	}
+
+	for _, value := range []string{"", "abc", "4XB", "-1"} {
+		_, err := ParseSize(value)
+		assert.Error(err, value)
+	}
+
+	_, _, err := ParseBlockChunkSize("4MiB", "64MiB")
+	assert.NoError(err)
+	_, _, err = ParseBlockChunkSize("3MiB", "64MiB")
+	assert.Error(err)
+	_, _, err = ParseBlockChunkSize("4MiB", "2MiB")
+	assert.Error(err)
+}
+
+func TestParseDuration(t *testing.T) {
+	assert := assert.New(t)
+	for value, expect := range map[string]time.Duration{
+		"30":    30 * time.Second,
+		"30s":   30 * time.Second,
+		"1.5h":  90 * time.Minute,
+		"500ms": 500 * time.Millisecond,
+		"7d":    7 * 24 * time.Hour,
+		"1d12h": 36 * time.Hour,
+	} {
+		duration, err := ParseDuration(value)
+		assert.NoError(err, value)
+		assert.Equal(expect, duration, value)
+	}
+
+	_, err := ParseDuration("1x")
+	assert.Error(err)
+}
@@ -15,6 +15,8 @@
 package logger
 
 import (
+	"fmt"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	lumberjack "gopkg.in/natefinch/lumberjack.v2"
@@ -23,61 +25,103 @@ import (
 type DingoLogger struct {
 	zapLogger *zap.Logger
 	sugar     *zap.SugaredLogger
+	level     zap.AtomicLevel
+	// rotated log file, shared by loggers of all formats
+	writer zapcore.WriteSyncer
 }
 
-func convertToLevel(loglevel string) zapcore.Level {
-	var level zapcore.Level
+// level of name, fatal and panic are only used by code, not configurable
+func ParseLevel(loglevel string) (zapcore.Level, error) {
 	switch loglevel {
 	case "debug":
-		level = zap.DebugLevel
+		return zap.DebugLevel, nil
 	case "info":
-		level = zap.InfoLevel
+		return zap.InfoLevel, nil
 	case "warn":
-		level = zap.WarnLevel
+		return zap.WarnLevel, nil
 	case "error":
-		level = zap.ErrorLevel
+		return zap.ErrorLevel, nil
+	}
+	return zap.InfoLevel, fmt.Errorf("invalid log level %s, should be: debug, info, warn, error", loglevel)
+}
+
+func ParseFormat(format string) (string, error) {
+	switch format {
+	case LOG_FORMAT_TEXT, LOG_FORMAT_JSON:
+		return format, nil
+	}
+	return DEFAULT_LOG_FORMAT, fmt.Errorf("invalid log format %s, should be: %s, %s", format, LOG_FORMAT_TEXT, LOG_FORMAT_JSON)
+}
+
+func convertToLevel(loglevel string) zapcore.Level {
+	switch loglevel {
 	case "fatal":
-		level = zap.FatalLevel
+		return zap.FatalLevel
 	case "panic":
-		level = zap.PanicLevel
-	default:
-		level = zap.InfoLevel
+		return zap.PanicLevel
 	}
-
+	// unknown level is info
+	level, _ := ParseLevel(loglevel)
 	return level
 }
 
-func newZapLogger(cfg *logConfig) *zap.Logger {
-	hook := lumberjack.Logger{
+func newLogWriter(cfg *logConfig) zapcore.WriteSyncer {
+	hook := &lumberjack.Logger{
 		Filename:   cfg.LogFile,
 		MaxSize:    cfg.MaxSize,
 		MaxBackups: cfg.MaxBackups,
 		MaxAge:     cfg.MaxAge,
 		Compress:   cfg.Compress,
 	}
+	return &redactWriteSyncer{zapcore.AddSync(hook)}
+}
 
-	writeSyncer := zapcore.AddSync(&hook)
-
+func newZapLogger(writer zapcore.WriteSyncer, format string, level zap.AtomicLevel) *zap.Logger {
 	encoderCfg := zap.NewProductionEncoderConfig()
 	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
 
 	var encoder zapcore.Encoder
-	if cfg.Format == "json" {
+	if format == LOG_FORMAT_JSON {
 		encoder = zapcore.NewJSONEncoder(encoderCfg)
 	} else {
 		encoder = zapcore.NewConsoleEncoder(encoderCfg)
 	}
 
-	level := convertToLevel(cfg.LogLevel)
 	core := zapcore.NewCore(
 		encoder,
-		writeSyncer,
+		writer,
 		level,
 	)
 
 	return zap.New(core)
 }
 
+// change log level at runtime, e.g. after configure file reloaded
+func (logger *DingoLogger) SetLevel(loglevel string) {
+	logger.level.SetLevel(convertToLevel(loglevel))
+}
+
+// change format of the log file, should be called before logging, e.g. after flags parsed,
+// loggers returned by With before are not changed
+func (logger *DingoLogger) SetFormat(format string) {
+	if logger.writer == nil {
+		return
+	}
+	logger.zapLogger = newZapLogger(logger.writer, format, logger.level)
+	logger.sugar = logger.zapLogger.Sugar()
+}
+
+// logger with fields added to every entry, e.g. With("fsid", 1, "path", "/dir")
+func (logger *DingoLogger) With(keysAndValues ...interface{}) *DingoLogger {
+	sugar := logger.sugar.With(keysAndValues...)
+	return &DingoLogger{
+		zapLogger: sugar.Desugar(),
+		sugar:     sugar,
+		level:     logger.level,
+		writer:    logger.writer,
+	}
+}
+
 func (logger *DingoLogger) Info(message string) {
 	logger.zapLogger.Info(message)
 }
@@ -126,6 +170,22 @@ func (logger *DingoLogger) Panicf(template string, args ...interface{}) {
 	logger.zapLogger.Sugar().Panicf(template, args...)
 }
 
+func (logger *DingoLogger) Debugw(message string, keysAndValues ...interface{}) {
+	logger.sugar.Debugw(message, keysAndValues...)
+}
+
+func (logger *DingoLogger) Infow(message string, keysAndValues ...interface{}) {
+	logger.sugar.Infow(message, keysAndValues...)
+}
+
+func (logger *DingoLogger) Warnw(message string, keysAndValues ...interface{}) {
+	logger.sugar.Warnw(message, keysAndValues...)
+}
+
+func (logger *DingoLogger) Errorw(message string, keysAndValues ...interface{}) {
+	logger.sugar.Errorw(message, keysAndValues...)
+}
+
 func (logger *DingoLogger) Sync() error {
 	return logger.zapLogger.Sync()
 }
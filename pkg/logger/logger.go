@@ -16,6 +16,8 @@ package logger
 
 import (
 	"sync"
+
+	"go.uber.org/zap"
 )
 
 var (
@@ -29,12 +31,16 @@ func newDingoLogger(opts ...Option) *DingoLogger {
 		opt(cfg)
 	}
 
-	zapLogger := newZapLogger(cfg)
+	level := zap.NewAtomicLevelAt(convertToLevel(cfg.LogLevel))
+	writer := newLogWriter(cfg)
+	zapLogger := newZapLogger(writer, cfg.Format, level)
 	sugar := zapLogger.Sugar()
 
 	return &DingoLogger{
 		zapLogger: zapLogger,
 		sugar:     sugar,
+		level:     level,
+		writer:    writer,
 	}
 }
 
@@ -102,6 +108,34 @@ func Panicf(message string, args ...interface{}) {
 	GetLogger().Panicf(message, args...)
 }
 
+func Debugw(message string, keysAndValues ...interface{}) {
+	GetLogger().Debugw(message, keysAndValues...)
+}
+
+func Infow(message string, keysAndValues ...interface{}) {
+	GetLogger().Infow(message, keysAndValues...)
+}
+
+func Warnw(message string, keysAndValues ...interface{}) {
+	GetLogger().Warnw(message, keysAndValues...)
+}
+
+func Errorw(message string, keysAndValues ...interface{}) {
+	GetLogger().Errorw(message, keysAndValues...)
+}
+
+func With(keysAndValues ...interface{}) *DingoLogger {
+	return GetLogger().With(keysAndValues...)
+}
+
+func SetLevel(loglevel string) {
+	GetLogger().SetLevel(loglevel)
+}
+
+func SetFormat(format string) {
+	GetLogger().SetFormat(format)
+}
+
 func Sync() error {
 	return GetLogger().Sync()
 }
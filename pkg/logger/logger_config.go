@@ -15,9 +15,17 @@
 package logger
 
 const (
+	LOG_FORMAT_TEXT = "text"
+	LOG_FORMAT_JSON = "json"
+
 	DEFAULT_LOG_FILE   = "dingocli.log"
 	DEFAULT_LOG_LEVEL  = "info"
-	DEFAULT_LOG_FORMAT = "text"
+	DEFAULT_LOG_FORMAT = LOG_FORMAT_TEXT
+
+	// log file is rotated by size, rotated files are removed by count and age
+	DEFAULT_LOG_MAX_SIZE    = 100 // MB
+	DEFAULT_LOG_MAX_BACKUPS = 5
+	DEFAULT_LOG_MAX_AGE     = 7 // days
 )
 
 type logConfig struct {
@@ -38,9 +46,9 @@ func defaultConfig() *logConfig {
 		LogFile:    DEFAULT_LOG_FILE,
 		LogLevel:   DEFAULT_LOG_LEVEL,
 		Format:     DEFAULT_LOG_FORMAT,
-		MaxSize:    1024,
-		MaxBackups: 1,
-		MaxAge:     1,
+		MaxSize:    DEFAULT_LOG_MAX_SIZE,
+		MaxBackups: DEFAULT_LOG_MAX_BACKUPS,
+		MaxAge:     DEFAULT_LOG_MAX_AGE,
 		Compress:   false,
 		Stdout:     false,
 	}
@@ -267,3 +267,50 @@ func TestPanic(t *testing.T) {
 		}()
 	})
 }
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("warn")
+	assert.NoError(t, err)
+	assert.Equal(t, zapcore.WarnLevel, level)
+
+	_, err = ParseLevel("verbose")
+	assert.Error(t, err)
+
+	_, err = ParseFormat("xml")
+	assert.Error(t, err)
+}
+
+func TestStructuredFields(t *testing.T) {
+	core, recorded := observer.New(zap.DebugLevel)
+	testLogger := &DingoLogger{
+		zapLogger: zap.New(core),
+		sugar:     zap.New(core).Sugar(),
+	}
+
+	originalLogger := globalLogger
+	globalLogger = testLogger
+	defer func() { globalLogger = originalLogger }()
+
+	With("fsid", 1).Infow("quota set", "path", "/dir1")
+	entries := recorded.All()
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, "quota set", entries[0].Message)
+	assert.Equal(t, map[string]interface{}{"fsid": int64(1), "path": "/dir1"}, entries[0].ContextMap())
+}
+
+func TestSetFormat(t *testing.T) {
+	var buffer bytes.Buffer
+	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+	writer := zapcore.AddSync(&buffer)
+	testLogger := &DingoLogger{
+		zapLogger: newZapLogger(writer, LOG_FORMAT_TEXT, level),
+		level:     level,
+		writer:    writer,
+	}
+	testLogger.sugar = testLogger.zapLogger.Sugar()
+
+	testLogger.SetFormat(LOG_FORMAT_JSON)
+	testLogger.Infow("mounted", "mountpoint", "/mnt/dingofs")
+	assert.Contains(t, buffer.String(), `"msg":"mounted"`)
+	assert.Contains(t, buffer.String(), `"mountpoint":"/mnt/dingofs"`)
+}
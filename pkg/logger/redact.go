@@ -0,0 +1,145 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package logger
+
+import (
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// redact secrets before anything is printed or written:
+//   - values of sensitive keys, e.g. "sk": "xxx", sk: xxx, --s3.sk=xxx, --s3.sk xxx
+//   - registered secret values wherever they appear, e.g. in error messages
+const (
+	REDACTED = "******"
+
+	// shorter values are not registered, otherwise unrelated text would be masked
+	MIN_SECRET_VALUE_LENGTH = 4
+)
+
+var (
+	redactMu      sync.RWMutex
+	sensitiveKeys = map[string]bool{}
+	secretValues  = map[string]bool{}
+	keyValueRegex *regexp.Regexp
+	flagRegex     *regexp.Regexp
+)
+
+func AddSensitiveKeys(keys ...string) {
+	redactMu.Lock()
+	defer redactMu.Unlock()
+
+	for _, key := range keys {
+		if key != "" {
+			sensitiveKeys[key] = true
+		}
+	}
+
+	names := []string{}
+	for key := range sensitiveKeys {
+		names = append(names, regexp.QuoteMeta(key))
+	}
+	// longest first, so s3.sk is matched before sk
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	pattern := strings.Join(names, "|")
+	keyValueRegex = regexp.MustCompile(`(?i)(\b(?:` + pattern + `)"?\s*[:=]\s*"?)([^"\s,}]+)`)
+	flagRegex = regexp.MustCompile(`(?i)(--(?:` + pattern + `)\s+)([^-\s]\S*)`)
+}
+
+func AddSecretValue(value string) {
+	if len(value) < MIN_SECRET_VALUE_LENGTH {
+		return
+	}
+
+	redactMu.Lock()
+	defer redactMu.Unlock()
+	secretValues[value] = true
+}
+
+func Redact(s string) string {
+	redactMu.RLock()
+	defer redactMu.RUnlock()
+
+	for value := range secretValues {
+		s = strings.ReplaceAll(s, value, REDACTED)
+	}
+	if keyValueRegex != nil {
+		s = keyValueRegex.ReplaceAllString(s, "${1}"+REDACTED)
+		s = flagRegex.ReplaceAllString(s, "${1}"+REDACTED)
+	}
+	return s
+}
+
+// RedactArgs redacts arguments of a command line one by one, the value of a
+// sensitive flag may be the next argument, e.g. --s3.sk xxx
+func RedactArgs(args []string) []string {
+	redacted := make([]string, 0, len(args))
+	for i, arg := range args {
+		if i > 0 && isSensitiveFlag(args[i-1]) && !strings.HasPrefix(arg, "-") {
+			redacted = append(redacted, REDACTED)
+			continue
+		}
+		redacted = append(redacted, Redact(arg))
+	}
+	return redacted
+}
+
+// e.g. --s3.sk, but not --s3.sk=xxx which is redacted by itself
+func isSensitiveFlag(arg string) bool {
+	if !strings.HasPrefix(arg, "--") || strings.Contains(arg, "=") {
+		return false
+	}
+
+	redactMu.RLock()
+	defer redactMu.RUnlock()
+	name := strings.TrimPrefix(arg, "--")
+	for key := range sensitiveKeys {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
+
+type redactWriter struct {
+	io.Writer
+}
+
+// writer which redacts secrets before writing to w
+func NewRedactWriter(w io.Writer) io.Writer {
+	return &redactWriter{Writer: w}
+}
+
+func (w *redactWriter) Write(p []byte) (int, error) {
+	if _, err := w.Writer.Write([]byte(Redact(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+type redactWriteSyncer struct {
+	zapcore.WriteSyncer
+}
+
+func (w *redactWriteSyncer) Write(p []byte) (int, error) {
+	if _, err := w.WriteSyncer.Write([]byte(Redact(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026 dingodb.com, Inc. All Rights Reserved
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package logger
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	AddSensitiveKeys("s3.sk", "dingofs.s3.sk", "rados.key", "dingofs.rados.key")
+	AddSecretValue("my-secret-value")
+
+	assert.Equal(t, `{"s3.sk": "******", "ak": "ak1"}`, Redact(`{"s3.sk": "abc", "ak": "ak1"}`))
+	assert.Equal(t, "dingo fs create --s3.sk=****** --s3.ak ak1", Redact("dingo fs create --s3.sk=abc --s3.ak ak1"))
+	assert.Equal(t, "dingo fs create --s3.sk ******", Redact("dingo fs create --s3.sk abc"))
+	assert.Equal(t, "dingofs.s3.sk: ******", Redact("dingofs.s3.sk: abc"))
+	assert.Equal(t, "auth failed: ******", Redact("auth failed: my-secret-value"))
+	assert.Equal(t, "task: warmup", Redact("task: warmup"))
+
+	assert.Equal(t, []string{"fs", "create", "--s3.sk", "******", "--s3.ak", "ak1"},
+		RedactArgs([]string{"fs", "create", "--s3.sk", "abc", "--s3.ak", "ak1"}))
+	assert.Equal(t, []string{"fs", "create", "--s3.sk=******", "--verbose"},
+		RedactArgs([]string{"fs", "create", "--s3.sk=abc", "--verbose"}))
+	assert.Equal(t, []string{"fs", "create", "--S3.SK", "******"}, RedactArgs([]string{"fs", "create", "--S3.SK", "abc"}))
+
+	var buf bytes.Buffer
+	w := NewRedactWriter(&buf)
+	n, err := w.Write([]byte("s3.sk=abc"))
+	assert.NoError(t, err)
+	assert.Equal(t, 9, n)
+	assert.Equal(t, "s3.sk=******", buf.String())
+}
+
+func TestRedactPlainKey(t *testing.T) {
+	AddSensitiveKeys("rados.key", "dingofs.rados.key")
+
+	// only the registered flag name and viper key are sensitive, not the last segment
+	for _, s := range []string{
+		`{"key": "chunk_1_2", "sk": "abc"}`,
+		`{"fsId": 1, "key": "blocks/1/2"}`,
+		"key: dingofs.yaml",
+	} {
+		assert.Equal(t, s, Redact(s))
+	}
+	assert.Equal(t, `{"rados.key": "******", "key": "k1"}`, Redact(`{"rados.key": "abc", "key": "k1"}`))
+}